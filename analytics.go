@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/database"
+)
+
+// dayLayout is how -before takes a day.
+const dayLayout = "2006-01-02"
+
+// runAnalyticsCommand dispatches the analytics subcommands.
+func runAnalyticsCommand(args []string) int {
+	if len(args) == 0 || args[0] != "rollup" {
+		printAnalyticsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	var opts config.Options
+
+	fs := commandFlags("analytics rollup", &opts, os.Stderr)
+	before := fs.String("before", "", "roll up the days before this one, YYYY-MM-DD (default today)")
+	prune := fs.Bool("prune", false, "delete the rolled up events")
+	asJSON := fs.Bool("json", false, "print JSON")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return constants.ExitUsage
+	}
+
+	if fs.NArg() > 0 {
+		printAnalyticsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	day := time.Now().UTC()
+
+	if *before != "" {
+		var err error
+		if day, err = time.Parse(dayLayout, *before); err != nil {
+			fmt.Fprintf(os.Stderr, "-before: expected a day as YYYY-MM-DD: %v\n", err)
+			return constants.ExitUsage
+		}
+	}
+
+	di, _, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	dbManager, err := container.Get[*database.DatabaseManager](di)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open database: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	rollup, err := dbManager.RollupAnalytics(context.Background(), day, *prune)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Rollup failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if *asJSON {
+		return writeJSON(os.Stdout, rollup)
+	}
+
+	fmt.Fprintf(os.Stdout, "Rolled up events before %s into %d daily totals", rollup.Before.Format(dayLayout), rollup.Days)
+
+	if *prune {
+		fmt.Fprintf(os.Stdout, ", pruned %d events", rollup.Pruned)
+	}
+
+	fmt.Fprintln(os.Stdout)
+
+	return constants.ExitSuccess
+}
+
+func printAnalyticsUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website analytics rollup [-before <YYYY-MM-DD>] [-prune] [-json]
+
+Sums the analytics events of every day before -before into analytics_daily,
+one row per day, event type and page. -prune then deletes those events.`)
+}
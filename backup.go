@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/database"
+)
+
+// backupDir holds the backups named after the time they were taken, next to
+// the database.
+const backupDir = "backups"
+
+// backupResult is the -json output of backup and restore.
+type backupResult struct {
+	File     string `json:"file"`
+	Database string `json:"database"`
+	Bytes    int64  `json:"bytes"`
+}
+
+// runBackupCommand copies the database, safely while the server runs.
+func runBackupCommand(args []string) int {
+	var opts config.Options
+
+	fs := commandFlags("backup", &opts, os.Stderr)
+	asJSON := fs.Bool("json", false, "print JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return constants.ExitUsage
+	}
+
+	if fs.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "Usage: holger-hahn-website backup [-json] [file]")
+		return constants.ExitUsage
+	}
+
+	di, cfg, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	dbPath := cfg.Database.ConnectionString
+
+	file := fs.Arg(0)
+	if file == "" {
+		file = defaultBackupFile(dbPath, time.Now().UTC())
+	}
+
+	dbManager, err := container.Get[*database.DatabaseManager](di)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open database: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if err := dbManager.Backup(context.Background(), file); err != nil {
+		fmt.Fprintf(os.Stderr, "Backup failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	return writeBackupResult("Backed up %s to %s (%d bytes)\n", file, backupResult{File: file, Database: dbPath}, *asJSON)
+}
+
+// defaultBackupFile names a backup of dbPath taken at now.
+func defaultBackupFile(dbPath string, now time.Time) string {
+	name := strings.TrimSuffix(filepath.Base(dbPath), filepath.Ext(dbPath))
+
+	return filepath.Join(filepath.Dir(dbPath), backupDir, name+"-"+now.Format("20060102-150405")+".db")
+}
+
+// runRestoreCommand replaces the database with a backup. The server must be
+// stopped: it would keep writing to the replaced file.
+func runRestoreCommand(args []string) int {
+	var opts config.Options
+
+	fs := commandFlags("restore", &opts, os.Stderr)
+	force := fs.Bool("force", false, "replace an existing database")
+	asJSON := fs.Bool("json", false, "print JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return constants.ExitUsage
+	}
+
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: holger-hahn-website restore [-force] [-json] <file>")
+		return constants.ExitUsage
+	}
+
+	// Only the configuration is needed: the database must not be opened
+	cfg, err := config.Load(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		return constants.ExitFailure
+	}
+
+	dbPath := cfg.Database.ConnectionString
+
+	if _, err := os.Stat(dbPath); err == nil && !*force {
+		fmt.Fprintf(os.Stderr, "%s exists; stop the server and pass -force to replace it\n", dbPath)
+		return constants.ExitFailure
+	}
+
+	if err := database.Restore(context.Background(), fs.Arg(0), dbPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Restore failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	return writeBackupResult("Restored %s from %s (%d bytes)\n", dbPath, backupResult{File: fs.Arg(0), Database: dbPath}, *asJSON)
+}
+
+// writeBackupResult prints the outcome of backup or restore, in message with
+// the database, the backup file and the size of written, or as JSON.
+func writeBackupResult(message, written string, result backupResult, asJSON bool) int {
+	info, err := os.Stat(written)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read %s: %v\n", written, err)
+		return constants.ExitFailure
+	}
+
+	result.Bytes = info.Size()
+
+	if asJSON {
+		return writeJSON(os.Stdout, result)
+	}
+
+	fmt.Fprintf(os.Stdout, message, result.Database, result.File, result.Bytes)
+
+	return constants.ExitSuccess
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/sitebuild"
+)
+
+// runBuildCommand generates the static site from the repositories behind the
+// live site and checks it.
+func runBuildCommand(args []string) int {
+	var opts config.Options
+
+	fs := commandFlags("build", &opts, os.Stderr)
+	target := fs.String("target", sitebuild.TargetSite, "what to build: site (pages, assets and CV, then check), resume (CV only) or check (check existing output)")
+	out := fs.String("out", sitebuild.DefaultPublicDir, "directory to generate the site into")
+	reportFile := fs.String("report", sitebuild.DefaultReportFile, "where to write the JSON report of the site check")
+
+	if err := fs.Parse(args); err != nil {
+		return constants.ExitUsage
+	}
+
+	di, cfg, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	// Set up structured logging with build prefix.
+	log.SetPrefix("[BUILD] ")
+
+	err := sitebuild.Run(context.Background(), di, sitebuild.Options{
+		Target:     *target,
+		PublicDir:  *out,
+		ReportFile: *reportFile,
+		BaseURL:    cfg.Server.PublicURL,
+	})
+	if errors.Is(err, sitebuild.ErrUnknownTarget) {
+		log.Print(err)
+		return constants.ExitUsage
+	}
+
+	if err != nil {
+		log.Printf("Build failed: %v", err)
+		return constants.ExitFailure
+	}
+
+	log.Printf("Build completed! Files generated in %s/ directory", *out)
+
+	return constants.ExitSuccess
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"holger-hahn-website/internal/application"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+)
+
+// commands maps the operating subcommands to their entry points. Each one
+// parses its own flags and reads the same configuration as the server.
+var commands = map[string]func(args []string) int{
+	"analytics": runAnalyticsCommand,
+	"backup":    runBackupCommand,
+	"build":     runBuildCommand,
+	"config":    runConfigCommand,
+	"contacts":  runContactsCommand,
+	"dev":       runDevCommand,
+	"migrate":   runMigrateCommand,
+	"restore":   runRestoreCommand,
+	"secrets":   runSecretsCommand,
+	"seed":      runSeedCommand,
+	"serve":     runServeCommand,
+	"token":     runTokenCommand,
+	"user":      runUserCommand,
+}
+
+// isHelp reports whether arg asks for the list of commands.
+func isHelp(arg string) bool {
+	return arg == "help" || arg == "-h" || arg == "-help" || arg == "--help"
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website [command] [flags]
+
+Without a command the web server runs, as with "serve".
+
+Commands:
+  serve                           run the web server (--port, --db, --config, ...)
+  build                           generate the static site into public/
+  migrate up|down|status          apply, roll back or list schema migrations
+  seed                            add the core technologies and sample content
+  backup [file]                   copy the database while it is in use
+  restore <file>                  replace the database with a backup
+  contacts list|export|purge      read, export or delete contact submissions
+  user create                     issue a token for the content editor
+  token issue|revoke|list         manage API tokens
+  analytics rollup                sum analytics events into daily totals
+  config print                    show the effective configuration
+  secrets keygen|set|delete|list  manage the encrypted secrets file
+  dev                             serve with live reload
+
+Every command that reads the configuration accepts -config <file>; listing
+commands accept -json for machine-readable output.`)
+}
+
+// commandFlags returns the flag set of a subcommand. -config names the config
+// file like the server's --config flag and is stored in opts.
+func commandFlags(name string, opts *config.Options, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.File, "config", "", "config file (default $CONFIG_FILE or ./config.yaml)")
+
+	return fs
+}
+
+// openContainer creates the DI container for a command and loads its
+// configuration, reporting an invalid configuration on stderr. Log entries go
+// to stderr too, so that they never mix with -json output.
+func openContainer(opts config.Options, stderr io.Writer) (*container.Container, *config.Config, bool) {
+	set := map[string]any{"logging.output": "stderr"}
+	maps.Copy(set, opts.Set)
+	opts.Set = set
+
+	di := container.NewWithConfig(opts)
+
+	cfg, err := container.Get[*config.Config](di)
+	if err != nil {
+		fmt.Fprintf(stderr, "Invalid configuration:\n%v\n", err)
+		closeContainer(di, stderr)
+
+		return nil, nil, false
+	}
+
+	return di, cfg, true
+}
+
+// closeContainer shuts down the DI container of a command.
+func closeContainer(di *container.Container, stderr io.Writer) {
+	if err := di.Shutdown(); err != nil {
+		fmt.Fprintf(stderr, "Error shutting down DI container: %v\n", err)
+	}
+}
+
+// writeJSON prints v as indented JSON, the output of every -json flag.
+func writeJSON(w io.Writer, v any) int {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(v); err != nil {
+		return constants.ExitFailure
+	}
+
+	return constants.ExitSuccess
+}
+
+// runTokenCommand dispatches the token subcommands.
+func runTokenCommand(args []string) int {
+	if len(args) == 0 {
+		printTokenUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	switch args[0] {
+	case "issue", "revoke", "list":
+	default:
+		printTokenUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	var opts config.Options
+
+	fs := commandFlags("token "+args[0], &opts, os.Stderr)
+
+	var (
+		asJSON  bool
+		name    *string
+		scopes  *string
+		expires *time.Duration
+	)
+
+	if args[0] != "revoke" {
+		fs.BoolVar(&asJSON, "json", false, "print JSON")
+	}
+
+	if args[0] == "issue" {
+		name, scopes, expires = tokenIssueFlags(fs)
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return constants.ExitUsage
+	}
+
+	di, cfg, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	tokenService := container.MustGet[*application.TokenService](di)
+	ctx := context.Background()
+
+	switch args[0] {
+	case "issue":
+		// Without -expires tokens live for the configured default
+		ttl := cfg.Auth.TokenTTL
+		if isFlagSet(fs, "expires") {
+			ttl = *expires
+		}
+
+		req := application.IssueTokenRequest{Name: *name, Scopes: strings.Split(*scopes, ","), TTL: ttl}
+
+		return issueToken(ctx, tokenService, req, asJSON, os.Stdout, os.Stderr)
+	case "revoke":
+		return revokeToken(ctx, tokenService, fs.Args(), os.Stdout, os.Stderr)
+	default:
+		return listTokens(ctx, tokenService, asJSON, os.Stdout, os.Stderr)
+	}
+}
+
+// tokenIssueFlags registers the flags of "token issue".
+func tokenIssueFlags(fs *flag.FlagSet) (name, scopes *string, expires *time.Duration) {
+	name = fs.String("name", "", "descriptive name, e.g. github-actions")
+	scopes = fs.String("scopes", "", "comma separated scopes: content:write, contacts:read, metrics:read")
+	expires = fs.Duration("expires", 0, "token lifetime, 0 for no expiry (default auth.token_ttl)")
+
+	return name, scopes, expires
+}
+
+// isFlagSet reports whether the flag name was given on the command line.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
+// issueToken handles "token issue" and prints the new token with its secret.
+func issueToken(ctx context.Context, svc *application.TokenService, req application.IssueTokenRequest, asJSON bool, stdout, stderr io.Writer) int {
+	issued, err := svc.IssueToken(ctx, req)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to issue token: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if asJSON {
+		return writeJSON(stdout, issued)
+	}
+
+	fmt.Fprintf(stdout, "Issued token %s (%s) with scopes %s\n", issued.ID, issued.Name, strings.Join(issued.Scopes, ", "))
+
+	if issued.ExpiresAt != nil {
+		fmt.Fprintf(stdout, "Expires: %s\n", issued.ExpiresAt.Format(time.RFC3339))
+	}
+
+	fmt.Fprintln(stdout, "Store this token now, it will not be shown again:")
+	fmt.Fprintln(stdout, issued.Secret)
+
+	return constants.ExitSuccess
+}
+
+// revokeToken handles "token revoke <id>".
+func revokeToken(ctx context.Context, svc *application.TokenService, args []string, stdout, stderr io.Writer) int {
+	if len(args) != 1 {
+		fmt.Fprintln(stderr, "Usage: holger-hahn-website token revoke <id>")
+		return constants.ExitUsage
+	}
+
+	if err := svc.RevokeToken(ctx, args[0]); err != nil {
+		fmt.Fprintf(stderr, "Failed to revoke token: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	fmt.Fprintf(stdout, "Revoked token %s\n", args[0])
+
+	return constants.ExitSuccess
+}
+
+// listTokens handles "token list".
+func listTokens(ctx context.Context, svc *application.TokenService, asJSON bool, stdout, stderr io.Writer) int {
+	tokens, err := svc.ListTokens(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to list tokens: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if asJSON {
+		return writeJSON(stdout, tokens)
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tPREFIX\tSCOPES\tEXPIRES\tLAST USED\tSTATUS")
+
+	now := time.Now().UTC()
+	for _, token := range tokens {
+		status := "active"
+
+		switch {
+		case token.RevokedAt != nil:
+			status = "revoked"
+		case token.ExpiresAt != nil && !now.Before(*token.ExpiresAt):
+			status = "expired"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s…\t%s\t%s\t%s\t%s\n",
+			token.ID,
+			token.Name,
+			token.Prefix,
+			strings.Join(token.Scopes, ","),
+			formatOptionalTime(token.ExpiresAt, "never"),
+			formatOptionalTime(token.LastUsedAt, "never"),
+			status,
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		return constants.ExitFailure
+	}
+
+	return constants.ExitSuccess
+}
+
+func formatOptionalTime(t *time.Time, fallback string) string {
+	if t == nil {
+		return fallback
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func printTokenUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website token <command>
+
+Commands:
+  issue   -name <name> -scopes <scope,...> [-expires <duration>] [-json]
+  revoke  <id>
+  list    [-json]`)
+}
+
+// runConfigCommand dispatches the config subcommands.
+func runConfigCommand(args []string) int {
+	if len(args) == 0 || args[0] != "print" {
+		printConfigUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	return printConfig(args[1:], os.Stdout, os.Stderr)
+}
+
+// printConfig handles "config print": it shows the configuration the server
+// would run with, after every source has been applied.
+func printConfig(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("config print", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	redacted := fs.Bool("redacted", false, "replace secrets such as the SMTP password")
+	file := fs.String("config", "", "config file (default $CONFIG_FILE or ./config.yaml)")
+
+	if err := fs.Parse(args); err != nil {
+		return constants.ExitUsage
+	}
+
+	cfg, err := config.Load(config.Options{File: *file})
+	if err != nil {
+		fmt.Fprintf(stderr, "Invalid configuration:\n%v\n", err)
+		return constants.ExitFailure
+	}
+
+	if *redacted {
+		cfg = cfg.Redacted()
+	}
+
+	out, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to print configuration: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	fmt.Fprintln(stdout, string(out))
+
+	return constants.ExitSuccess
+}
+
+func printConfigUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website config <command>
+
+Commands:
+  print   [-redacted] [-config <file>]`)
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"holger-hahn-website/internal/application"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/domain"
+)
+
+// Export formats of "contacts export".
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+// errInvalidAge is returned for an -older-than value that is not an age.
+var errInvalidAge = errors.New("invalid age, expected e.g. 365d or 720h")
+
+// runContactsCommand dispatches the contacts subcommands.
+func runContactsCommand(args []string) int {
+	if len(args) == 0 {
+		printContactsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	var opts config.Options
+
+	fs := commandFlags("contacts "+args[0], &opts, os.Stderr)
+
+	var run func(ctx context.Context, svc *application.ContactService) int
+
+	switch args[0] {
+	case "list":
+		status := fs.String("status", "", "only contacts with this status: new, read, replied or archived")
+		limit := fs.Int("limit", constants.DefaultContactPageSize, "number of contacts to show")
+		offset := fs.Int("offset", 0, "number of contacts to skip")
+		asJSON := fs.Bool("json", false, "print JSON")
+
+		run = func(ctx context.Context, svc *application.ContactService) int {
+			return listContacts(ctx, svc, *status, *limit, *offset, *asJSON, os.Stdout, os.Stderr)
+		}
+	case "export":
+		format := fs.String("format", formatCSV, "csv or json")
+		out := fs.String("o", "", "file to write, default standard output")
+
+		run = func(ctx context.Context, svc *application.ContactService) int {
+			return exportContacts(ctx, svc, *format, *out, os.Stdout, os.Stderr)
+		}
+	case "purge":
+		olderThan := fs.String("older-than", "", "delete contacts submitted longer ago than this, e.g. 365d")
+		dryRun := fs.Bool("dry-run", false, "only count the contacts that would be deleted")
+		asJSON := fs.Bool("json", false, "print JSON")
+
+		run = func(ctx context.Context, svc *application.ContactService) int {
+			return purgeContacts(ctx, svc, *olderThan, *dryRun, *asJSON, os.Stdout, os.Stderr)
+		}
+	default:
+		printContactsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return constants.ExitUsage
+	}
+
+	if fs.NArg() > 0 {
+		printContactsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	di, _, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	return run(context.Background(), container.MustGet[*application.ContactService](di))
+}
+
+// listContacts handles "contacts list".
+func listContacts(ctx context.Context, svc *application.ContactService, status string, limit, offset int, asJSON bool, stdout, stderr io.Writer) int {
+	if status != "" && !domain.ContactStatus(status).IsValid() {
+		fmt.Fprintln(stderr, "status must be one of new, read, replied, archived")
+		return constants.ExitUsage
+	}
+
+	contacts, err := svc.ListContacts(ctx, status, limit, offset)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to list contacts: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if asJSON {
+		return writeJSON(stdout, contacts)
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSUBMITTED\tSTATUS\tNAME\tEMAIL\tCOMPANY")
+
+	for _, contact := range contacts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			contact.ID,
+			formatSubmittedAt(contact),
+			contact.Status,
+			contact.Name,
+			contact.Email,
+			contact.Company,
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		return constants.ExitFailure
+	}
+
+	return constants.ExitSuccess
+}
+
+// exportContacts handles "contacts export": every contact, newest first, as
+// CSV or JSON.
+func exportContacts(ctx context.Context, svc *application.ContactService, format, out string, stdout, stderr io.Writer) int {
+	if format != formatCSV && format != formatJSON {
+		fmt.Fprintf(stderr, "unknown format %q, expected %s or %s\n", format, formatCSV, formatJSON)
+		return constants.ExitUsage
+	}
+
+	contacts := []*application.Contact{}
+
+	for offset := 0; ; offset += constants.MaxContactPageSize {
+		page, err := svc.ListContacts(ctx, "", constants.MaxContactPageSize, offset)
+		if err != nil {
+			fmt.Fprintf(stderr, "Failed to export contacts: %v\n", err)
+			return constants.ExitFailure
+		}
+
+		contacts = append(contacts, page...)
+
+		if len(page) < constants.MaxContactPageSize {
+			break
+		}
+	}
+
+	w := stdout
+
+	if out != "" {
+		// Exports hold personal data
+		file, err := os.OpenFile(out, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, constants.PrivateFilePerms)
+		if err != nil {
+			fmt.Fprintf(stderr, "Failed to export contacts: %v\n", err)
+			return constants.ExitFailure
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	if format == formatJSON {
+		return writeJSON(w, contacts)
+	}
+
+	if err := writeContactsCSV(w, contacts); err != nil {
+		fmt.Fprintf(stderr, "Failed to export contacts: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	return constants.ExitSuccess
+}
+
+// writeContactsCSV writes contacts with a header row.
+func writeContactsCSV(w io.Writer, contacts []*application.Contact) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"id", "submitted_at", "status", "name", "email", "company", "project"}); err != nil {
+		return err
+	}
+
+	for _, contact := range contacts {
+		err := cw.Write([]string{
+			contact.ID,
+			formatSubmittedAt(contact),
+			contact.Status,
+			contact.Name,
+			contact.Email,
+			contact.Company,
+			contact.Project,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// purgeContacts handles "contacts purge".
+func purgeContacts(ctx context.Context, svc *application.ContactService, olderThan string, dryRun, asJSON bool, stdout, stderr io.Writer) int {
+	age, err := parseAge(olderThan)
+	if err != nil {
+		fmt.Fprintf(stderr, "-older-than: %v\n", err)
+		return constants.ExitUsage
+	}
+
+	before := time.Now().UTC().Add(-age)
+
+	count, err := svc.PurgeContacts(ctx, before, dryRun)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to purge contacts: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if asJSON {
+		return writeJSON(stdout, struct {
+			Before  time.Time `json:"before"`
+			Deleted int       `json:"deleted"`
+			DryRun  bool      `json:"dry_run"`
+		}{before, count, dryRun})
+	}
+
+	verb := "Deleted"
+	if dryRun {
+		verb = "Would delete"
+	}
+
+	fmt.Fprintf(stdout, "%s %d contacts submitted before %s\n", verb, count, before.Format(time.RFC3339))
+
+	return constants.ExitSuccess
+}
+
+// parseAge parses a duration that may also be given in days, as in 30d.
+func parseAge(s string) (time.Duration, error) {
+	var (
+		age time.Duration
+		err error
+	)
+
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		age = time.Duration(n) * 24 * time.Hour
+	} else {
+		age, err = time.ParseDuration(s)
+	}
+
+	if err != nil || age <= 0 {
+		return 0, fmt.Errorf("%w: %q", errInvalidAge, s)
+	}
+
+	return age, nil
+}
+
+// formatSubmittedAt formats when a contact was submitted.
+func formatSubmittedAt(contact *application.Contact) string {
+	if t, ok := contact.SubmittedAt.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	return fmt.Sprint(contact.SubmittedAt)
+}
+
+func printContactsUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website contacts <command>
+
+Commands:
+  list    [-status <status>] [-limit <n>] [-offset <n>] [-json]
+  export  [-format csv|json] [-o <file>]
+  purge   -older-than <age> [-dry-run] [-json]   age as 365d or 720h`)
+}
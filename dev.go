@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/devserver"
+)
+
+// runDevCommand serves the website with live reload: templates, static files
+// and seed content are watched, and every change regenerates, rebuilds or
+// restarts as needed before the browser reloads.
+func runDevCommand(args []string) int {
+	if len(args) > 0 {
+		fmt.Fprintln(os.Stderr, "Usage: holger-hahn-website dev")
+		return constants.ExitUsage
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load(config.Options{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		return constants.ExitFailure
+	}
+
+	err = devserver.New(devserver.DefaultConfig(cfg.Server.Address())).Run(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Development server failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	return constants.ExitSuccess
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+
+	"holger-hahn-website/internal/assets"
+	"holger-hahn-website/internal/config"
+)
+
+// staticPrefix is the URL path the static assets are served under.
+const staticPrefix = "/static"
+
+// staticFiles holds the static assets, so that the server runs from any
+// working directory.
+//
+//go:embed static
+var staticFiles embed.FS
+
+// newStaticServer serves the embedded assets or, when the configuration names
+// a static directory, the files in it as they are on disk, for development.
+func newStaticServer(cfg *config.Config) (*assets.Server, error) {
+	if cfg.Server.StaticDir != "" {
+		return assets.NewLiveServer(os.DirFS(cfg.Server.StaticDir), staticPrefix), nil
+	}
+
+	files, err := fs.Sub(staticFiles, "static")
+	if err != nil {
+		return nil, err
+	}
+
+	return assets.NewServer(files, staticPrefix)
+}
@@ -5,16 +5,23 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/telemetry"
 )
 
+// contactFormThanks is the reply to every accepted submission.
+const contactFormThanks = "Thank you for your message! We'll get back to you within 24 hours."
+
 // ContactService handles contact form business logic.
 type ContactService struct {
 	contactRepo domain.ContactRepository
 	emailSvc    domain.EmailService
 	logger      domain.LoggingService
+	metrics     domain.MetricsService
 }
 
 // NewContactService creates a new contact service.
@@ -22,16 +29,31 @@ func NewContactService(
 	contactRepo domain.ContactRepository,
 	emailSvc domain.EmailService,
 	logger domain.LoggingService,
+	metrics domain.MetricsService,
 ) *ContactService {
 	return &ContactService{
 		contactRepo: contactRepo,
 		emailSvc:    emailSvc,
 		logger:      logger,
+		metrics:     metrics,
 	}
 }
 
 // SubmitContactForm handles a new contact form submission.
-func (s *ContactService) SubmitContactForm(ctx context.Context, req ContactFormRequest) (*ContactFormResponse, error) {
+func (s *ContactService) SubmitContactForm(ctx context.Context, req ContactFormRequest) (_ *ContactFormResponse, err error) {
+	ctx, span := telemetry.Start(ctx, "ContactService.SubmitContactForm")
+	defer telemetry.End(span, &err)
+
+	// Bots fill in the hidden website field; they are told nothing is wrong.
+	if req.Website != "" {
+		s.logger.Warn(ctx, "Rejected contact form spam", map[string]interface{}{
+			"email": req.Email,
+		})
+		s.metrics.RecordContactSubmission(domain.SubmissionSpam)
+
+		return &ContactFormResponse{Message: contactFormThanks, Success: true}, nil
+	}
+
 	// Create domain entity with validation.
 	contact, err := domain.NewContact(req.Name, req.Company, req.Email, req.Project, "")
 	if err != nil {
@@ -40,6 +62,7 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req ContactFormR
 			"email":   req.Email,
 			"company": req.Company,
 		})
+		s.metrics.RecordContactSubmission(domain.SubmissionValidationFailed)
 
 		return nil, fmt.Errorf("%w: %w", domain.ErrValidationFailed, err)
 	}
@@ -50,6 +73,7 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req ContactFormR
 			"contact_id": contact.ID,
 			"email":      contact.Email,
 		})
+		s.metrics.RecordContactSubmission(domain.SubmissionSaveFailed)
 
 		return nil, fmt.Errorf("%w: %w", domain.ErrSaveContact, err)
 	}
@@ -60,23 +84,7 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req ContactFormR
 		"company":    contact.Company,
 	})
 
-	// Send notification email (async in production).
-	if err := s.emailSvc.SendContactNotification(ctx, contact); err != nil {
-		s.logger.Error(ctx, "Failed to send notification email", err, map[string]interface{}{
-			"contact_id": contact.ID,
-			"email":      contact.Email,
-		})
-		// Don't fail the request if email fails - log and continue.
-	}
-
-	// Send confirmation email to contact.
-	if err := s.emailSvc.SendConfirmationEmail(ctx, contact); err != nil {
-		s.logger.Error(ctx, "Failed to send confirmation email", err, map[string]interface{}{
-			"contact_id": contact.ID,
-			"email":      contact.Email,
-		})
-		// Don't fail the request if email fails - log and continue.
-	}
+	s.sendEmails(ctx, contact)
 
 	// Mark as processed.
 	contact.MarkAsRead()
@@ -90,13 +98,61 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req ContactFormR
 
 	return &ContactFormResponse{
 		ID:      contact.ID,
-		Message: "Thank you for your message! We'll get back to you within 24 hours.",
+		Message: contactFormThanks,
 		Success: true,
 	}, nil
 }
 
+// sendEmails sends the notification and the confirmation about a saved
+// contact and counts the submission as saved once both went out. Email
+// failures are logged but never fail the request.
+func (s *ContactService) sendEmails(ctx context.Context, contact *domain.Contact) {
+	record := func(err error) {
+		if err != nil {
+			s.metrics.RecordContactSubmission(domain.SubmissionEmailFailed)
+			return
+		}
+
+		s.metrics.RecordContactSubmission(domain.SubmissionSaved)
+	}
+
+	// A queue sends later and reports back from its worker.
+	if mailer, ok := s.emailSvc.(domain.ContactMailer); ok {
+		if err := mailer.SendContactEmails(ctx, contact, record); err != nil {
+			s.logger.Error(ctx, "Failed to queue contact emails", err, map[string]interface{}{
+				"contact_id": contact.ID,
+				"email":      contact.Email,
+			})
+			record(err)
+		}
+
+		return
+	}
+
+	notifyErr := s.emailSvc.SendContactNotification(ctx, contact)
+	if notifyErr != nil {
+		s.logger.Error(ctx, "Failed to send notification email", notifyErr, map[string]interface{}{
+			"contact_id": contact.ID,
+			"email":      contact.Email,
+		})
+	}
+
+	confirmErr := s.emailSvc.SendConfirmationEmail(ctx, contact)
+	if confirmErr != nil {
+		s.logger.Error(ctx, "Failed to send confirmation email", confirmErr, map[string]interface{}{
+			"contact_id": contact.ID,
+			"email":      contact.Email,
+		})
+	}
+
+	record(errors.Join(notifyErr, confirmErr))
+}
+
 // GetContact retrieves a contact by ID.
-func (s *ContactService) GetContact(ctx context.Context, id string) (*Contact, error) {
+func (s *ContactService) GetContact(ctx context.Context, id string) (_ *Contact, err error) {
+	ctx, span := telemetry.Start(ctx, "ContactService.GetContact")
+	defer telemetry.End(span, &err)
+
 	contact, err := s.contactRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain.ErrFindContact, err)
@@ -114,7 +170,10 @@ func (s *ContactService) GetContact(ctx context.Context, id string) (*Contact, e
 }
 
 // ListContacts retrieves contacts with pagination.
-func (s *ContactService) ListContacts(ctx context.Context, status string, limit, offset int) ([]*Contact, error) {
+func (s *ContactService) ListContacts(ctx context.Context, status string, limit, offset int) (_ []*Contact, err error) {
+	ctx, span := telemetry.Start(ctx, "ContactService.ListContacts")
+	defer telemetry.End(span, &err)
+
 	contacts, err := s.contactRepo.FindAll(ctx, domain.ContactStatus(status), limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain.ErrListContacts, err)
@@ -137,15 +196,50 @@ func (s *ContactService) ListContacts(ctx context.Context, status string, limit,
 	return result, nil
 }
 
+// CountContacts returns the number of contacts with the given status, or all contacts when status is empty.
+func (s *ContactService) CountContacts(ctx context.Context, status string) (_ int, err error) {
+	ctx, span := telemetry.Start(ctx, "ContactService.CountContacts")
+	defer telemetry.End(span, &err)
+
+	count, err := s.contactRepo.Count(ctx, domain.ContactStatus(status))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domain.ErrListContacts, err)
+	}
+
+	return count, nil
+}
+
+// PurgeContacts removes the contacts submitted before the given time and
+// returns how many there were. With dryRun they are only counted.
+func (s *ContactService) PurgeContacts(ctx context.Context, before time.Time, dryRun bool) (_ int, err error) {
+	ctx, span := telemetry.Start(ctx, "ContactService.PurgeContacts")
+	defer telemetry.End(span, &err)
+
+	var count int
+	if dryRun {
+		count, err = s.contactRepo.CountBefore(ctx, before)
+	} else {
+		count, err = s.contactRepo.DeleteBefore(ctx, before)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", domain.ErrPurgeContacts, err)
+	}
+
+	return count, nil
+}
+
 // DTOs for application layer.
 
 // ContactFormRequest represents the request payload for contact form submissions,
 // containing all required and optional fields with validation rules.
 type ContactFormRequest struct {
-	Name    string `binding:"required,min=2,max=100" json:"name"`
-	Company string `binding:"max=100" json:"company"`
-	Email   string `binding:"required,email" json:"email"`
-	Project string `binding:"required,min=10,max=2000" json:"project"`
+	Name    string `binding:"required,min=2,max=100" form:"name" json:"name"`
+	Company string `binding:"max=100" form:"company" json:"company"`
+	Email   string `binding:"required,email" form:"email" json:"email"`
+	Project string `binding:"required,min=10,max=2000" form:"project" json:"project"`
+	// Website is a honeypot hidden from people; only bots fill it in.
+	Website string `binding:"max=200" form:"website" json:"website"`
 }
 
 // ContactFormResponse represents the response payload after contact form submission,
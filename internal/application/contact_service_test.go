@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/infrastructure"
+	"holger-hahn-website/internal/testutil"
+)
+
+// stubEmailService sends every notification and fails confirmations with err.
+type stubEmailService struct {
+	err error
+}
+
+func (stubEmailService) SendContactNotification(context.Context, *domain.Contact) error {
+	return nil
+}
+
+func (s stubEmailService) SendConfirmationEmail(context.Context, *domain.Contact) error {
+	return s.err
+}
+
+var errMailbox = errors.New("mailbox unavailable")
+
+// outcomeMetrics records the submission outcomes reported to it.
+type outcomeMetrics struct {
+	outcomes []domain.SubmissionOutcome
+	mu       sync.Mutex
+}
+
+func (m *outcomeMetrics) RecordContactSubmission(outcome domain.SubmissionOutcome) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.outcomes = append(m.outcomes, outcome)
+}
+
+func (m *outcomeMetrics) RecordEmail(string, time.Duration, error) {}
+
+func newTestContactService(email domain.EmailService) (*ContactService, *infrastructure.MemoryContactRepository, *outcomeMetrics) {
+	repo := infrastructure.NewMemoryContactRepository()
+	logger := infrastructure.NewSlogLoggingService(slog.New(slog.DiscardHandler))
+	metrics := &outcomeMetrics{}
+
+	return NewContactService(repo, email, logger, metrics), repo, metrics
+}
+
+var validContactForm = ContactFormRequest{
+	Name:    "Ada Lovelace",
+	Email:   "ada@example.com",
+	Project: "Custody integration for a bank",
+}
+
+func TestContactService_SubmitContactForm(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	logger := infrastructure.NewSlogLoggingService(slog.New(slog.DiscardHandler))
+
+	t.Run("spam", func(t *testing.T) {
+		service, repo, metrics := newTestContactService(stubEmailService{err: errMailbox})
+
+		form := validContactForm
+		form.Website = "https://spam.example.com"
+
+		response, err := service.SubmitContactForm(ctx, form)
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, response.Success, "Expected bots to see a normal reply")
+
+		count, err := repo.Count(ctx, "")
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 0, count)
+		testutil.AssertLen(t, metrics.outcomes, 1)
+		testutil.AssertEqual(t, domain.SubmissionSpam, metrics.outcomes[0])
+	})
+
+	t.Run("email failure", func(t *testing.T) {
+		service, _, metrics := newTestContactService(stubEmailService{err: errMailbox})
+
+		_, err := service.SubmitContactForm(ctx, validContactForm)
+		testutil.AssertNoError(t, err)
+		testutil.AssertLen(t, metrics.outcomes, 1)
+		testutil.AssertEqual(t, domain.SubmissionEmailFailed, metrics.outcomes[0])
+	})
+
+	t.Run("queued email failure", func(t *testing.T) {
+		queue := infrastructure.NewEmailQueue(stubEmailService{err: errMailbox}, logger, &outcomeMetrics{}, 1)
+		service, _, metrics := newTestContactService(queue)
+
+		_, err := service.SubmitContactForm(ctx, validContactForm)
+		testutil.AssertNoError(t, err)
+		testutil.AssertNoError(t, queue.Stop(ctx))
+
+		// The worker reports the outcome once the emails were tried
+		testutil.AssertLen(t, metrics.outcomes, 1)
+		testutil.AssertEqual(t, domain.SubmissionEmailFailed, metrics.outcomes[0])
+	})
+
+	t.Run("queued and sent", func(t *testing.T) {
+		queue := infrastructure.NewEmailQueue(stubEmailService{}, logger, &outcomeMetrics{}, 1)
+		service, _, metrics := newTestContactService(queue)
+
+		_, err := service.SubmitContactForm(ctx, validContactForm)
+		testutil.AssertNoError(t, err)
+		testutil.AssertNoError(t, queue.Stop(ctx))
+		testutil.AssertLen(t, metrics.outcomes, 1)
+		testutil.AssertEqual(t, domain.SubmissionSaved, metrics.outcomes[0])
+	})
+}
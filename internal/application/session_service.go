@@ -0,0 +1,141 @@
+// Package application contains the business logic and use cases for the portfolio website.
+// This file implements the content editor sessions, which map a short-lived random ID kept
+// in the editor's cookie to the API token they signed in with.
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// sessionIDBytes is the amount of randomness in each session ID.
+const sessionIDBytes = 32
+
+// SessionService handles content editor sign-in and sessions.
+type SessionService struct {
+	tokens      *TokenService
+	sessionRepo domain.SessionRepository
+	logger      domain.LoggingService
+	now         func() time.Time
+}
+
+// NewSessionService creates a new session service. Editors sign in with a
+// token checked by tokens; sessions are stored in sessionRepo.
+func NewSessionService(tokens *TokenService, sessionRepo domain.SessionRepository, logger domain.LoggingService) *SessionService {
+	return &SessionService{
+		tokens:      tokens,
+		sessionRepo: sessionRepo,
+		logger:      logger,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Authenticate resolves the token pasted on the login page. Only signing in
+// records the token's use; requests within a session do not.
+func (s *SessionService) Authenticate(ctx context.Context, secret string) (*domain.APIToken, error) {
+	return s.tokens.Authenticate(ctx, secret)
+}
+
+// StartSession opens a session for token and returns its ID together with the
+// time it expires, which is maxAge from now but never after the token expires.
+func (s *SessionService) StartSession(ctx context.Context, token *domain.APIToken, maxAge time.Duration) (string, time.Time, error) {
+	now := s.now()
+
+	expiresAt := now.Add(maxAge).Truncate(time.Second)
+	if token.ExpiresAt != nil && token.ExpiresAt.Before(expiresAt) {
+		expiresAt = *token.ExpiresAt
+	}
+
+	id, err := generateSessionID()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %w", domain.ErrStartSession, err)
+	}
+
+	session := &domain.AdminSession{
+		IDHash:    HashToken(id),
+		TokenID:   token.ID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %w", domain.ErrStartSession, err)
+	}
+
+	// Sessions that ran out without a logout are swept on the next sign-in
+	if _, err := s.sessionRepo.DeleteExpired(ctx, now); err != nil {
+		s.logger.Warn(ctx, "Failed to delete expired sessions", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	s.logger.Info(ctx, "Admin session started", map[string]interface{}{
+		"token_id":   token.ID,
+		"expires_at": expiresAt,
+	})
+
+	return id, expiresAt, nil
+}
+
+// ResumeSession resolves a session ID to the token it was started with. The
+// session ends as soon as that token is revoked or expires.
+func (s *SessionService) ResumeSession(ctx context.Context, id string) (*domain.APIToken, error) {
+	if id == "" {
+		return nil, domain.ErrSessionInvalid
+	}
+
+	session, err := s.sessionRepo.FindByHash(ctx, HashToken(id))
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return nil, domain.ErrSessionInvalid
+		}
+
+		return nil, err
+	}
+
+	now := s.now()
+
+	if session.IsExpired(now) {
+		return nil, domain.ErrSessionInvalid
+	}
+
+	token, err := s.tokens.tokenRepo.FindByID(ctx, session.TokenID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTokenNotFound) {
+			return nil, domain.ErrSessionInvalid
+		}
+
+		return nil, err
+	}
+
+	if err := checkActive(token, now); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// EndSession removes a session so its ID can no longer be used.
+func (s *SessionService) EndSession(ctx context.Context, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	return s.sessionRepo.Delete(ctx, HashToken(id))
+}
+
+// generateSessionID returns a new random session ID.
+func generateSessionID() (string, error) {
+	buf := make([]byte, sessionIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
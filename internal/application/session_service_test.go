@@ -0,0 +1,94 @@
+package application
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/infrastructure"
+	"holger-hahn-website/internal/testutil"
+)
+
+func newTestSessionService() (*SessionService, *TokenService, *infrastructure.MemoryTokenRepository) {
+	tokens, tokenRepo := newTestTokenService()
+	logger := infrastructure.NewSlogLoggingService(slog.New(slog.DiscardHandler))
+
+	return NewSessionService(tokens, infrastructure.NewMemorySessionRepository(), logger), tokens, tokenRepo
+}
+
+func TestSessionService(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	sessions, tokens, tokenRepo := newTestSessionService()
+
+	issued, err := tokens.IssueToken(ctx, IssueTokenRequest{Name: "editor", Scopes: []string{"content:write"}})
+	testutil.AssertNoError(t, err)
+
+	token, err := sessions.Authenticate(ctx, issued.Secret)
+	testutil.AssertNoError(t, err)
+
+	t.Run("resumes without the token secret", func(t *testing.T) {
+		id, expiresAt, err := sessions.StartSession(ctx, token, time.Hour)
+		testutil.AssertNoError(t, err)
+		testutil.AssertNotEqual(t, issued.Secret, id)
+		testutil.AssertTrue(t, expiresAt.After(time.Now().Add(59*time.Minute)), "Expected the session to last the max age")
+
+		lastUsed := *token.LastUsedAt
+
+		resumed, err := sessions.ResumeSession(ctx, id)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, token.ID, resumed.ID)
+
+		// Only signing in counts as using the token
+		stored, err := tokenRepo.FindByID(ctx, token.ID)
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, stored.LastUsedAt.Equal(lastUsed), "Expected no usage update")
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := sessions.ResumeSession(ctx, issued.Secret)
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrSessionInvalid), "Expected invalid session error")
+	})
+
+	t.Run("ended session", func(t *testing.T) {
+		id, _, err := sessions.StartSession(ctx, token, time.Hour)
+		testutil.AssertNoError(t, err)
+		testutil.AssertNoError(t, sessions.EndSession(ctx, id))
+
+		_, err = sessions.ResumeSession(ctx, id)
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrSessionInvalid), "Expected invalid session error")
+	})
+
+	t.Run("expired session", func(t *testing.T) {
+		id, _, err := sessions.StartSession(ctx, token, time.Minute)
+		testutil.AssertNoError(t, err)
+
+		sessions.now = func() time.Time { return time.Now().UTC().Add(2 * time.Minute) }
+		defer func() { sessions.now = func() time.Time { return time.Now().UTC() } }()
+
+		_, err = sessions.ResumeSession(ctx, id)
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrSessionInvalid), "Expected invalid session error")
+	})
+
+	t.Run("capped at the token expiry", func(t *testing.T) {
+		short, err := tokens.IssueToken(ctx, IssueTokenRequest{Name: "short", Scopes: []string{"content:write"}, TTL: time.Minute})
+		testutil.AssertNoError(t, err)
+
+		shortToken, err := sessions.Authenticate(ctx, short.Secret)
+		testutil.AssertNoError(t, err)
+
+		_, expiresAt, err := sessions.StartSession(ctx, shortToken, time.Hour)
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, expiresAt.Equal(*shortToken.ExpiresAt), "Expected the token expiry")
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		id, _, err := sessions.StartSession(ctx, token, time.Hour)
+		testutil.AssertNoError(t, err)
+		testutil.AssertNoError(t, tokens.RevokeToken(ctx, token.ID))
+
+		_, err = sessions.ResumeSession(ctx, id)
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrTokenRevoked), "Expected revoked token error")
+	})
+}
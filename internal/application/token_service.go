@@ -0,0 +1,236 @@
+// Package application contains the business logic and use cases for the portfolio website.
+// This file implements issuing, authenticating and revoking personal access tokens
+// used for scripted API access.
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+const (
+	// tokenPrefix marks personal access tokens so they are easy to spot in logs and secret scanners.
+	tokenPrefix = "hh_pat_"
+
+	// tokenSecretBytes is the amount of randomness in each token.
+	tokenSecretBytes = 32
+
+	// tokenDisplayChars is how many characters of the secret are kept for identification.
+	tokenDisplayChars = 6
+)
+
+// TokenService handles personal access token business logic.
+type TokenService struct {
+	tokenRepo domain.TokenRepository
+	logger    domain.LoggingService
+	now       func() time.Time
+}
+
+// NewTokenService creates a new token service.
+func NewTokenService(tokenRepo domain.TokenRepository, logger domain.LoggingService) *TokenService {
+	return &TokenService{
+		tokenRepo: tokenRepo,
+		logger:    logger,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// IssueToken creates a new token and returns its plaintext value exactly once.
+func (s *TokenService) IssueToken(ctx context.Context, req IssueTokenRequest) (*IssuedToken, error) {
+	scopes, err := domain.ParseScopes(req.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidationFailed, err)
+	}
+
+	if req.TTL < 0 {
+		return nil, fmt.Errorf("%w: token lifetime cannot be negative", domain.ErrValidationFailed)
+	}
+
+	secret, err := generateTokenSecret()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrIssueToken, err)
+	}
+
+	token := &domain.APIToken{
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    secret[:len(tokenPrefix)+tokenDisplayChars],
+		TokenHash: HashToken(secret),
+		Scopes:    scopes,
+		CreatedAt: s.now(),
+	}
+
+	if req.TTL > 0 {
+		expiresAt := s.now().Add(req.TTL)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := token.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrValidationFailed, err)
+	}
+
+	if err := s.tokenRepo.Save(ctx, token); err != nil {
+		s.logger.Error(ctx, "Failed to save token", err, map[string]interface{}{
+			"name": token.Name,
+		})
+
+		return nil, fmt.Errorf("%w: %w", domain.ErrIssueToken, err)
+	}
+
+	s.logger.Info(ctx, "API token issued", map[string]interface{}{
+		"token_id": token.ID,
+		"name":     token.Name,
+		"scopes":   req.Scopes,
+	})
+
+	return &IssuedToken{
+		Token:  toTokenDTO(token),
+		Secret: secret,
+	}, nil
+}
+
+// Authenticate resolves a plaintext token to an active token and records its use.
+func (s *TokenService) Authenticate(ctx context.Context, secret string) (*domain.APIToken, error) {
+	if !strings.HasPrefix(secret, tokenPrefix) {
+		return nil, domain.ErrTokenInvalid
+	}
+
+	token, err := s.tokenRepo.FindByHash(ctx, HashToken(secret))
+	if err != nil {
+		if errors.Is(err, domain.ErrTokenNotFound) {
+			return nil, domain.ErrTokenInvalid
+		}
+
+		return nil, err
+	}
+
+	now := s.now()
+
+	if err := checkActive(token, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.tokenRepo.TouchLastUsed(ctx, token.ID, now); err != nil {
+		// Usage tracking is best effort and must not block the request
+		s.logger.Warn(ctx, "Failed to record token usage", map[string]interface{}{
+			"token_id": token.ID,
+			"error":    err.Error(),
+		})
+	} else {
+		token.LastUsedAt = &now
+	}
+
+	return token, nil
+}
+
+// RevokeToken revokes a token so it can no longer be used.
+func (s *TokenService) RevokeToken(ctx context.Context, id string) error {
+	if err := s.tokenRepo.Revoke(ctx, id, s.now()); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrRevokeToken, err)
+	}
+
+	s.logger.Info(ctx, "API token revoked", map[string]interface{}{
+		"token_id": id,
+	})
+
+	return nil
+}
+
+// ListTokens returns all tokens without their secrets.
+func (s *TokenService) ListTokens(ctx context.Context) ([]*Token, error) {
+	tokens, err := s.tokenRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrListTokens, err)
+	}
+
+	result := make([]*Token, len(tokens))
+	for i, token := range tokens {
+		result[i] = toTokenDTO(token)
+	}
+
+	return result, nil
+}
+
+// checkActive rejects tokens that were revoked or have expired by now.
+func checkActive(token *domain.APIToken, now time.Time) error {
+	if token.IsRevoked() {
+		return domain.ErrTokenRevoked
+	}
+
+	if token.IsExpired(now) {
+		return domain.ErrTokenExpired
+	}
+
+	return nil
+}
+
+// HashToken returns the hex encoded SHA-256 hash under which a token is stored.
+// Tokens carry 256 bits of randomness, so a fast hash is sufficient.
+func HashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateTokenSecret returns a new random token with the personal access token prefix.
+func generateTokenSecret() (string, error) {
+	buf := make([]byte, tokenSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func toTokenDTO(token *domain.APIToken) *Token {
+	scopes := make([]string, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return &Token{
+		ID:         token.ID,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     scopes,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+	}
+}
+
+// DTOs for application layer.
+
+// IssueTokenRequest represents the parameters for issuing a new token.
+// A zero TTL issues a token that never expires.
+type IssueTokenRequest struct {
+	Name   string        `json:"name"`
+	Scopes []string      `json:"scopes"`
+	TTL    time.Duration `json:"ttl"`
+}
+
+// Token represents the application layer view of an API token without its secret.
+type Token struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+}
+
+// IssuedToken is returned once when a token is created and carries the plaintext secret.
+type IssuedToken struct {
+	*Token
+	Secret string `json:"token"`
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/infrastructure"
+	"holger-hahn-website/internal/testutil"
+)
+
+func newTestTokenService() (*TokenService, *infrastructure.MemoryTokenRepository) {
+	repo := infrastructure.NewMemoryTokenRepository()
+	logger := infrastructure.NewSlogLoggingService(slog.New(slog.DiscardHandler))
+
+	return NewTokenService(repo, logger), repo
+}
+
+func TestTokenService_IssueToken(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	service, repo := newTestTokenService()
+
+	t.Run("stores only the hash", func(t *testing.T) {
+		issued, err := service.IssueToken(ctx, IssueTokenRequest{
+			Name:   "ci",
+			Scopes: []string{"content:write", "contacts:read"},
+			TTL:    time.Hour,
+		})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, strings.HasPrefix(issued.Secret, tokenPrefix), "Expected token prefix")
+		testutil.AssertTrue(t, strings.HasPrefix(issued.Secret, issued.Prefix), "Expected display prefix to match")
+		testutil.AssertNotNil(t, issued.ExpiresAt)
+
+		stored, err := repo.FindByID(ctx, issued.ID)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, HashToken(issued.Secret), stored.TokenHash)
+		testutil.AssertNotEqual(t, issued.Secret, stored.TokenHash)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, err := service.IssueToken(ctx, IssueTokenRequest{Name: "ci", Scopes: []string{"admin"}})
+
+		testutil.AssertError(t, err)
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrInvalidScope), "Expected invalid scope error")
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := service.IssueToken(ctx, IssueTokenRequest{Scopes: []string{"content:write"}})
+
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrTokenNameEmpty), "Expected name error")
+	})
+}
+
+func TestTokenService_Authenticate(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	service, _ := newTestTokenService()
+
+	issued, err := service.IssueToken(ctx, IssueTokenRequest{Name: "ci", Scopes: []string{"content:write"}})
+	testutil.AssertNoError(t, err)
+
+	t.Run("valid token records usage", func(t *testing.T) {
+		token, err := service.Authenticate(ctx, issued.Secret)
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, token.HasScope(domain.ScopeContentWrite), "Expected content:write scope")
+		testutil.AssertFalse(t, token.HasScope(domain.ScopeContactsRead), "Expected no contacts:read scope")
+		testutil.AssertNotNil(t, token.LastUsedAt)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := service.Authenticate(ctx, tokenPrefix+"nope")
+
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrTokenInvalid), "Expected invalid token error")
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		short, err := service.IssueToken(ctx, IssueTokenRequest{Name: "short", Scopes: []string{"content:write"}, TTL: time.Minute})
+		testutil.AssertNoError(t, err)
+
+		service.now = func() time.Time { return time.Now().UTC().Add(2 * time.Minute) }
+		defer func() { service.now = func() time.Time { return time.Now().UTC() } }()
+
+		_, err = service.Authenticate(ctx, short.Secret)
+
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrTokenExpired), "Expected expired token error")
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		testutil.AssertNoError(t, service.RevokeToken(ctx, issued.ID))
+
+		_, err := service.Authenticate(ctx, issued.Secret)
+
+		testutil.AssertTrue(t, errors.Is(err, domain.ErrTokenRevoked), "Expected revoked token error")
+	})
+}
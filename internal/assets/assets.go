@@ -0,0 +1,117 @@
+// Package assets fingerprints static files and resolves their URLs. The static
+// build and the Server both name every asset after a hash of its content, so
+// the files can be cached forever, and record the names in a Manifest that
+// templates consult through the request or build context.
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// hashLength is the number of hex digits of the content hash in a
+// fingerprinted name.
+const hashLength = 8
+
+// ManifestFile is the name the static build writes the manifest under.
+const ManifestFile = "asset-manifest.json"
+
+// compressible lists the extensions worth precompressing. Images are already
+// compressed.
+var compressible = map[string]bool{
+	".css":  true,
+	".js":   true,
+	".svg":  true,
+	".html": true,
+	".json": true,
+	".xml":  true,
+	".txt":  true,
+}
+
+// Manifest maps asset URL paths, e.g. "/static/css/styles.css", to their
+// fingerprinted paths.
+type Manifest map[string]string
+
+type manifestKey struct{}
+
+// WithManifest returns a context whose templates resolve asset URLs with m.
+func WithManifest(ctx context.Context, m Manifest) context.Context {
+	return context.WithValue(ctx, manifestKey{}, m)
+}
+
+// URL returns the fingerprinted path of the asset at urlPath, or urlPath
+// itself when ctx carries no manifest or the manifest does not know it.
+func URL(ctx context.Context, urlPath string) string {
+	if m, ok := ctx.Value(manifestKey{}).(Manifest); ok {
+		if fingerprinted, ok := m[urlPath]; ok {
+			return fingerprinted
+		}
+	}
+
+	return urlPath
+}
+
+// Hash returns the hex encoded SHA-256 of data.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Fingerprint inserts a hash of data before the extension of urlPath, e.g.
+// "/static/css/styles.css" becomes "/static/css/styles.3f2a9c1b.css".
+func Fingerprint(urlPath string, data []byte) string {
+	ext := path.Ext(urlPath)
+
+	return strings.TrimSuffix(urlPath, ext) + "." + Hash(data)[:hashLength] + ext
+}
+
+// Compressible reports whether files named name benefit from precompression.
+func Compressible(name string) bool {
+	return compressible[strings.ToLower(path.Ext(name))]
+}
+
+// Gzip compresses data at the best compression level.
+func Gzip(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to gzip: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to gzip: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Brotli compresses data at the best compression level.
+func Brotli(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := brotli.NewWriterLevel(&buf, brotli.BestCompression)
+
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to brotli compress: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to brotli compress: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
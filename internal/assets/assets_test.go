@@ -0,0 +1,56 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestFingerprint(t *testing.T) {
+	css := []byte("body { color: #141d55; }")
+
+	fingerprinted := Fingerprint("/static/css/styles.css", css)
+	testutil.AssertEqual(t, "/static/css/styles."+Hash(css)[:hashLength]+".css", fingerprinted)
+	testutil.AssertEqual(t, fingerprinted, Fingerprint("/static/css/styles.css", css))
+	testutil.AssertTrue(t, fingerprinted != Fingerprint("/static/css/styles.css", []byte("body {}")), "Changed content should change the name")
+}
+
+func TestURL(t *testing.T) {
+	ctx := context.Background()
+	testutil.AssertEqual(t, "/static/css/styles.css", URL(ctx, "/static/css/styles.css"))
+
+	ctx = WithManifest(ctx, Manifest{"/static/css/styles.css": "/static/css/styles.0123abcd.css"})
+	testutil.AssertEqual(t, "/static/css/styles.0123abcd.css", URL(ctx, "/static/css/styles.css"))
+	testutil.AssertEqual(t, "/static/unknown.js", URL(ctx, "/static/unknown.js"))
+}
+
+func TestCompression(t *testing.T) {
+	testutil.AssertTrue(t, Compressible("index.html"), "HTML should be precompressed")
+	testutil.AssertTrue(t, Compressible("/static/images/icons/xml.SVG"), "SVG should be precompressed")
+	testutil.AssertTrue(t, !Compressible("Holger-Hahn.webp"), "WebP is already compressed")
+
+	data := bytes.Repeat([]byte("<p>Digital asset custody</p>\n"), 100)
+
+	gz, err := Gzip(data)
+	testutil.AssertNoError(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	testutil.AssertNoError(t, err)
+
+	plain, err := io.ReadAll(r)
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, bytes.Equal(data, plain), "Gzip should round-trip")
+
+	br, err := Brotli(data)
+	testutil.AssertNoError(t, err)
+
+	plain, err = io.ReadAll(brotli.NewReader(bytes.NewReader(br)))
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, bytes.Equal(data, plain), "Brotli should round-trip")
+	testutil.AssertTrue(t, len(br) < len(data), "Brotli should shrink repetitive text")
+}
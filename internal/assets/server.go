@@ -0,0 +1,207 @@
+package assets
+
+import (
+	"bytes"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// Cache policies of served files. Fingerprinted names never change content;
+// every other name is revalidated against its ETag on each use.
+const (
+	ImmutableCache  = "public, max-age=31536000, immutable"
+	revalidateCache = "public, no-cache"
+)
+
+// Content codings offered for compressible files, best first.
+const (
+	codingBrotli = "br"
+	codingGzip   = "gzip"
+)
+
+// file is one static file ready to be served.
+type file struct {
+	modTime   time.Time
+	variants  map[string][]byte
+	name      string
+	hash      string
+	body      []byte
+	immutable bool
+}
+
+// Server serves the files of a file system below a URL prefix, e.g.
+// "/static". Responses carry content-hash ETags and cache headers, and
+// compressible files are sent Brotli or gzip encoded when the client accepts
+// it.
+type Server struct {
+	fsys     fs.FS
+	files    map[string]*file
+	manifest Manifest
+	prefix   string
+}
+
+// NewServer loads and precompresses every file of fsys and serves each under
+// its own name and its fingerprinted name, as recorded in the Manifest.
+func NewServer(fsys fs.FS, prefix string) (*Server, error) {
+	s := &Server{fsys: fsys, prefix: prefix, files: map[string]*file{}, manifest: Manifest{}}
+
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		f, err := s.load(name)
+		if err != nil {
+			return err
+		}
+
+		err = f.compress()
+		if err != nil {
+			return err
+		}
+
+		urlPath := path.Join(prefix, name)
+		fingerprinted := Fingerprint(urlPath, f.body)
+
+		s.files[urlPath] = f
+		s.manifest[urlPath] = fingerprinted
+
+		immutable := *f
+		immutable.immutable = true
+		s.files[fingerprinted] = &immutable
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// NewLiveServer serves fsys without loading anything up front, so that edits
+// show up on the next request. It is meant for development: names are not
+// fingerprinted and responses are not compressed.
+func NewLiveServer(fsys fs.FS, prefix string) *Server {
+	return &Server{fsys: fsys, prefix: prefix}
+}
+
+// Manifest maps the URL path of every file to its fingerprinted path. It is
+// empty for live servers.
+func (s *Server) Manifest() Manifest {
+	return s.manifest
+}
+
+// ServeHTTP serves the file at the request path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f := s.lookup(r.URL.Path)
+	if f == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, etag := f.body, f.hash
+
+	if len(f.variants) > 0 {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		for _, coding := range []string{codingBrotli, codingGzip} {
+			if accepts(r.Header.Get("Accept-Encoding"), coding) {
+				body, etag = f.variants[coding], f.hash+"-"+coding
+				w.Header().Set("Content-Encoding", coding)
+
+				break
+			}
+		}
+	}
+
+	cacheControl := revalidateCache
+	if f.immutable {
+		cacheControl = ImmutableCache
+	}
+
+	if contentType := mime.TypeByExtension(path.Ext(f.name)); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	w.Header().Set("ETag", `"`+etag+`"`)
+	w.Header().Set("Cache-Control", cacheControl)
+
+	http.ServeContent(w, r, f.name, f.modTime, bytes.NewReader(body))
+}
+
+// lookup returns the file served at urlPath, or nil.
+func (s *Server) lookup(urlPath string) *file {
+	if s.files != nil {
+		return s.files[urlPath]
+	}
+
+	name, ok := strings.CutPrefix(urlPath, s.prefix+"/")
+	if !ok || !fs.ValidPath(name) {
+		return nil
+	}
+
+	f, err := s.load(name)
+	if err != nil {
+		return nil
+	}
+
+	return f
+}
+
+// load reads the file name of the server's file system.
+func (s *Server) load(name string) (*file, error) {
+	body, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &file{name: name, body: body, hash: Hash(body)}
+
+	if info, err := fs.Stat(s.fsys, name); err == nil {
+		f.modTime = info.ModTime()
+	}
+
+	return f, nil
+}
+
+// compress adds the encoded variants of compressible files.
+func (f *file) compress() error {
+	if !Compressible(f.name) {
+		return nil
+	}
+
+	br, err := Brotli(f.body)
+	if err != nil {
+		return err
+	}
+
+	gz, err := Gzip(f.body)
+	if err != nil {
+		return err
+	}
+
+	f.variants = map[string][]byte{codingBrotli: br, codingGzip: gz}
+
+	return nil
+}
+
+// accepts reports whether an Accept-Encoding header allows coding.
+func accepts(header, coding string) bool {
+	for _, accepted := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(accepted), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), coding) {
+			continue
+		}
+
+		q, ok := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q=")
+
+		return !ok || strings.Trim(q, "0.") != ""
+	}
+
+	return false
+}
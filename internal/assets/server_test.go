@@ -0,0 +1,103 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+func testFiles() fstest.MapFS {
+	return fstest.MapFS{
+		"css/styles.css":   {Data: []byte("body { color: navy; }")},
+		"images/photo.png": {Data: []byte("png")},
+	}
+}
+
+func serve(h http.Handler, target string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServerServesFingerprintedFiles(t *testing.T) {
+	s, err := NewServer(testFiles(), "/static")
+	testutil.AssertNoError(t, err)
+
+	hashed := s.Manifest()["/static/css/styles.css"]
+	testutil.AssertEqual(t, Fingerprint("/static/css/styles.css", []byte("body { color: navy; }")), hashed)
+
+	rec := serve(s, "/static/css/styles.css", nil)
+	testutil.AssertEqual(t, http.StatusOK, rec.Code)
+	testutil.AssertEqual(t, revalidateCache, rec.Header().Get("Cache-Control"))
+	testutil.AssertEqual(t, "body { color: navy; }", rec.Body.String())
+	testutil.AssertEqual(t, "Accept-Encoding", rec.Header().Get("Vary"))
+
+	rec = serve(s, hashed, nil)
+	testutil.AssertEqual(t, http.StatusOK, rec.Code)
+	testutil.AssertEqual(t, ImmutableCache, rec.Header().Get("Cache-Control"))
+
+	etag := rec.Header().Get("ETag")
+	rec = serve(s, hashed, map[string]string{"If-None-Match": etag})
+	testutil.AssertEqual(t, http.StatusNotModified, rec.Code)
+
+	rec = serve(s, "/static/missing.css", nil)
+	testutil.AssertEqual(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServerNegotiatesEncoding(t *testing.T) {
+	s, err := NewServer(testFiles(), "/static")
+	testutil.AssertNoError(t, err)
+
+	rec := serve(s, "/static/css/styles.css", map[string]string{"Accept-Encoding": "gzip, br;q=0"})
+	testutil.AssertEqual(t, "gzip", rec.Header().Get("Content-Encoding"))
+
+	reader, err := gzip.NewReader(bytes.NewReader(rec.Body.Bytes()))
+	testutil.AssertNoError(t, err)
+
+	plain, err := io.ReadAll(reader)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "body { color: navy; }", string(plain))
+
+	rec = serve(s, "/static/css/styles.css", map[string]string{"Accept-Encoding": "gzip, br"})
+	testutil.AssertEqual(t, "br", rec.Header().Get("Content-Encoding"))
+
+	// Images are compressed already.
+	rec = serve(s, "/static/images/photo.png", map[string]string{"Accept-Encoding": "gzip, br"})
+	testutil.AssertEqual(t, "", rec.Header().Get("Content-Encoding"))
+	testutil.AssertEqual(t, "image/png", rec.Header().Get("Content-Type"))
+}
+
+func TestLiveServerReadsOnEveryRequest(t *testing.T) {
+	files := testFiles()
+	s := NewLiveServer(files, "/static")
+
+	testutil.AssertEqual(t, 0, len(s.Manifest()))
+
+	files["css/styles.css"] = &fstest.MapFile{Data: []byte("body { color: teal; }")}
+
+	rec := serve(s, "/static/css/styles.css", map[string]string{"Accept-Encoding": "br"})
+	testutil.AssertEqual(t, "body { color: teal; }", rec.Body.String())
+	testutil.AssertEqual(t, revalidateCache, rec.Header().Get("Cache-Control"))
+
+	rec = serve(s, "/static/../secret", nil)
+	testutil.AssertEqual(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAccepts(t *testing.T) {
+	testutil.AssertTrue(t, accepts("gzip, deflate, br", "br"), "br listed")
+	testutil.AssertTrue(t, accepts("br;q=0.5", "br"), "br with weight")
+	testutil.AssertTrue(t, !accepts("br;q=0", "br"), "br refused")
+	testutil.AssertTrue(t, !accepts("gzip", "br"), "br not listed")
+}
@@ -1,82 +1,169 @@
 // Package config provides configuration loading and validation for the portfolio website.
-// It handles environment variable parsing, defaults, and configuration validation
-// to ensure the application starts with valid settings.
+// One configuration tree is assembled from defaults, an optional config file, environment
+// variables and command line flags, in increasing precedence, and validated before use.
 package config
 
 import (
 	"errors"
 	"fmt"
-	"os"
-	"strconv"
+	"net/url"
+	"slices"
+	"time"
 
 	"holger-hahn-website/internal/constants"
 )
 
 // Static error variables to avoid dynamic error creation.
 var (
-	ErrInvalidServerPort     = errors.New("invalid server port")
-	ErrInvalidReadTimeout    = errors.New("invalid read timeout")
-	ErrInvalidWriteTimeout   = errors.New("invalid write timeout")
-	ErrEmptyDatabaseType     = errors.New("database type cannot be empty")
-	ErrEmptyConnectionString = errors.New("database connection string cannot be empty")
-	ErrInvalidLogLevel       = errors.New("invalid log level")
+	ErrInvalidServerPort      = errors.New("invalid server port")
+	ErrInvalidReadTimeout     = errors.New("invalid read timeout")
+	ErrInvalidWriteTimeout    = errors.New("invalid write timeout")
+	ErrInvalidShutdownTimeout = errors.New("invalid shutdown timeout")
+	ErrEmptyDatabaseType      = errors.New("database type cannot be empty")
+	ErrUnsupportedDatabase    = errors.New("unsupported database type")
+	ErrEmptyConnectionString  = errors.New("database connection string cannot be empty")
+	ErrInvalidLogLevel        = errors.New("invalid log level")
+	ErrInvalidLogFormat       = errors.New("invalid log format")
+	ErrInvalidLogOutput       = errors.New("invalid log output")
+	ErrInvalidPublicURL       = errors.New("invalid public URL")
+	ErrInvalidTraceExporter   = errors.New("invalid trace exporter")
+	ErrInvalidEmailMode       = errors.New("invalid email mode")
+	ErrInvalidSMTPPort        = errors.New("invalid SMTP port")
+	ErrInvalidEmailQueueSize  = errors.New("invalid email queue size")
+	ErrInvalidTokenTTL        = errors.New("invalid token TTL")
+	ErrInvalidSessionMaxAge   = errors.New("invalid session max age")
+	ErrInvalidAnalyticsHost   = errors.New("invalid analytics host")
+	ErrInvalidCacheAge        = errors.New("invalid cache age")
+	ErrInvalidValue           = errors.New("invalid configuration value")
+	ErrReadConfigFile         = errors.New("cannot read config file")
+	ErrResolveSecret          = errors.New("cannot resolve secret")
+	ErrMissingSecretsKey      = errors.New("secrets file needs a key")
 )
 
 // Config holds application configuration.
 type Config struct {
-	Logging  LoggingConfig  `json:"logging"`
-	Database DatabaseConfig `json:"database"`
-	Server   ServerConfig   `json:"server"`
+	Logging   LoggingConfig   `json:"logging" mapstructure:"logging"`
+	Tracing   TracingConfig   `json:"tracing" mapstructure:"tracing"`
+	Metrics   MetricsConfig   `json:"metrics" mapstructure:"metrics"`
+	Database  DatabaseConfig  `json:"database" mapstructure:"database"`
+	Server    ServerConfig    `json:"server" mapstructure:"server"`
+	Email     EmailConfig     `json:"email" mapstructure:"email"`
+	Auth      AuthConfig      `json:"auth" mapstructure:"auth"`
+	Analytics AnalyticsConfig `json:"analytics" mapstructure:"analytics"`
+	Cache     CacheConfig     `json:"cache" mapstructure:"cache"`
+	Features  FeatureFlags    `json:"features" mapstructure:"features"`
+	Secrets   SecretsConfig   `json:"secrets" mapstructure:"secrets"`
 }
 
 // ServerConfig holds server-related configuration.
 type ServerConfig struct {
-	Host         string `json:"host"`
-	Environment  string `json:"environment"`
-	Port         int    `json:"port"`
-	ReadTimeout  int    `json:"read_timeout"`
-	WriteTimeout int    `json:"write_timeout"`
+	Host            string `json:"host" mapstructure:"host"`
+	Environment     string `json:"environment" mapstructure:"environment"`
+	PublicURL       string `json:"public_url" mapstructure:"public_url"`
+	StaticDir       string `json:"static_dir" mapstructure:"static_dir"`
+	Port            int    `json:"port" mapstructure:"port"`
+	ReadTimeout     int    `json:"read_timeout" mapstructure:"read_timeout"`
+	WriteTimeout    int    `json:"write_timeout" mapstructure:"write_timeout"`
+	ShutdownTimeout int    `json:"shutdown_timeout" mapstructure:"shutdown_timeout"`
 }
 
-// DatabaseConfig holds database-related configuration.
+// DatabaseConfig holds database-related configuration. For SQLite the
+// connection string is the path of the database file.
 type DatabaseConfig struct {
-	Type             string `json:"type"`
-	ConnectionString string `json:"connection_string"`
-	MigrationsPath   string `json:"migrations_path"`
-	MaxOpenConns     int    `json:"max_open_conns"`
-	MaxIdleConns     int    `json:"max_idle_conns"`
+	Type             string `json:"type" mapstructure:"type"`
+	ConnectionString string `json:"connection_string" mapstructure:"connection_string"`
+	MigrationsPath   string `json:"migrations_path" mapstructure:"migrations_path"`
+	MaxOpenConns     int    `json:"max_open_conns" mapstructure:"max_open_conns"`
+	MaxIdleConns     int    `json:"max_idle_conns" mapstructure:"max_idle_conns"`
+	AutoMigrate      bool   `json:"auto_migrate" mapstructure:"auto_migrate"`
 }
 
-// LoggingConfig holds logging-related configuration.
+// LoggingConfig holds logging-related configuration. With Output "file" logs
+// go to File, which is rotated once it reaches MaxSizeMB.
 type LoggingConfig struct {
-	Level  string `json:"level"`
-	Format string `json:"format"`
-	Output string `json:"output"`
-}
-
-// LoadConfig loads configuration from environment variables with defaults.
-func LoadConfig() *Config {
-	return &Config{
-		Server: ServerConfig{
-			Host:         getEnv("SERVER_HOST", "localhost"),
-			Port:         getEnvAsInt("SERVER_PORT", constants.DefaultServerPort),
-			ReadTimeout:  getEnvAsInt("SERVER_READ_TIMEOUT", constants.DefaultReadTimeoutSeconds),
-			WriteTimeout: getEnvAsInt("SERVER_WRITE_TIMEOUT", constants.DefaultWriteTimeoutSeconds),
-			Environment:  getEnv("ENVIRONMENT", "development"),
-		},
-		Database: DatabaseConfig{
-			Type:             getEnv("DB_TYPE", "sqlite"),
-			ConnectionString: getEnv("DB_CONNECTION_STRING", "./data/portfolio.db"),
-			MaxOpenConns:     getEnvAsInt("DB_MAX_OPEN_CONNS", constants.DefaultMaxOpenConnections),
-			MaxIdleConns:     getEnvAsInt("DB_MAX_IDLE_CONNS", constants.DefaultMaxIdleConnections),
-			MigrationsPath:   getEnv("DB_MIGRATIONS_PATH", "./migrations"),
-		},
-		Logging: LoggingConfig{
-			Level:  getEnv("LOG_LEVEL", "info"),
-			Format: getEnv("LOG_FORMAT", "json"),
-			Output: getEnv("LOG_OUTPUT", "stdout"),
-		},
-	}
+	Level      string `json:"level" mapstructure:"level"`
+	Format     string `json:"format" mapstructure:"format"`
+	Output     string `json:"output" mapstructure:"output"`
+	File       string `json:"file" mapstructure:"file"`
+	MaxSizeMB  int    `json:"max_size_mb" mapstructure:"max_size_mb"`
+	MaxBackups int    `json:"max_backups" mapstructure:"max_backups"`
+	MaxAgeDays int    `json:"max_age_days" mapstructure:"max_age_days"`
+}
+
+// TracingConfig holds tracing-related configuration. The OTLP exporter reads
+// its endpoint and headers from the standard OTEL_EXPORTER_OTLP_* variables.
+type TracingConfig struct {
+	Exporter    string `json:"exporter" mapstructure:"exporter"`
+	ServiceName string `json:"service_name" mapstructure:"service_name"`
+}
+
+// MetricsConfig holds metrics-related configuration.
+type MetricsConfig struct {
+	// RequireToken protects /metrics with a metrics:read API token.
+	RequireToken bool `json:"require_token" mapstructure:"require_token"`
+}
+
+// EmailConfig holds the contact form email configuration. In "development"
+// mode emails are printed instead of sent; in "smtp" mode they are sent
+// through the SMTP server.
+type EmailConfig struct {
+	Mode         string `json:"mode" mapstructure:"mode"`
+	SMTPHost     string `json:"smtp_host" mapstructure:"smtp_host"`
+	SMTPUsername string `json:"smtp_username" mapstructure:"smtp_username"`
+	SMTPPassword string `json:"smtp_password" mapstructure:"smtp_password" secret:"true"`
+	From         string `json:"from" mapstructure:"from"`
+	To           string `json:"to" mapstructure:"to"`
+	SMTPPort     int    `json:"smtp_port" mapstructure:"smtp_port"`
+	QueueSize    int    `json:"queue_size" mapstructure:"queue_size"`
+	SMTPTLS      bool   `json:"smtp_tls" mapstructure:"smtp_tls"`
+}
+
+// IsDevelopment reports whether emails are printed instead of sent.
+func (e *EmailConfig) IsDevelopment() bool {
+	return e.Mode == "development"
+}
+
+// AuthConfig holds API token and admin session configuration.
+type AuthConfig struct {
+	// TokenTTL is the default lifetime of issued API tokens.
+	TokenTTL time.Duration `json:"token_ttl" mapstructure:"token_ttl"`
+	// SessionMaxAge is how long the content editor stays signed in.
+	SessionMaxAge time.Duration `json:"session_max_age" mapstructure:"session_max_age"`
+}
+
+// AnalyticsConfig holds the PostHog configuration of the public pages. An
+// empty key disables analytics.
+type AnalyticsConfig struct {
+	PostHogKey  string `json:"posthog_key" mapstructure:"posthog_key"`
+	PostHogHost string `json:"posthog_host" mapstructure:"posthog_host"`
+}
+
+// CacheConfig holds the in-process cache of rendered pages and API reads and
+// the caching headers they are served with to browsers and the CDN.
+type CacheConfig struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// SharedMaxAge is how long a CDN may serve a page without revalidating it.
+	SharedMaxAge time.Duration `json:"shared_max_age" mapstructure:"shared_max_age"`
+	// StaleWhileRevalidate is how long after that a CDN may keep serving the
+	// stale page while it revalidates in the background.
+	StaleWhileRevalidate time.Duration `json:"stale_while_revalidate" mapstructure:"stale_while_revalidate"`
+}
+
+// FeatureFlags switch optional parts of the site on and off. They take
+// effect on reload, without a restart.
+type FeatureFlags struct {
+	GraphQL bool `json:"graphql" mapstructure:"graphql"`
+	Admin   bool `json:"admin" mapstructure:"admin"`
+	PDF     bool `json:"pdf" mapstructure:"pdf"`
+}
+
+// SecretsConfig holds where secret references such as "secret:smtp_password"
+// are looked up besides *_FILE variables: an encrypted local file, opened with
+// Key, and a cloud secret manager URL.
+type SecretsConfig struct {
+	File  string `json:"file" mapstructure:"file"`
+	Key   string `json:"key" mapstructure:"key" secret:"true"`
+	Cloud string `json:"cloud" mapstructure:"cloud"`
 }
 
 // Address returns the server address in the format host:port.
@@ -94,58 +181,67 @@ func (s *ServerConfig) IsProduction() bool {
 	return s.Environment == "production"
 }
 
-// Validate validates the configuration.
+// Validate validates the configuration and reports every invalid setting,
+// not just the first.
 func (c *Config) Validate() error {
-	if c.Server.Port < constants.MinValidPort || c.Server.Port > constants.MaxValidPort {
-		return fmt.Errorf("%w: %d", ErrInvalidServerPort, c.Server.Port)
-	}
+	var errs []error
 
-	if c.Server.ReadTimeout <= 0 {
-		return fmt.Errorf("%w: %d", ErrInvalidReadTimeout, c.Server.ReadTimeout)
+	check := func(ok bool, err error) {
+		if !ok {
+			errs = append(errs, err)
+		}
 	}
 
-	if c.Server.WriteTimeout <= 0 {
-		return fmt.Errorf("%w: %d", ErrInvalidWriteTimeout, c.Server.WriteTimeout)
+	check(c.Server.Port >= constants.MinValidPort && c.Server.Port <= constants.MaxValidPort,
+		fmt.Errorf("%w: %d", ErrInvalidServerPort, c.Server.Port))
+	check(c.Server.ReadTimeout > 0, fmt.Errorf("%w: %d", ErrInvalidReadTimeout, c.Server.ReadTimeout))
+	check(c.Server.WriteTimeout > 0, fmt.Errorf("%w: %d", ErrInvalidWriteTimeout, c.Server.WriteTimeout))
+	check(c.Server.ShutdownTimeout > 0, fmt.Errorf("%w: %d", ErrInvalidShutdownTimeout, c.Server.ShutdownTimeout))
+	check(c.Server.PublicURL == "" || isAbsoluteURL(c.Server.PublicURL),
+		fmt.Errorf("%w: %s", ErrInvalidPublicURL, c.Server.PublicURL))
+
+	switch c.Database.Type {
+	case "":
+		errs = append(errs, ErrEmptyDatabaseType)
+	case "sqlite":
+	default:
+		errs = append(errs, fmt.Errorf("%w: %s (only sqlite is supported)", ErrUnsupportedDatabase, c.Database.Type))
 	}
 
-	if c.Database.Type == "" {
-		return ErrEmptyDatabaseType
-	}
+	check(c.Database.ConnectionString != "", ErrEmptyConnectionString)
 
-	if c.Database.ConnectionString == "" {
-		return ErrEmptyConnectionString
-	}
+	check(oneOf(c.Logging.Level, "debug", "info", "warn", "error"), fmt.Errorf("%w: %s", ErrInvalidLogLevel, c.Logging.Level))
+	check(oneOf(c.Logging.Format, "json", "text"), fmt.Errorf("%w: %s", ErrInvalidLogFormat, c.Logging.Format))
+	check(oneOf(c.Logging.Output, "stdout", "stderr", "file"), fmt.Errorf("%w: %s", ErrInvalidLogOutput, c.Logging.Output))
+	check(oneOf(c.Tracing.Exporter, "", "none", "stdout", "otlp"), fmt.Errorf("%w: %s", ErrInvalidTraceExporter, c.Tracing.Exporter))
 
-	validLogLevels := map[string]bool{
-		"debug": true,
-		"info":  true,
-		"warn":  true,
-		"error": true,
-	}
+	check(oneOf(c.Email.Mode, "development", "smtp"),
+		fmt.Errorf("%w: %s (want development or smtp)", ErrInvalidEmailMode, c.Email.Mode))
+	check(c.Email.Mode != "smtp" || (c.Email.SMTPPort >= constants.MinValidPort && c.Email.SMTPPort <= constants.MaxValidPort),
+		fmt.Errorf("%w: %d", ErrInvalidSMTPPort, c.Email.SMTPPort))
+	check(c.Email.QueueSize >= 1, fmt.Errorf("%w: %d (want at least 1)", ErrInvalidEmailQueueSize, c.Email.QueueSize))
 
-	if !validLogLevels[c.Logging.Level] {
-		return fmt.Errorf("%w: %s", ErrInvalidLogLevel, c.Logging.Level)
-	}
+	check(c.Auth.TokenTTL >= 0, fmt.Errorf("%w: %s", ErrInvalidTokenTTL, c.Auth.TokenTTL))
+	check(c.Auth.SessionMaxAge >= 0, fmt.Errorf("%w: %s", ErrInvalidSessionMaxAge, c.Auth.SessionMaxAge))
 
-	return nil
-}
+	check(c.Cache.SharedMaxAge >= 0, fmt.Errorf("%w: shared_max_age %s", ErrInvalidCacheAge, c.Cache.SharedMaxAge))
+	check(c.Cache.StaleWhileRevalidate >= 0,
+		fmt.Errorf("%w: stale_while_revalidate %s", ErrInvalidCacheAge, c.Cache.StaleWhileRevalidate))
 
-// getEnv gets an environment variable with a default value.
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
+	check(c.Analytics.PostHogKey == "" || isAbsoluteURL(c.Analytics.PostHogHost),
+		fmt.Errorf("%w: %s", ErrInvalidAnalyticsHost, c.Analytics.PostHogHost))
 
-	return defaultValue
+	return errors.Join(errs...)
 }
 
-// getEnvAsInt gets an environment variable as an integer with a default value.
-func getEnvAsInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if intValue, err := strconv.Atoi(value); err == nil {
-			return intValue
-		}
-	}
+// oneOf reports whether value is one of allowed.
+func oneOf(value string, allowed ...string) bool {
+	return slices.Contains(allowed, value)
+}
+
+// isAbsoluteURL reports whether raw is an http or https URL with a host.
+func isAbsoluteURL(raw string) bool {
+	u, err := url.Parse(raw)
 
-	return defaultValue
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
 }
@@ -0,0 +1,391 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/secrets"
+)
+
+// configFileEnv names the config file when no --config flag is given.
+const configFileEnv = "CONFIG_FILE"
+
+// configFlag is the flag naming the config file.
+const configFlag = "config"
+
+// defaultConfigFiles are looked for in the working directory, in order.
+var defaultConfigFiles = []string{"config.yaml", "config.yml", "config.json", "config.toml"}
+
+// binding ties a configuration key to its default, its environment variable
+// and, optionally, the command line flag overriding it.
+type binding struct {
+	key    string
+	env    string
+	def    any
+	flag   string
+	usage  string
+	secret bool
+}
+
+// bindings lists every configuration key. The type of the default is the type
+// values from the environment and from files must parse as. Secret keys may
+// also be read from a file named by <ENV>_FILE and may hold a reference to a
+// secret in the form "secret:<name>".
+var bindings = []binding{
+	{key: "server.host", env: "SERVER_HOST", def: "localhost", flag: "host", usage: "interface to listen on"},
+	{key: "server.port", env: "SERVER_PORT", def: constants.DefaultServerPort, flag: "port", usage: "port to listen on"},
+	{key: "server.read_timeout", env: "SERVER_READ_TIMEOUT", def: constants.DefaultReadTimeoutSeconds},
+	{key: "server.write_timeout", env: "SERVER_WRITE_TIMEOUT", def: constants.DefaultWriteTimeoutSeconds},
+	{key: "server.shutdown_timeout", env: "SERVER_SHUTDOWN_TIMEOUT", def: constants.DefaultShutdownTimeoutSeconds},
+	{key: "server.environment", env: "ENVIRONMENT", def: "development", flag: "environment", usage: "development or production"},
+	{key: "server.public_url", env: "PUBLIC_URL", def: constants.DefaultPublicURL, flag: "public-url", usage: "canonical address of the site"},
+	{key: "server.static_dir", env: "STATIC_DIR", def: "", flag: "static-dir", usage: "serve static files from this directory instead of the binary"},
+
+	{key: "database.type", env: "DB_TYPE", def: "sqlite"},
+	{key: "database.connection_string", env: "DB_CONNECTION_STRING", def: constants.DefaultDatabasePath, flag: "db", usage: "SQLite database file"},
+	{key: "database.migrations_path", env: "DB_MIGRATIONS_PATH", def: "./migrations"},
+	{key: "database.max_open_conns", env: "DB_MAX_OPEN_CONNS", def: constants.DefaultMaxOpenConnections},
+	{key: "database.max_idle_conns", env: "DB_MAX_IDLE_CONNS", def: constants.DefaultMaxIdleConnections},
+	{key: "database.auto_migrate", env: "DB_AUTO_MIGRATE", def: true},
+
+	{key: "email.mode", env: "EMAIL_MODE", def: "development", flag: "email-mode", usage: "development prints emails, smtp sends them"},
+	{key: "email.smtp_host", env: "SMTP_HOST", def: "localhost"},
+	{key: "email.smtp_port", env: "SMTP_PORT", def: constants.DefaultSMTPPort},
+	{key: "email.smtp_username", env: "SMTP_USERNAME", def: ""},
+	{key: "email.smtp_password", env: "SMTP_PASSWORD", def: "", secret: true},
+	{key: "email.smtp_tls", env: "SMTP_TLS", def: true},
+	{key: "email.from", env: "FROM_EMAIL", def: constants.DefaultEmailAddress},
+	{key: "email.to", env: "TO_EMAIL", def: constants.DefaultEmailAddress},
+	{key: "email.queue_size", env: "EMAIL_QUEUE_SIZE", def: constants.DefaultEmailQueueSize},
+
+	{key: "auth.token_ttl", env: "AUTH_TOKEN_TTL", def: constants.DefaultTokenTTL},
+	{key: "auth.session_max_age", env: "AUTH_SESSION_MAX_AGE", def: constants.AdminSessionMaxAge},
+
+	{key: "analytics.posthog_key", env: "POSTHOG_KEY", def: constants.DefaultPostHogKey},
+	{key: "analytics.posthog_host", env: "POSTHOG_HOST", def: constants.DefaultPostHogHost},
+
+	{key: "cache.enabled", env: "CACHE_ENABLED", def: true},
+	{key: "cache.shared_max_age", env: "CACHE_SHARED_MAX_AGE", def: constants.DefaultCacheSharedMaxAge},
+	{key: "cache.stale_while_revalidate", env: "CACHE_STALE_WHILE_REVALIDATE", def: constants.DefaultCacheStaleWhileRevalidate},
+
+	{key: "features.graphql", env: "FEATURE_GRAPHQL", def: true},
+	{key: "features.admin", env: "FEATURE_ADMIN", def: true},
+	{key: "features.pdf", env: "FEATURE_PDF", def: true},
+
+	{key: "logging.level", env: "LOG_LEVEL", def: "info", flag: "log-level", usage: "debug, info, warn or error"},
+	{key: "logging.format", env: "LOG_FORMAT", def: "json", flag: "log-format", usage: "json or text"},
+	{key: "logging.output", env: "LOG_OUTPUT", def: "stdout"},
+	{key: "logging.file", env: "LOG_FILE", def: "./logs/website.log"},
+	{key: "logging.max_size_mb", env: "LOG_MAX_SIZE_MB", def: constants.DefaultLogMaxSizeMB},
+	{key: "logging.max_backups", env: "LOG_MAX_BACKUPS", def: constants.DefaultLogMaxBackups},
+	{key: "logging.max_age_days", env: "LOG_MAX_AGE_DAYS", def: constants.DefaultLogMaxAgeDays},
+
+	{key: "tracing.exporter", env: "TRACE_EXPORTER", def: "none"},
+	{key: "tracing.service_name", env: "OTEL_SERVICE_NAME", def: constants.ServiceName},
+
+	{key: "metrics.require_token", env: "METRICS_REQUIRE_TOKEN", def: false},
+
+	{key: "secrets.file", env: "SECRETS_FILE", def: ""},
+	{key: secretsKey.key, env: secretsKey.env, def: "", secret: true},
+	{key: "secrets.cloud", env: "SECRETS_CLOUD", def: ""},
+}
+
+// secretsKey opens the secrets file, so it cannot be kept in there.
+var secretsKey = binding{key: "secrets.key", env: "SECRETS_KEY"}
+
+// Options selects the sources Load reads besides the defaults and the
+// environment.
+type Options struct {
+	// File is the config file to read. When empty, $CONFIG_FILE is used, or
+	// else the first of config.yaml, config.yml, config.json and config.toml
+	// found in the working directory.
+	File string
+
+	// Flags holds flags registered with RegisterFlags. Flags set on the
+	// command line override every other source.
+	Flags *pflag.FlagSet
+
+	// Set overrides keys, such as "database.auto_migrate", after every other
+	// source, for commands that need a setting whatever is configured.
+	Set map[string]any
+}
+
+// RegisterFlags adds --config and the flags overriding configuration keys to fs.
+func RegisterFlags(fs *pflag.FlagSet) {
+	fs.String(configFlag, "", "config file in YAML, JSON or TOML (default $"+configFileEnv+" or ./config.yaml)")
+
+	for _, b := range bindings {
+		if b.flag == "" {
+			continue
+		}
+
+		switch def := b.def.(type) {
+		case int:
+			fs.Int(b.flag, def, b.usage)
+		case bool:
+			fs.Bool(b.flag, def, b.usage)
+		case time.Duration:
+			fs.Duration(b.flag, def, b.usage)
+		default:
+			fs.String(b.flag, fmt.Sprint(def), b.usage)
+		}
+	}
+}
+
+// Defaults returns the configuration used when nothing is configured.
+func Defaults() *Config {
+	v := viper.New()
+	for _, b := range bindings {
+		v.SetDefault(b.key, b.def)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		panic(fmt.Sprintf("config defaults do not decode: %v", err))
+	}
+
+	return &cfg
+}
+
+// Load assembles the configuration from the defaults, the config file, the
+// environment and flags, each overriding the ones before, and validates it.
+func Load(opts Options) (*Config, error) {
+	v := viper.New()
+
+	for _, b := range bindings {
+		v.SetDefault(b.key, b.def)
+
+		if err := v.BindEnv(b.key, b.env); err != nil {
+			return nil, err
+		}
+	}
+
+	file := ConfigFile(opts)
+	if file != "" {
+		v.SetConfigFile(file)
+
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrReadConfigFile, file, err)
+		}
+	}
+
+	if opts.Flags != nil {
+		for _, b := range bindings {
+			if flag := opts.Flags.Lookup(b.flag); flag != nil {
+				if err := v.BindPFlag(b.key, flag); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for key, value := range opts.Set {
+		v.Set(key, value)
+	}
+
+	if err := checkTypes(v, file); err != nil {
+		return nil, err
+	}
+
+	if err := resolveSecrets(context.Background(), v); err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValue, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// ConfigFile returns the config file opts select, or "" if there is none.
+func ConfigFile(opts Options) string {
+	if opts.Flags != nil {
+		if file, _ := opts.Flags.GetString(configFlag); file != "" {
+			return file
+		}
+	}
+
+	if opts.File != "" {
+		return opts.File
+	}
+
+	if file := os.Getenv(configFileEnv); file != "" {
+		return file
+	}
+
+	for _, file := range defaultConfigFiles {
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+	}
+
+	return ""
+}
+
+// checkTypes reports values given as text that do not parse as the type of
+// their key, naming where each came from.
+func checkTypes(v *viper.Viper, file string) error {
+	var errs []error
+
+	for _, b := range bindings {
+		raw, isText := v.Get(b.key).(string)
+		if !isText {
+			continue
+		}
+
+		var err error
+
+		switch b.def.(type) {
+		case int:
+			_, err = strconv.Atoi(raw)
+		case bool:
+			_, err = strconv.ParseBool(raw)
+		case time.Duration:
+			_, err = time.ParseDuration(raw)
+		default:
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s = %q from %s is not a valid %T",
+				ErrInvalidValue, b.key, raw, source(b, file), b.def))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// source describes where the value of b came from.
+func source(b binding, file string) string {
+	if os.Getenv(b.env) != "" {
+		return "$" + b.env
+	}
+
+	if file != "" {
+		return file
+	}
+
+	return "--" + b.flag
+}
+
+// resolveSecrets replaces the values of secret keys with the contents of
+// their *_FILE variables and with the secrets they refer to.
+func resolveSecrets(ctx context.Context, v *viper.Viper) error {
+	key, err := secretValue(ctx, v, secretsKey, secrets.FileEnv{})
+	if err != nil {
+		return err
+	}
+
+	v.Set(secretsKey.key, key)
+
+	providers := secrets.Chain{secrets.FileEnv{}}
+
+	if file := v.GetString("secrets.file"); file != "" {
+		store, err := openStore(file, key)
+		if err != nil {
+			return err
+		}
+
+		providers = append(providers, store)
+	}
+
+	if cloud := v.GetString("secrets.cloud"); cloud != "" {
+		provider, err := secrets.NewCloud(ctx, cloud)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrResolveSecret, err)
+		}
+
+		providers = append(providers, provider)
+	}
+
+	var errs []error
+
+	for _, b := range bindings {
+		if !b.secret || b.key == secretsKey.key {
+			continue
+		}
+
+		value, err := secretValue(ctx, v, b, providers)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		v.Set(b.key, value)
+	}
+
+	return errors.Join(errs...)
+}
+
+// secretValue returns the value of the secret key b. Unless $<ENV> is set,
+// <ENV>_FILE takes precedence over the config file; a reference is then
+// looked up through provider.
+func secretValue(ctx context.Context, v *viper.Viper, b binding, provider secrets.Provider) (string, error) {
+	value := v.GetString(b.key)
+
+	if os.Getenv(b.env) == "" && secrets.FileEnvPath(b.env) != "" {
+		fromFile, err := secrets.FileEnv{}.Lookup(ctx, b.env)
+		if err != nil {
+			return "", fmt.Errorf("%w %s: %w", ErrResolveSecret, b.key, err)
+		}
+
+		value = fromFile
+	}
+
+	resolved, err := secrets.Resolve(ctx, provider, value)
+	if err != nil {
+		return "", fmt.Errorf("%w %s: %w", ErrResolveSecret, b.key, err)
+	}
+
+	return resolved, nil
+}
+
+// openStore opens the encrypted secrets file with the base64 encoded key.
+func openStore(file, key string) (*secrets.Store, error) {
+	if key == "" {
+		return nil, fmt.Errorf("%w: set $%s or $%s_FILE", ErrMissingSecretsKey, secretsKey.env, secretsKey.env)
+	}
+
+	parsed, err := secrets.ParseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := secrets.OpenStore(file, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrResolveSecret, err)
+	}
+
+	return store, nil
+}
+
+// secretFiles returns the files secrets were read from for cfg: the
+// encrypted secrets file and the files named by *_FILE variables.
+func secretFiles(cfg *Config) []string {
+	var files []string
+
+	if cfg.Secrets.File != "" {
+		files = append(files, cfg.Secrets.File)
+	}
+
+	for _, b := range bindings {
+		if path := secrets.FileEnvPath(b.env); b.secret && path != "" {
+			files = append(files, path)
+		}
+	}
+
+	return files
+}
@@ -0,0 +1,50 @@
+package config
+
+import "reflect"
+
+// redacted replaces secret values in the output of Redacted.
+const redacted = "[redacted]"
+
+// Redacted returns a copy of the configuration with the values of fields
+// tagged secret:"true" replaced, for printing and logging.
+func (c *Config) Redacted() *Config {
+	cfg := *c
+	replaceSecrets(reflect.ValueOf(&cfg).Elem(), redacted)
+
+	return &cfg
+}
+
+// replaceSecrets sets the non-empty secret string fields of the struct v to
+// with.
+func replaceSecrets(v reflect.Value, with string) {
+	for i := range v.NumField() {
+		field := v.Field(i)
+
+		switch {
+		case field.Kind() == reflect.Struct:
+			replaceSecrets(field, with)
+		case field.Kind() == reflect.String && isSecret(v.Type().Field(i)) && field.String() != "":
+			field.SetString(with)
+		}
+	}
+}
+
+// copySecrets sets the secret string fields of the struct dst to those of
+// src, which has the same type.
+func copySecrets(dst, src reflect.Value) {
+	for i := range dst.NumField() {
+		field := dst.Field(i)
+
+		switch {
+		case field.Kind() == reflect.Struct:
+			copySecrets(field, src.Field(i))
+		case field.Kind() == reflect.String && isSecret(dst.Type().Field(i)):
+			field.SetString(src.Field(i).String())
+		}
+	}
+}
+
+// isSecret reports whether field is tagged secret:"true".
+func isSecret(field reflect.StructField) bool {
+	return field.Tag.Get("secret") == "true"
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"holger-hahn-website/internal/constants"
+)
+
+// Live holds the running configuration and reloads it when the config file or
+// a file secrets are read from changes. Only the log level, the metrics
+// settings, the feature flags and rotated secrets take effect on reload; other
+// changes are reported and wait for a restart.
+type Live struct {
+	opts      Options
+	current   atomic.Pointer[Config]
+	mu        sync.Mutex
+	listeners []func(*Config)
+}
+
+// NewLive returns a Live starting from cfg, which was loaded with opts.
+func NewLive(cfg *Config, opts Options) *Live {
+	l := &Live{opts: opts}
+	l.current.Store(cfg)
+
+	return l
+}
+
+// Current returns the configuration in effect.
+func (l *Live) Current() *Config {
+	return l.current.Load()
+}
+
+// OnReload registers fn to be called with the new configuration after every
+// successful reload.
+func (l *Live) OnReload(fn func(*Config)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.listeners = append(l.listeners, fn)
+}
+
+// Reload loads the configuration again and applies the keys that are safe to
+// change at runtime. An invalid configuration is rejected and the current one
+// kept.
+func (l *Live) Reload() error {
+	loaded, err := Load(l.opts)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	old := l.current.Load()
+	next := *old
+	next.Logging.Level = loaded.Logging.Level
+	next.Metrics = loaded.Metrics
+	next.Features = loaded.Features
+	copySecrets(reflect.ValueOf(&next).Elem(), reflect.ValueOf(loaded).Elem())
+
+	for _, section := range restartRequired(old, loaded) {
+		slog.Warn("Configuration change needs a restart", "section", section)
+	}
+
+	l.current.Store(&next)
+
+	for _, fn := range l.listeners {
+		fn(&next)
+	}
+
+	return nil
+}
+
+// restartRequired names the sections of next that differ from old in keys
+// that are not applied on reload.
+func restartRequired(old, next *Config) []string {
+	a, b := *old, *next
+	a.Logging.Level, b.Logging.Level = "", ""
+	a.Metrics, b.Metrics = MetricsConfig{}, MetricsConfig{}
+	a.Features, b.Features = FeatureFlags{}, FeatureFlags{}
+	replaceSecrets(reflect.ValueOf(&a).Elem(), "")
+	replaceSecrets(reflect.ValueOf(&b).Elem(), "")
+
+	var sections []string
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	for i := range va.NumField() {
+		if !reflect.DeepEqual(va.Field(i).Interface(), vb.Field(i).Interface()) {
+			sections = append(sections, va.Type().Field(i).Tag.Get("json"))
+		}
+	}
+
+	return sections
+}
+
+// Watch reloads the configuration whenever the config file or a secret file
+// changes, until ctx is done. It returns immediately when there is nothing to
+// watch.
+func (l *Live) Watch(ctx context.Context) error {
+	files := secretFiles(l.Current())
+	if file := ConfigFile(l.opts); file != "" {
+		files = append(files, file)
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	// Watch the directories, as editors and secret mounts replace files
+	// instead of writing to them.
+	watched := make(map[string]bool, len(files))
+
+	for _, file := range files {
+		watched[filepath.Clean(file)] = true
+
+		if err := watcher.Add(filepath.Dir(file)); err != nil {
+			return err
+		}
+	}
+
+	var debounce <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if isWatchedChange(event, watched) {
+				debounce = time.After(constants.ConfigReloadDelay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			slog.Warn("Config file watcher failed", "error", err)
+		case <-debounce:
+			debounce = nil
+
+			if err := l.Reload(); err != nil {
+				slog.Error("Config reload rejected", "error", err)
+				continue
+			}
+
+			slog.Info("Configuration reloaded")
+		}
+	}
+}
+
+// isWatchedChange reports whether event changed one of the watched files.
+// Kubernetes updates mounted secrets by swapping the ..data link next to
+// them, which counts as a change to every file in the directory.
+func isWatchedChange(event fsnotify.Event, watched map[string]bool) bool {
+	if !event.Has(fsnotify.Write | fsnotify.Create | fsnotify.Rename) {
+		return false
+	}
+
+	return watched[filepath.Clean(event.Name)] || filepath.Base(event.Name) == "..data"
+}
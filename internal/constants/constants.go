@@ -12,6 +12,13 @@ import (
 const (
 	// DefaultDirectoryPerms is the default permission for created directories (rwxr-x---).
 	DefaultDirectoryPerms os.FileMode = 0o750
+
+	// PublicFilePerms is the permission for generated files served to visitors (rw-r--r--).
+	PublicFilePerms os.FileMode = 0o644
+
+	// PrivateFilePerms is the permission for databases, backups and exports
+	// holding personal data (rw-------).
+	PrivateFilePerms os.FileMode = 0o600
 )
 
 // HTTP Status Codes.
@@ -45,6 +52,22 @@ const (
 
 	// DefaultWriteTimeoutSeconds is the default HTTP write timeout in seconds.
 	DefaultWriteTimeoutSeconds = 30
+
+	// DefaultShutdownTimeoutSeconds is how long shutdown may take in seconds,
+	// matching the grace period Cloud Run allows after SIGTERM.
+	DefaultShutdownTimeoutSeconds = 10
+
+	// DefaultEmailQueueSize is the number of contact submissions whose emails
+	// are buffered for sending.
+	DefaultEmailQueueSize = 100
+
+	// HealthCheckTimeout bounds each dependency check of the readiness probe,
+	// well below the probe's own timeout.
+	HealthCheckTimeout = 2 * time.Second
+
+	// DefaultPublicURL is the canonical address of the website, used for
+	// absolute links in metadata, structured data and the sitemap.
+	DefaultPublicURL = "https://holger-hahn.net"
 )
 
 // Database Configuration Defaults.
@@ -54,12 +77,106 @@ const (
 
 	// DefaultMaxIdleConnections is the default maximum number of idle database connections.
 	DefaultMaxIdleConnections = 5
+
+	// DefaultDatabasePath is the SQLite database file used unless configured otherwise.
+	DefaultDatabasePath = "./data/holger-hahn.db"
+)
+
+// Email and Authentication Defaults.
+const (
+	// DefaultSMTPPort is the SMTP submission port.
+	DefaultSMTPPort = 587
+
+	// DefaultEmailAddress sends and receives the contact form emails.
+	DefaultEmailAddress = "hello@holger-hahn.net"
+
+	// DefaultTokenTTL is the lifetime of newly issued API tokens.
+	DefaultTokenTTL = 90 * 24 * time.Hour
+)
+
+// Analytics Defaults.
+const (
+	// DefaultPostHogKey is the public PostHog project key of the website.
+	DefaultPostHogKey = "phc_mK6VSY6Vhs6DGKDnyZXDbdCLQKRw1qlMuxWQUhQ1fQN"
+
+	// DefaultPostHogHost is the PostHog ingestion host.
+	DefaultPostHogHost = "https://us.i.posthog.com"
+)
+
+// ConfigReloadDelay lets a burst of writes to the config file settle before
+// it is read again.
+const ConfigReloadDelay = 250 * time.Millisecond
+
+// Log File Rotation Defaults.
+const (
+	// DefaultLogMaxSizeMB is the size in megabytes at which the log file is rotated.
+	DefaultLogMaxSizeMB = 100
+
+	// DefaultLogMaxBackups is the number of rotated log files kept.
+	DefaultLogMaxBackups = 5
+
+	// DefaultLogMaxAgeDays is the number of days rotated log files are kept.
+	DefaultLogMaxAgeDays = 28
+)
+
+// API Pagination Defaults.
+const (
+	// DefaultPageSize is the default number of portfolio items returned per request.
+	DefaultPageSize = 20
+
+	// MaxPageSize is the maximum number of portfolio items returned per request.
+	MaxPageSize = 100
+
+	// DefaultContactPageSize is the default number of contacts returned per request.
+	DefaultContactPageSize = 50
+
+	// MaxContactPageSize is the maximum number of contacts returned per request.
+	MaxContactPageSize = 200
+)
+
+// GraphQL Limits.
+const (
+	// GraphQLMaxDepth is the deepest field nesting a GraphQL query may use.
+	GraphQLMaxDepth = 8
+
+	// GraphQLMaxComplexity is the highest estimated field count a GraphQL query may cost.
+	GraphQLMaxComplexity = 10000
+)
+
+// Admin Session.
+const (
+	// AdminSessionCookie is the name of the cookie holding the admin editor's session ID.
+	AdminSessionCookie = "hh_admin_session"
+
+	// AdminSessionMaxAge is how long the admin session cookie is kept by the browser.
+	AdminSessionMaxAge = 8 * time.Hour
+)
+
+// Page Cache Defaults.
+const (
+	// DefaultCacheSharedMaxAge is how long a CDN may serve a cached page without revalidating it.
+	DefaultCacheSharedMaxAge = 5 * time.Minute
+
+	// DefaultCacheStaleWhileRevalidate is how long after that a CDN may serve the stale page while it revalidates.
+	DefaultCacheStaleWhileRevalidate = 24 * time.Hour
+
+	// PageCacheMaxEntries bounds the rendered pages kept in memory, one per route, path parameters and query.
+	PageCacheMaxEntries = 1024
+
+	// ContentRevisionCheckInterval is how often the page cache reads the content revision stored in the database.
+	ContentRevisionCheckInterval = time.Second
 )
 
 // Exit Status Codes.
 const (
+	// ExitSuccess represents a zero exit status code for success.
+	ExitSuccess = 0
+
 	// ExitFailure represents a non-zero exit status code for failures.
 	ExitFailure = 1
+
+	// ExitUsage represents the exit status code for invalid command line usage.
+	ExitUsage = 2
 )
 
 // Performance Thresholds.
@@ -90,4 +207,28 @@ const (
 const (
 	// AppVersion is the current application version.
 	AppVersion = "1.0.0"
+
+	// ServiceName identifies the application in logs and traces.
+	ServiceName = "holger-hahn-website"
+)
+
+// Owner Profile.
+const (
+	// OwnerName is the full name used on the website and in exported CVs.
+	OwnerName = "Holger M. Hahn"
+
+	// OwnerLabel is the professional headline shown under the name.
+	OwnerLabel = "Digital Assets Solutions Architect"
+
+	// OwnerSummary is the one-line profile summary.
+	OwnerSummary = "5+ years specializing in blockchain technology for regulated financial institutions"
+
+	// OwnerEmail is the public contact address.
+	OwnerEmail = "hello@holger-hahn.net"
+
+	// OwnerLinkedInUsername is the LinkedIn profile handle.
+	OwnerLinkedInUsername = "holger-hahn-1b258ba3"
+
+	// OwnerLinkedInURL is the public LinkedIn profile.
+	OwnerLinkedInURL = "https://www.linkedin.com/in/" + OwnerLinkedInUsername + "/"
 )
@@ -5,29 +5,49 @@ package container
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"time"
 
 	"github.com/samber/do"
 	"holger-hahn-website/internal/application"
 	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
 	"holger-hahn-website/internal/database"
 	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/graph"
+	"holger-hahn-website/internal/health"
 	"holger-hahn-website/internal/infrastructure"
+	"holger-hahn-website/internal/logging"
+	"holger-hahn-website/internal/metrics"
+	"holger-hahn-website/internal/ogimage"
+	"holger-hahn-website/internal/pagecache"
+	"holger-hahn-website/internal/pdf"
 	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/seo"
 	"holger-hahn-website/internal/service"
 )
 
 // Container wraps the DI container with our application-specific setup.
 type Container struct {
 	injector *do.Injector
+	opts     config.Options
 }
 
-// New creates a new container with all dependencies registered.
+// New creates a new container with all dependencies registered, configured
+// from the environment and the default config file.
 func New() *Container {
+	return NewWithConfig(config.Options{})
+}
+
+// NewWithConfig creates a new container with all dependencies registered,
+// configured from the sources opts selects.
+func NewWithConfig(opts config.Options) *Container {
 	injector := do.New()
 
 	c := &Container{
 		injector: injector,
+		opts:     opts,
 	}
 
 	c.registerDependencies()
@@ -44,46 +64,78 @@ func (c *Container) GetInjector() *do.Injector {
 func (c *Container) registerDependencies() {
 	// Register configuration.
 	do.Provide(c.injector, func(_ *do.Injector) (*config.Config, error) {
-		cfg := config.LoadConfig()
-		if err := cfg.Validate(); err != nil {
-			return nil, err
-		}
+		return config.Load(c.opts)
+	})
 
-		return cfg, nil
+	// Configuration as reloaded at runtime
+	do.Provide(c.injector, func(i *do.Injector) (*config.Live, error) {
+		return config.NewLive(do.MustInvoke[*config.Config](i), c.opts), nil
 	})
 
 	// Register database manager and initialize database
-	do.Provide(c.injector, func(_ *do.Injector) (*database.DatabaseManager, error) {
+	do.Provide(c.injector, func(i *do.Injector) (*database.DatabaseManager, error) {
+		cfg := do.MustInvoke[*config.Config](i)
+
 		dbConfig := database.DefaultConfig()
+		dbConfig.DatabasePath = cfg.Database.ConnectionString
+		dbConfig.MaxOpenConns = cfg.Database.MaxOpenConns
+		dbConfig.MaxIdleConns = cfg.Database.MaxIdleConns
+
 		dbManager, err := database.NewDatabaseManager(dbConfig)
 		if err != nil {
 			return nil, err
 		}
 
-		// Run migrations on startup
-		ctx := context.Background()
-		if err := dbManager.Migrate(ctx); err != nil {
-			log.Printf("Warning: Database migration failed: %v", err)
-			// Don't fail startup, but log the warning
+		// Run migrations on startup unless "migrate up" is left to do it
+		if cfg.Database.AutoMigrate {
+			ctx := context.Background()
+			if err := dbManager.Migrate(ctx); err != nil {
+				slog.Warn("Database migration failed", "error", err)
+				// Don't fail startup, but log the warning
+			}
 		}
 
 		return dbManager, nil
 	})
 
+	// Portfolio content revision, kept in the database so that writes by the
+	// CLI and other instances count too, and the rendered pages cached by it.
+	do.Provide(c.injector, func(i *do.Injector) (*pagecache.Revision, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+
+		return pagecache.NewStoredRevision(func(ctx context.Context) (uint64, time.Time, error) {
+			row, err := dbManager.Queries().GetContentRevision(ctx)
+			return uint64(row.Revision), row.UpdatedAt, err
+		}), nil
+	})
+
+	do.Provide(c.injector, func(i *do.Injector) (*pagecache.Cache, error) {
+		return pagecache.New(
+			do.MustInvoke[*config.Config](i).Cache,
+			do.MustInvoke[*pagecache.Revision](i),
+		), nil
+	})
+
 	// Register database repositories (replacing in-memory implementations)
 	do.Provide(c.injector, func(i *do.Injector) (repository.TechnologyRepository, error) {
 		dbManager := do.MustInvoke[*database.DatabaseManager](i)
-		return database.NewTechnologyRepository(dbManager.Queries()), nil
+		repo := database.NewTechnologyRepository(dbManager.Queries())
+
+		return pagecache.WatchTechnologies(repo, do.MustInvoke[*pagecache.Revision](i)), nil
 	})
 
-	do.Provide(c.injector, func(_ *do.Injector) (repository.ExperienceRepository, error) {
-		// TODO: Implement database experience repository when available
-		return NewInMemoryExperienceRepository(), nil
+	do.Provide(c.injector, func(i *do.Injector) (repository.ExperienceRepository, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+		repo := database.NewExperienceRepository(dbManager.Queries())
+
+		return pagecache.WatchExperiences(repo, do.MustInvoke[*pagecache.Revision](i)), nil
 	})
 
-	do.Provide(c.injector, func(_ *do.Injector) (repository.ServiceRepository, error) {
-		// TODO: Implement database service repository when available
-		return NewInMemoryServiceRepository(), nil
+	do.Provide(c.injector, func(i *do.Injector) (repository.ServiceRepository, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+		repo := database.NewServiceRepository(dbManager.Queries())
+
+		return pagecache.WatchServices(repo, do.MustInvoke[*pagecache.Revision](i)), nil
 	})
 
 	do.Provide(c.injector, func(_ *do.Injector) (repository.UnitOfWork, error) {
@@ -111,6 +163,55 @@ func (c *Container) registerDependencies() {
 		return service.NewPortfolioService(serviceRepo, techRepo), nil
 	})
 
+	// GraphQL schema over the portfolio services.
+	do.Provide(c.injector, func(i *do.Injector) (*graph.Server, error) {
+		return graph.NewServer(
+			do.MustInvoke[*service.TechnologyService](i),
+			do.MustInvoke[*service.ExperienceService](i),
+			do.MustInvoke[*service.PortfolioService](i),
+			do.MustInvoke[repository.TechnologyRepository](i),
+		)
+	})
+
+	// CV exports generated from the portfolio services.
+	do.Provide(c.injector, func(i *do.Injector) (*resume.Generator, error) {
+		return resume.NewGenerator(
+			do.MustInvoke[*service.ExperienceService](i),
+			do.MustInvoke[*service.TechnologyService](i),
+		), nil
+	})
+
+	// PDF CV and service one-pagers, cached by content revision.
+	do.Provide(c.injector, func(i *do.Injector) (*pdf.Generator, error) {
+		return pdf.NewGenerator(
+			do.MustInvoke[*service.ExperienceService](i),
+			do.MustInvoke[*service.PortfolioService](i),
+		), nil
+	})
+
+	// Open Graph preview cards, cached by content revision.
+	do.Provide(c.injector, func(i *do.Injector) (*ogimage.Generator, error) {
+		return ogimage.NewGenerator(
+			do.MustInvoke[*config.Config](i).Server.PublicURL,
+			do.MustInvoke[*service.ExperienceService](i),
+			do.MustInvoke[*service.PortfolioService](i),
+		), nil
+	})
+
+	// Public site metadata for search engines and link previews.
+	do.Provide(c.injector, func(i *do.Injector) (*seo.Site, error) {
+		cfg := do.MustInvoke[*config.Config](i)
+
+		return seo.NewSite(
+			cfg.Server.PublicURL,
+			do.MustInvoke[*service.ExperienceService](i),
+			do.MustInvoke[*service.PortfolioService](i),
+		).WithAnalytics(seo.Analytics{
+			Key:  cfg.Analytics.PostHogKey,
+			Host: cfg.Analytics.PostHogHost,
+		}), nil
+	})
+
 	// Register aggregated repositories struct.
 	do.Provide(c.injector, func(i *do.Injector) (*repository.Repositories, error) {
 		return &repository.Repositories{
@@ -128,14 +229,71 @@ func (c *Container) registerDependencies() {
 		return database.NewContactRepository(dbManager.Queries()), nil
 	})
 
+	// API token repository (using database implementation)
+	do.Provide(c.injector, func(i *do.Injector) (domain.TokenRepository, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+		return database.NewTokenRepository(dbManager.Queries()), nil
+	})
+
+	// Admin session repository (using database implementation)
+	do.Provide(c.injector, func(i *do.Injector) (domain.SessionRepository, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+		return database.NewSessionRepository(dbManager.Queries()), nil
+	})
+
+	// SMTP delivery
+	do.Provide(c.injector, func(i *do.Injector) (*infrastructure.SMTPEmailService, error) {
+		return infrastructure.NewSMTPEmailService(
+			do.MustInvoke[*config.Config](i).Email,
+			do.MustInvoke[*logging.Logger](i).Logger,
+		), nil
+	})
+
+	// Email queue sending through SMTP in the background; stopped on shutdown
+	do.Provide(c.injector, func(i *do.Injector) (*infrastructure.EmailQueue, error) {
+		smtp := do.MustInvoke[*infrastructure.SMTPEmailService](i)
+		logger := do.MustInvoke[domain.LoggingService](i)
+		m := do.MustInvoke[*metrics.Metrics](i)
+
+		queue := infrastructure.NewEmailQueue(smtp, logger, m, do.MustInvoke[*config.Config](i).Email.QueueSize)
+		if err := m.RegisterQueue("email", queue.Len); err != nil {
+			return nil, err
+		}
+
+		return queue, nil
+	})
+
 	// Email service
-	do.Provide(c.injector, func(_ *do.Injector) (domain.EmailService, error) {
-		return infrastructure.NewSMTPEmailService(), nil
+	do.Provide(c.injector, func(i *do.Injector) (domain.EmailService, error) {
+		return do.MustInvoke[*infrastructure.EmailQueue](i), nil
+	})
+
+	// Prometheus metrics, including the database connection pool
+	do.Provide(c.injector, func(i *do.Injector) (*metrics.Metrics, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+
+		m := metrics.New()
+		if err := m.RegisterDBStats(dbManager.GetStats); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	})
+
+	do.Provide(c.injector, func(i *do.Injector) (domain.MetricsService, error) {
+		return do.MustInvoke[*metrics.Metrics](i), nil
+	})
+
+	// Application logger, closed with the container
+	do.Provide(c.injector, func(i *do.Injector) (*logging.Logger, error) {
+		cfg := do.MustInvoke[*config.Config](i)
+
+		return logging.New(cfg.Logging, constants.ServiceName)
 	})
 
 	// Logging service
-	do.Provide(c.injector, func(_ *do.Injector) (domain.LoggingService, error) {
-		return infrastructure.NewConsoleLoggingService("holger-hahn-website"), nil
+	do.Provide(c.injector, func(i *do.Injector) (domain.LoggingService, error) {
+		return infrastructure.NewSlogLoggingService(do.MustInvoke[*logging.Logger](i).Logger), nil
 	})
 
 	// Contact application service
@@ -143,8 +301,39 @@ func (c *Container) registerDependencies() {
 		contactRepo := do.MustInvoke[domain.ContactRepository](i)
 		emailSvc := do.MustInvoke[domain.EmailService](i)
 		logger := do.MustInvoke[domain.LoggingService](i)
+		metricsSvc := do.MustInvoke[domain.MetricsService](i)
+
+		return application.NewContactService(contactRepo, emailSvc, logger, metricsSvc), nil
+	})
+
+	// Dependency checks for the readiness probe. Without the database the
+	// instance must not take traffic; mail problems only degrade it.
+	do.Provide(c.injector, func(i *do.Injector) (*health.Checker, error) {
+		dbManager := do.MustInvoke[*database.DatabaseManager](i)
+		checker := health.New(constants.HealthCheckTimeout)
+		checker.AddCritical("database", dbManager.HealthCheck)
+		checker.AddCritical("migrations", dbManager.MigrationCheck)
+		checker.Add("smtp", do.MustInvoke[*infrastructure.SMTPEmailService](i).HealthCheck)
+		checker.Add("email_queue", do.MustInvoke[*infrastructure.EmailQueue](i).HealthCheck)
+
+		return checker, nil
+	})
+
+	// API token application service
+	do.Provide(c.injector, func(i *do.Injector) (*application.TokenService, error) {
+		tokenRepo := do.MustInvoke[domain.TokenRepository](i)
+		logger := do.MustInvoke[domain.LoggingService](i)
+
+		return application.NewTokenService(tokenRepo, logger), nil
+	})
+
+	// Content editor session service
+	do.Provide(c.injector, func(i *do.Injector) (*application.SessionService, error) {
+		tokenService := do.MustInvoke[*application.TokenService](i)
+		sessionRepo := do.MustInvoke[domain.SessionRepository](i)
+		logger := do.MustInvoke[domain.LoggingService](i)
 
-		return application.NewContactService(contactRepo, emailSvc, logger), nil
+		return application.NewSessionService(tokenService, sessionRepo, logger), nil
 	})
 }
 
@@ -153,7 +342,7 @@ func (c *Container) Shutdown() error {
 	// Close database connection before shutting down injector
 	if dbManager, err := do.Invoke[*database.DatabaseManager](c.injector); err == nil {
 		if closeErr := dbManager.Close(); closeErr != nil {
-			log.Printf("Error closing database connection: %v", closeErr)
+			slog.Error("Failed to close database connection", "error", closeErr)
 		}
 	}
 	
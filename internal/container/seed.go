@@ -0,0 +1,120 @@
+package container
+
+import (
+	"context"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/service"
+)
+
+// seedTechnology is a technology the seed command creates.
+type seedTechnology struct {
+	name     string
+	category string
+	level    domain.Level
+}
+
+// seedTechnologies are the core technologies of the about page. The database
+// starts out empty; experiences and services are seeded from the sample data
+// of their in-memory repositories.
+var seedTechnologies = []seedTechnology{
+	{name: "Java", category: "language", level: domain.LevelExpert},
+	{name: "Python", category: "language", level: domain.LevelAdvanced},
+	{name: "Rust", category: "language", level: domain.LevelAdvanced},
+	{name: "PHP", category: "language", level: domain.LevelAdvanced},
+	{name: "Kubernetes", category: "infrastructure", level: domain.LevelAdvanced},
+	{name: "AWS", category: "infrastructure", level: domain.LevelAdvanced},
+	{name: "Docker", category: "infrastructure", level: domain.LevelExpert},
+	{name: "Linux", category: "infrastructure", level: domain.LevelExpert},
+	{name: "CosmWasm", category: "blockchain", level: domain.LevelAdvanced},
+	{name: "Smart Contracts", category: "blockchain", level: domain.LevelAdvanced},
+	{name: "Fireblocks", category: "blockchain", level: domain.LevelExpert},
+	{name: "Web3", category: "blockchain", level: domain.LevelAdvanced},
+}
+
+// SeedResult lists the technologies Seed created and the ones that existed,
+// and counts the sample experiences and services it created.
+type SeedResult struct {
+	Created     []string `json:"created"`
+	Skipped     []string `json:"skipped"`
+	Experiences int      `json:"experiences"`
+	Services    int      `json:"services"`
+}
+
+// Seed creates the seed technologies that do not exist yet, so it can run
+// against a database that is already in use. The sample experiences and
+// services are only added while their table is empty, so edited content is
+// never overwritten.
+func (c *Container) Seed(ctx context.Context) (*SeedResult, error) {
+	result, err := c.seedTechnologies(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if result.Experiences, err = seedSamples(ctx,
+		MustGet[repository.ExperienceRepository](c), NewInMemoryExperienceRepository(), repository.ExperienceFilter{},
+	); err != nil {
+		return result, err
+	}
+
+	result.Services, err = seedSamples(ctx,
+		MustGet[repository.ServiceRepository](c), NewInMemoryServiceRepository(), repository.ServiceFilter{},
+	)
+
+	return result, err
+}
+
+// seedSamples copies the entities of samples into repo if repo is empty and
+// returns how many it copied.
+func seedSamples[T repository.Entity, F any](ctx context.Context, repo, samples repository.Filterable[T, F], filter F) (int, error) {
+	existing, err := repo.List(ctx, filter)
+	if err != nil || len(existing) > 0 {
+		return 0, err
+	}
+
+	entities, err := samples.List(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, entity := range entities {
+		if err := repo.Create(ctx, entity); err != nil {
+			return i, err
+		}
+	}
+
+	return len(entities), nil
+}
+
+// seedTechnologies creates the seed technologies that do not exist yet.
+func (c *Container) seedTechnologies(ctx context.Context) (*SeedResult, error) {
+	technologies := MustGet[*service.TechnologyService](c)
+
+	existing, err := technologies.ListTechnologies(ctx, service.TechnologyFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(existing))
+	for _, tech := range existing {
+		names[tech.Name] = true
+	}
+
+	result := &SeedResult{Created: []string{}, Skipped: []string{}}
+
+	for _, seed := range seedTechnologies {
+		if names[seed.name] {
+			result.Skipped = append(result.Skipped, seed.name)
+			continue
+		}
+
+		if _, err := technologies.CreateTechnology(ctx, seed.name, seed.category, seed.level); err != nil {
+			return result, err
+		}
+
+		result.Created = append(result.Created, seed.name)
+	}
+
+	return result, nil
+}
@@ -53,6 +53,18 @@ func (q *Queries) CreateAnalyticsEvent(ctx context.Context, arg CreateAnalyticsE
 	return i, err
 }
 
+const DeleteAnalyticsEventsBefore = `-- name: DeleteAnalyticsEventsBefore :execrows
+DELETE FROM analytics_events WHERE created_at < ?
+`
+
+func (q *Queries) DeleteAnalyticsEventsBefore(ctx context.Context, createdAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, DeleteAnalyticsEventsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const DeleteOldAnalyticsEvents = `-- name: DeleteOldAnalyticsEvents :exec
 DELETE FROM analytics_events
 WHERE created_at < datetime('now', '-90 days')
@@ -261,3 +273,27 @@ func (q *Queries) ListAnalyticsEventsByType(ctx context.Context, arg ListAnalyti
 	}
 	return items, nil
 }
+
+const RollupAnalyticsEvents = `-- name: RollupAnalyticsEvents :execrows
+INSERT INTO analytics_daily (day, event_type, page_path, event_count, unique_sessions)
+SELECT
+    DATE(created_at),
+    event_type,
+    COALESCE(page_path, ''),
+    COUNT(*),
+    COUNT(DISTINCT session_id)
+FROM analytics_events
+WHERE created_at < ?
+GROUP BY DATE(created_at), event_type, COALESCE(page_path, '')
+ON CONFLICT (day, event_type, page_path) DO UPDATE SET
+    event_count = excluded.event_count,
+    unique_sessions = excluded.unique_sessions
+`
+
+func (q *Queries) RollupAnalyticsEvents(ctx context.Context, createdAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, RollupAnalyticsEvents, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
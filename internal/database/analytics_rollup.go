@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// AnalyticsRollup reports what RollupAnalytics did.
+type AnalyticsRollup struct {
+	Before time.Time `json:"before"`
+	Days   int64     `json:"daily_rows"`
+	Pruned int64     `json:"pruned_events"`
+}
+
+// RollupAnalytics adds the events of every day before the given one to the
+// daily totals in analytics_daily, replacing earlier totals of those days.
+// With prune the rolled up events are deleted in the same transaction.
+// before is truncated to midnight UTC so that only whole days are rolled up.
+func (dm *DatabaseManager) RollupAnalytics(ctx context.Context, before time.Time, prune bool) (*AnalyticsRollup, error) {
+	before = before.UTC().Truncate(24 * time.Hour)
+	cutoff := sql.NullTime{Time: before, Valid: true}
+	result := &AnalyticsRollup{Before: before}
+
+	err := dm.WithTx(ctx, func(q *Queries) error {
+		days, err := q.RollupAnalyticsEvents(ctx, cutoff)
+		if err != nil {
+			return fmt.Errorf("failed to roll up analytics events: %w", err)
+		}
+
+		result.Days = days
+
+		if !prune {
+			return nil
+		}
+
+		pruned, err := q.DeleteAnalyticsEventsBefore(ctx, cutoff)
+		if err != nil {
+			return fmt.Errorf("failed to prune analytics events: %w", err)
+		}
+
+		result.Pruned = pruned
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
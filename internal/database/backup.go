@@ -0,0 +1,141 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"holger-hahn-website/internal/constants"
+)
+
+// Backup and restore errors.
+var (
+	ErrBackupExists  = errors.New("backup file already exists")
+	ErrInvalidBackup = errors.New("not a backup of this site")
+)
+
+// sqliteSidecars are the files SQLite keeps next to a database in WAL mode.
+var sqliteSidecars = []string{"-wal", "-shm"}
+
+// Backup writes a consistent copy of the database to dst. It uses VACUUM
+// INTO, which is safe while the server keeps serving requests.
+func (dm *DatabaseManager) Backup(ctx context.Context, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("%w: %s", ErrBackupExists, dst)
+	}
+
+	if err := ensureDirectoryExists(dst); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	if _, err := dm.db.ExecContext(ctx, "VACUUM INTO ?", dst); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+
+	// Backups hold contact submissions and token hashes
+	if err := os.Chmod(dst, constants.PrivateFilePerms); err != nil {
+		return fmt.Errorf("failed to restrict backup permissions: %w", err)
+	}
+
+	return nil
+}
+
+// Restore replaces the database at dbPath with the backup at src, after
+// checking that src is an intact database with migrations recorded. Nothing
+// may have dbPath open: stop the server first.
+func Restore(ctx context.Context, src, dbPath string) error {
+	if err := checkBackup(ctx, src); err != nil {
+		return err
+	}
+
+	if err := ensureDirectoryExists(dbPath); err != nil {
+		return fmt.Errorf("failed to create database directory: %w", err)
+	}
+
+	// Copy next to the target first so that the final rename is atomic
+	tmp := dbPath + ".restore"
+	if err := copyFile(src, tmp); err != nil {
+		return fmt.Errorf("failed to copy backup: %w", err)
+	}
+
+	// A write-ahead log left from the old database would be replayed into
+	// the restored one
+	for _, suffix := range sqliteSidecars {
+		if err := os.Remove(dbPath + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			os.Remove(tmp)
+			return fmt.Errorf("failed to remove %s: %w", dbPath+suffix, err)
+		}
+	}
+
+	if err := os.Rename(tmp, dbPath); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to replace database: %w", err)
+	}
+
+	return nil
+}
+
+// checkBackup opens src read-only and verifies its integrity and schema.
+func checkBackup(ctx context.Context, src string) error {
+	if _, err := os.Stat(src); err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+src+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("failed to open backup: %w", err)
+	}
+	defer db.Close()
+
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidBackup, src, err)
+	}
+
+	if result != "ok" {
+		return fmt.Errorf("%w: %s: integrity check: %s", ErrInvalidBackup, src, result)
+	}
+
+	var count int
+	err = db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'",
+	).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidBackup, src, err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("%w: %s has no schema_migrations table", ErrInvalidBackup, src)
+	}
+
+	return nil
+}
+
+// copyFile copies src to dst, replacing dst.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, constants.PrivateFilePerms)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
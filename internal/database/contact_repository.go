@@ -195,6 +195,26 @@ func (r *ContactRepository) Count(ctx context.Context, status domain.ContactStat
 	return int(count), nil
 }
 
+// CountBefore returns the number of contacts submitted before the given time.
+func (r *ContactRepository) CountBefore(ctx context.Context, before time.Time) (int, error) {
+	count, err := r.queries.CountContactsBefore(ctx, sql.NullTime{Time: before.UTC(), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count contacts: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// DeleteBefore removes contacts submitted before the given time.
+func (r *ContactRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := r.queries.DeleteContactsBefore(ctx, sql.NullTime{Time: before.UTC(), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete contacts: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
 // toDomainContact converts a database Contact to a domain Contact.
 func (r *ContactRepository) toDomainContact(dbContact Contact) *domain.Contact {
 	contact := &domain.Contact{
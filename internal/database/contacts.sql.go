@@ -21,6 +21,17 @@ func (q *Queries) CountContacts(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const CountContactsBefore = `-- name: CountContactsBefore :one
+SELECT COUNT(*) FROM contacts WHERE created_at < ?
+`
+
+func (q *Queries) CountContactsBefore(ctx context.Context, createdAt sql.NullTime) (int64, error) {
+	row := q.db.QueryRowContext(ctx, CountContactsBefore, createdAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const CountContactsByStatus = `-- name: CountContactsByStatus :one
 SELECT COUNT(*) FROM contacts WHERE status = ?
 `
@@ -83,6 +94,18 @@ func (q *Queries) DeleteContact(ctx context.Context, id string) error {
 	return err
 }
 
+const DeleteContactsBefore = `-- name: DeleteContactsBefore :execrows
+DELETE FROM contacts WHERE created_at < ?
+`
+
+func (q *Queries) DeleteContactsBefore(ctx context.Context, createdAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, DeleteContactsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const GetContact = `-- name: GetContact :one
 SELECT id, name, email, company, message, subject, created_at, updated_at, status, source FROM contacts WHERE id = ?
 `
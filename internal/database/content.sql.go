@@ -13,17 +13,20 @@ import (
 
 const CreateExperience = `-- name: CreateExperience :one
 INSERT INTO experiences (
-    company, position, description, achievements, technologies,
+    id, company, position, description, location, is_remote, achievements, technologies,
     start_date, end_date, is_current, sort_order
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?
-) RETURNING id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+) RETURNING id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at, location, is_remote
 `
 
 type CreateExperienceParams struct {
+	ID           string         `json:"id"`
 	Company      string         `json:"company"`
 	Position     string         `json:"position"`
 	Description  string         `json:"description"`
+	Location     sql.NullString `json:"location"`
+	IsRemote     sql.NullBool   `json:"is_remote"`
 	Achievements sql.NullString `json:"achievements"`
 	Technologies sql.NullString `json:"technologies"`
 	StartDate    time.Time      `json:"start_date"`
@@ -34,9 +37,12 @@ type CreateExperienceParams struct {
 
 func (q *Queries) CreateExperience(ctx context.Context, arg CreateExperienceParams) (Experience, error) {
 	row := q.db.QueryRowContext(ctx, CreateExperience,
+		arg.ID,
 		arg.Company,
 		arg.Position,
 		arg.Description,
+		arg.Location,
+		arg.IsRemote,
 		arg.Achievements,
 		arg.Technologies,
 		arg.StartDate,
@@ -59,35 +65,52 @@ func (q *Queries) CreateExperience(ctx context.Context, arg CreateExperiencePara
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Location,
+		&i.IsRemote,
 	)
 	return i, err
 }
 
 const CreateService = `-- name: CreateService :one
 INSERT INTO services (
-    title, description, features, icon_svg, color_scheme, sort_order
+    id, title, description, category, duration, pricing, deliverables, technologies,
+    features, icon_svg, color_scheme, sort_order, is_active
 ) VALUES (
-    ?, ?, ?, ?, ?, ?
-) RETURNING id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+) RETURNING id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at, category, duration, pricing, deliverables, technologies
 `
 
 type CreateServiceParams struct {
-	Title       string         `json:"title"`
-	Description string         `json:"description"`
-	Features    sql.NullString `json:"features"`
-	IconSvg     sql.NullString `json:"icon_svg"`
-	ColorScheme sql.NullString `json:"color_scheme"`
-	SortOrder   sql.NullInt64  `json:"sort_order"`
+	ID           string         `json:"id"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	Category     string         `json:"category"`
+	Duration     sql.NullString `json:"duration"`
+	Pricing      sql.NullString `json:"pricing"`
+	Deliverables sql.NullString `json:"deliverables"`
+	Technologies sql.NullString `json:"technologies"`
+	Features     sql.NullString `json:"features"`
+	IconSvg      sql.NullString `json:"icon_svg"`
+	ColorScheme  sql.NullString `json:"color_scheme"`
+	SortOrder    sql.NullInt64  `json:"sort_order"`
+	IsActive     sql.NullBool   `json:"is_active"`
 }
 
 func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (Service, error) {
 	row := q.db.QueryRowContext(ctx, CreateService,
+		arg.ID,
 		arg.Title,
 		arg.Description,
+		arg.Category,
+		arg.Duration,
+		arg.Pricing,
+		arg.Deliverables,
+		arg.Technologies,
 		arg.Features,
 		arg.IconSvg,
 		arg.ColorScheme,
 		arg.SortOrder,
+		arg.IsActive,
 	)
 	var i Service
 	err := row.Scan(
@@ -101,6 +124,11 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (S
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Category,
+		&i.Duration,
+		&i.Pricing,
+		&i.Deliverables,
+		&i.Technologies,
 	)
 	return i, err
 }
@@ -177,8 +205,19 @@ func (q *Queries) DeleteTechnology(ctx context.Context, id string) error {
 	return err
 }
 
+const GetContentRevision = `-- name: GetContentRevision :one
+SELECT id, revision, updated_at FROM content_revision WHERE id = 1
+`
+
+func (q *Queries) GetContentRevision(ctx context.Context) (ContentRevision, error) {
+	row := q.db.QueryRowContext(ctx, GetContentRevision)
+	var i ContentRevision
+	err := row.Scan(&i.ID, &i.Revision, &i.UpdatedAt)
+	return i, err
+}
+
 const GetCurrentExperience = `-- name: GetCurrentExperience :one
-SELECT id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at FROM experiences
+SELECT id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at, location, is_remote FROM experiences
 WHERE is_current = TRUE AND is_active = TRUE
 LIMIT 1
 `
@@ -200,12 +239,14 @@ func (q *Queries) GetCurrentExperience(ctx context.Context) (Experience, error)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Location,
+		&i.IsRemote,
 	)
 	return i, err
 }
 
 const GetExperience = `-- name: GetExperience :one
-SELECT id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at FROM experiences WHERE id = ?
+SELECT id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at, location, is_remote FROM experiences WHERE id = ?
 `
 
 func (q *Queries) GetExperience(ctx context.Context, id string) (Experience, error) {
@@ -225,12 +266,14 @@ func (q *Queries) GetExperience(ctx context.Context, id string) (Experience, err
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Location,
+		&i.IsRemote,
 	)
 	return i, err
 }
 
 const GetService = `-- name: GetService :one
-SELECT id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at FROM services WHERE id = ?
+SELECT id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at, category, duration, pricing, deliverables, technologies FROM services WHERE id = ?
 `
 
 func (q *Queries) GetService(ctx context.Context, id string) (Service, error) {
@@ -247,6 +290,11 @@ func (q *Queries) GetService(ctx context.Context, id string) (Service, error) {
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Category,
+		&i.Duration,
+		&i.Pricing,
+		&i.Deliverables,
+		&i.Technologies,
 	)
 	return i, err
 }
@@ -297,8 +345,52 @@ func (q *Queries) GetTechnologyByName(ctx context.Context, name string) (Technol
 	return i, err
 }
 
+const ListAllServices = `-- name: ListAllServices :many
+SELECT id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at, category, duration, pricing, deliverables, technologies FROM services
+ORDER BY sort_order, title
+`
+
+func (q *Queries) ListAllServices(ctx context.Context) ([]Service, error) {
+	rows, err := q.db.QueryContext(ctx, ListAllServices)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Service{}
+	for rows.Next() {
+		var i Service
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Features,
+			&i.IconSvg,
+			&i.ColorScheme,
+			&i.SortOrder,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Category,
+			&i.Duration,
+			&i.Pricing,
+			&i.Deliverables,
+			&i.Technologies,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const ListExperiences = `-- name: ListExperiences :many
-SELECT id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at FROM experiences
+SELECT id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at, location, is_remote FROM experiences
 WHERE is_active = TRUE
 ORDER BY is_current DESC, start_date DESC, sort_order
 `
@@ -327,6 +419,8 @@ func (q *Queries) ListExperiences(ctx context.Context) ([]Experience, error) {
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Location,
+			&i.IsRemote,
 		); err != nil {
 			return nil, err
 		}
@@ -342,7 +436,7 @@ func (q *Queries) ListExperiences(ctx context.Context) ([]Experience, error) {
 }
 
 const ListServices = `-- name: ListServices :many
-SELECT id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at FROM services
+SELECT id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at, category, duration, pricing, deliverables, technologies FROM services
 WHERE is_active = TRUE
 ORDER BY sort_order, title
 `
@@ -368,6 +462,11 @@ func (q *Queries) ListServices(ctx context.Context) ([]Service, error) {
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Category,
+			&i.Duration,
+			&i.Pricing,
+			&i.Deliverables,
+			&i.Technologies,
 		); err != nil {
 			return nil, err
 		}
@@ -508,17 +607,19 @@ func (q *Queries) ListTechnologiesByLevel(ctx context.Context, proficiencyLevel
 
 const UpdateExperience = `-- name: UpdateExperience :one
 UPDATE experiences
-SET company = ?, position = ?, description = ?, achievements = ?,
-    technologies = ?, start_date = ?, end_date = ?, is_current = ?,
+SET company = ?, position = ?, description = ?, location = ?, is_remote = ?,
+    achievements = ?, technologies = ?, start_date = ?, end_date = ?, is_current = ?,
     sort_order = ?, updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at
+RETURNING id, company, position, description, achievements, technologies, start_date, end_date, is_current, sort_order, is_active, created_at, updated_at, location, is_remote
 `
 
 type UpdateExperienceParams struct {
 	Company      string         `json:"company"`
 	Position     string         `json:"position"`
 	Description  string         `json:"description"`
+	Location     sql.NullString `json:"location"`
+	IsRemote     sql.NullBool   `json:"is_remote"`
 	Achievements sql.NullString `json:"achievements"`
 	Technologies sql.NullString `json:"technologies"`
 	StartDate    time.Time      `json:"start_date"`
@@ -533,6 +634,8 @@ func (q *Queries) UpdateExperience(ctx context.Context, arg UpdateExperiencePara
 		arg.Company,
 		arg.Position,
 		arg.Description,
+		arg.Location,
+		arg.IsRemote,
 		arg.Achievements,
 		arg.Technologies,
 		arg.StartDate,
@@ -556,36 +659,51 @@ func (q *Queries) UpdateExperience(ctx context.Context, arg UpdateExperiencePara
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Location,
+		&i.IsRemote,
 	)
 	return i, err
 }
 
 const UpdateService = `-- name: UpdateService :one
 UPDATE services
-SET title = ?, description = ?, features = ?, icon_svg = ?,
-    color_scheme = ?, sort_order = ?, updated_at = CURRENT_TIMESTAMP
+SET title = ?, description = ?, category = ?, duration = ?, pricing = ?,
+    deliverables = ?, technologies = ?, features = ?, icon_svg = ?,
+    color_scheme = ?, sort_order = ?, is_active = ?, updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at
+RETURNING id, title, description, features, icon_svg, color_scheme, sort_order, is_active, created_at, updated_at, category, duration, pricing, deliverables, technologies
 `
 
 type UpdateServiceParams struct {
-	Title       string         `json:"title"`
-	Description string         `json:"description"`
-	Features    sql.NullString `json:"features"`
-	IconSvg     sql.NullString `json:"icon_svg"`
-	ColorScheme sql.NullString `json:"color_scheme"`
-	SortOrder   sql.NullInt64  `json:"sort_order"`
-	ID          string         `json:"id"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	Category     string         `json:"category"`
+	Duration     sql.NullString `json:"duration"`
+	Pricing      sql.NullString `json:"pricing"`
+	Deliverables sql.NullString `json:"deliverables"`
+	Technologies sql.NullString `json:"technologies"`
+	Features     sql.NullString `json:"features"`
+	IconSvg      sql.NullString `json:"icon_svg"`
+	ColorScheme  sql.NullString `json:"color_scheme"`
+	SortOrder    sql.NullInt64  `json:"sort_order"`
+	IsActive     sql.NullBool   `json:"is_active"`
+	ID           string         `json:"id"`
 }
 
 func (q *Queries) UpdateService(ctx context.Context, arg UpdateServiceParams) (Service, error) {
 	row := q.db.QueryRowContext(ctx, UpdateService,
 		arg.Title,
 		arg.Description,
+		arg.Category,
+		arg.Duration,
+		arg.Pricing,
+		arg.Deliverables,
+		arg.Technologies,
 		arg.Features,
 		arg.IconSvg,
 		arg.ColorScheme,
 		arg.SortOrder,
+		arg.IsActive,
 		arg.ID,
 	)
 	var i Service
@@ -600,6 +718,11 @@ func (q *Queries) UpdateService(ctx context.Context, arg UpdateServiceParams) (S
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Category,
+		&i.Duration,
+		&i.Pricing,
+		&i.Deliverables,
+		&i.Technologies,
 	)
 	return i, err
 }
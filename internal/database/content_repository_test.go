@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/testutil"
+)
+
+func newMigratedManager(t *testing.T) *DatabaseManager {
+	t.Helper()
+
+	dm := newTestManager(t, filepath.Join(t.TempDir(), "site.db"))
+	testutil.AssertNoError(t, dm.Migrate(context.Background()))
+
+	return dm
+}
+
+func TestExperienceRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewExperienceRepository(newMigratedManager(t).Queries())
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	exp := domain.NewExperience("Acme", "Engineer", "Built things", "Berlin", start, true)
+	exp.ID = "acme-001"
+	exp.AddTechnology(*domain.NewTechnology("Go", "language", domain.LevelExpert))
+	exp.AddAchievement(*domain.NewAchievementWithMetrics("Faster deploys", "Cut deploy time", "high",
+		&domain.Metrics{DeploymentTime: domain.NewDeploymentTimeMetric(60, 5)}))
+	testutil.AssertNoError(t, repo.Create(ctx, exp))
+
+	stored, err := repo.GetByID(ctx, "acme-001")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "Acme", stored.CompanyName)
+	testutil.AssertEqual(t, "Berlin", stored.Location)
+	testutil.AssertTrue(t, stored.IsRemote, "Expected the remote flag to be stored")
+	testutil.AssertTrue(t, stored.IsCurrent(), "Expected no end date")
+	testutil.AssertTrue(t, stored.StartDate.Equal(start), "Expected the start date to be stored")
+	testutil.AssertLen(t, stored.Technologies, 1)
+	testutil.AssertEqual(t, "Go", stored.Technologies[0].Name)
+	testutil.AssertLen(t, stored.Achievements, 1)
+	testutil.AssertEqual(t, 60, stored.Achievements[0].Metrics.DeploymentTime.Before)
+
+	testutil.AssertNoError(t, stored.SetEndDate(start.AddDate(2, 0, 0)))
+	stored.Position = "Lead Engineer"
+	testutil.AssertNoError(t, repo.Update(ctx, stored))
+
+	updated, err := repo.GetByID(ctx, "acme-001")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "Lead Engineer", updated.Position)
+	testutil.AssertFalse(t, updated.IsCurrent(), "Expected the end date to be stored")
+
+	withGo, err := repo.GetWithTechnology(ctx, "Go")
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, withGo, 1)
+
+	current, err := repo.GetCurrent(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, current, 0)
+
+	testutil.AssertNoError(t, repo.Delete(ctx, "acme-001"))
+
+	_, err = repo.GetByID(ctx, "acme-001")
+	testutil.AssertNotFoundError(t, err)
+
+	err = repo.Update(ctx, updated)
+	testutil.AssertNotFoundError(t, err)
+}
+
+func TestServiceRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewServiceRepository(newMigratedManager(t).Queries())
+
+	svc := domain.NewService("Custody Integration", "Wallet infrastructure", domain.ServiceTypeArchitecture)
+	svc.ID = "service-001"
+	svc.Duration = "4-8 weeks"
+	svc.AddDeliverable(*domain.NewDeliverable("Architecture", "Design document", "week 1"))
+	testutil.AssertNoError(t, svc.SetPricing(domain.PricingInfo{Type: domain.PricingTypeDaily, Amount: 1200, Currency: "EUR"}))
+	testutil.AssertNoError(t, repo.Create(ctx, svc))
+
+	inactive := domain.NewService("Audits", "Smart contract reviews", domain.ServiceTypeAuditing)
+	inactive.ID = "service-002"
+	inactive.Deactivate()
+	testutil.AssertNoError(t, repo.Create(ctx, inactive))
+
+	stored, err := repo.GetByName(ctx, "Custody Integration")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "service-001", stored.ID)
+	testutil.AssertEqual(t, "4-8 weeks", stored.Duration)
+	testutil.AssertEqual(t, domain.PricingTypeDaily, stored.Pricing.Type)
+	testutil.AssertEqual(t, 1200.0, stored.Pricing.Amount)
+	testutil.AssertLen(t, stored.Deliverables, 1)
+
+	active, err := repo.GetActive(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, active, 1)
+
+	isActive := false
+	listed, err := repo.List(ctx, repository.ServiceFilter{IsActive: &isActive})
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, listed, 1)
+	testutil.AssertEqual(t, "service-002", listed[0].ID)
+
+	daily, err := repo.GetByPricingType(ctx, domain.PricingTypeDaily)
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, daily, 1)
+
+	stored.Name = "Custody Platforms"
+	stored.Pricing = nil
+	testutil.AssertNoError(t, repo.Update(ctx, stored))
+
+	updated, err := repo.GetByID(ctx, "service-001")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "Custody Platforms", updated.Name)
+	testutil.AssertTrue(t, updated.Pricing == nil, "Expected the pricing to be cleared")
+
+	_, err = repo.GetByName(ctx, "Custody Integration")
+	testutil.AssertNotFoundError(t, err)
+}
+
+func TestContentRevision(t *testing.T) {
+	ctx := context.Background()
+	queries := newMigratedManager(t).Queries()
+
+	before, err := queries.GetContentRevision(ctx)
+	testutil.AssertNoError(t, err)
+
+	svc := domain.NewService("Audits", "Smart contract reviews", domain.ServiceTypeAuditing)
+	svc.ID = "service-001"
+
+	repo := NewServiceRepository(queries)
+	testutil.AssertNoError(t, repo.Create(ctx, svc))
+	testutil.AssertNoError(t, repo.Update(ctx, svc))
+	testutil.AssertNoError(t, repo.Delete(ctx, svc.ID))
+
+	after, err := queries.GetContentRevision(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, before.Revision+3, after.Revision)
+}
@@ -5,12 +5,21 @@ package database
 import (
 	"context"
 	"database/sql"
+	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/mattn/go-sqlite3" // SQLite driver
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"holger-hahn-website/internal/constants"
 )
 
 // Config holds database configuration options.
@@ -37,7 +46,7 @@ type Config struct {
 // DefaultConfig returns a default database configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		DatabasePath:    "./data/holger-hahn.db",
+		DatabasePath:    constants.DefaultDatabasePath,
 		MaxOpenConns:    25,
 		MaxIdleConns:    5,
 		ConnMaxLifetime: 5 * time.Minute,
@@ -67,8 +76,16 @@ func NewDatabaseManager(config *Config) (*DatabaseManager, error) {
 	// Build connection string with pragma settings
 	connStr := buildConnectionString(config)
 
-	// Open database connection
-	db, err := sql.Open("sqlite3", connStr)
+	// Open database connection; every query becomes a span of the request
+	// that issued it
+	db, err := otelsql.Open("sqlite3", connStr,
+		otelsql.WithAttributes(semconv.DBSystemNameSQLite),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -188,22 +205,333 @@ func (dm *DatabaseManager) Close() error {
 	return nil
 }
 
-// Migrate applies database migrations (placeholder for now).
+// schemaDir holds the numbered migration files applied by Migrate. They are
+// embedded so that migrations run wherever the binary is started from.
+const schemaDir = "schema"
+
+//go:embed schema/*.sql
+var schemaFS embed.FS
+
+// ErrPendingMigrations is reported while embedded migrations are not applied.
+var ErrPendingMigrations = errors.New("pending migrations")
+
+// ErrIrreversibleMigration is returned when a migration to roll back has no
+// down file.
+var ErrIrreversibleMigration = errors.New("migration cannot be rolled back")
+
+// downSuffix ends the file that reverts the migration of the same name, as in
+// 002_api_tokens.sql and 002_api_tokens.down.sql.
+const downSuffix = ".down.sql"
+
+// MigrationStatus describes one embedded migration.
+type MigrationStatus struct {
+	Version   string     `json:"version"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+// legacySchemaVersion is the migration that databases created before version
+// tracking already contain.
+const legacySchemaVersion = "001_initial.sql"
+
+// Migrate applies pending schema migrations in filename order.
+// Applied versions are recorded in schema_migrations so each file runs only once.
 func (dm *DatabaseManager) Migrate(ctx context.Context) error {
-	// Read and execute schema file
-	schemaPath := "./internal/database/schema/001_initial.sql"
-	schemaSQL, err := os.ReadFile(schemaPath)
+	if err := dm.ensureMigrationsTable(ctx); err != nil {
+		return err
+	}
+
+	files, err := migrationFiles(schemaFS, schemaDir)
+	if err != nil {
+		return err
+	}
+
+	applied, err := dm.appliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range files {
+		if applied[version] {
+			continue
+		}
+
+		// Databases created before migrations were tracked already have the initial schema
+		if version == legacySchemaVersion {
+			exists, err := dm.tableExists(ctx, "contacts")
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				if err := dm.recordMigration(ctx, dm.db, version); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if err := dm.applyMigration(ctx, version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PendingMigrations returns the embedded migrations not yet applied, in order.
+func (dm *DatabaseManager) PendingMigrations(ctx context.Context) ([]string, error) {
+	files, err := migrationFiles(schemaFS, schemaDir)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := dm.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, version := range files {
+		if !applied[version] {
+			pending = append(pending, version)
+		}
+	}
+
+	return pending, nil
+}
+
+// MigrationCheck reports an error while migrations are pending, so that an
+// instance does not serve traffic against an outdated schema.
+func (dm *DatabaseManager) MigrationCheck(ctx context.Context) error {
+	pending, err := dm.PendingMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %s", ErrPendingMigrations, strings.Join(pending, ", "))
+	}
+
+	return nil
+}
+
+// MigrateDown rolls back the last steps applied migrations, newest first, and
+// returns the versions it reverted.
+func (dm *DatabaseManager) MigrateDown(ctx context.Context, steps int) ([]string, error) {
+	statuses, err := dm.MigrationStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []string
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		if !statuses[i].Applied {
+			continue
+		}
+
+		if err := dm.revertMigration(ctx, statuses[i].Version); err != nil {
+			return reverted, err
+		}
+
+		reverted = append(reverted, statuses[i].Version)
+	}
+
+	return reverted, nil
+}
+
+// MigrationStatuses lists the embedded migrations in order and when each was
+// applied.
+func (dm *DatabaseManager) MigrationStatuses(ctx context.Context) ([]MigrationStatus, error) {
+	if err := dm.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+
+	files, err := migrationFiles(schemaFS, schemaDir)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := dm.db.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	appliedAt := make(map[string]sql.NullTime)
+	for rows.Next() {
+		var (
+			version string
+			at      sql.NullTime
+		)
+
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+
+		appliedAt[version] = at
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	statuses := make([]MigrationStatus, 0, len(files))
+	for _, version := range files {
+		status := MigrationStatus{Version: version}
+
+		if at, ok := appliedAt[version]; ok {
+			status.Applied = true
+			if at.Valid {
+				status.AppliedAt = &at.Time
+			}
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// ensureMigrationsTable creates the table recording applied migrations.
+func (dm *DatabaseManager) ensureMigrationsTable(ctx context.Context) error {
+	if _, err := dm.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version TEXT PRIMARY KEY,
+    applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	return nil
+}
+
+// migrationFiles returns the sorted .sql file names in dir of fsys, leaving
+// out the down files.
+func migrationFiles(fsys fs.FS, dir string) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, dir)
 	if err != nil {
-		return fmt.Errorf("failed to read schema file: %w", err)
+		return nil, fmt.Errorf("failed to read schema directory: %w", err)
 	}
 
-	if _, err := dm.db.ExecContext(ctx, string(schemaSQL)); err != nil {
-		return fmt.Errorf("failed to execute schema: %w", err)
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".sql") && !strings.HasSuffix(entry.Name(), downSuffix) {
+			files = append(files, entry.Name())
+		}
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// appliedMigrations returns the set of already applied migration versions.
+func (dm *DatabaseManager) appliedMigrations(ctx context.Context) (map[string]bool, error) {
+	rows, err := dm.db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[string]bool)
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// applyMigration executes a single migration file and records it in one transaction.
+func (dm *DatabaseManager) applyMigration(ctx context.Context, version string) error {
+	schemaSQL, err := fs.ReadFile(schemaFS, path.Join(schemaDir, version))
+	if err != nil {
+		return fmt.Errorf("failed to read schema file %s: %w", version, err)
+	}
+
+	tx, err := dm.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, string(schemaSQL)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to execute schema %s: %w", version, err)
+	}
+
+	if err := dm.recordMigration(ctx, tx, version); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", version, err)
+	}
+
+	return nil
+}
+
+// revertMigration executes the down file of a migration and forgets the
+// migration in one transaction.
+func (dm *DatabaseManager) revertMigration(ctx context.Context, version string) error {
+	downFile := strings.TrimSuffix(version, ".sql") + downSuffix
+
+	schemaSQL, err := fs.ReadFile(schemaFS, path.Join(schemaDir, downFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s has no %s", ErrIrreversibleMigration, version, downFile)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to read schema file %s: %w", downFile, err)
+	}
+
+	tx, err := dm.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, string(schemaSQL)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to execute schema %s: %w", downFile, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", version); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to forget migration %s: %w", version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit rollback of %s: %w", version, err)
+	}
+
+	return nil
+}
+
+// recordMigration marks a migration version as applied.
+func (dm *DatabaseManager) recordMigration(ctx context.Context, db DBTX, version string) error {
+	if _, err := db.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES (?)", version); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", version, err)
 	}
 
 	return nil
 }
 
+// tableExists checks if a table with the given name exists.
+func (dm *DatabaseManager) tableExists(ctx context.Context, name string) (bool, error) {
+	var count int
+	err := dm.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name,
+	).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect schema: %w", err)
+	}
+
+	return count > 0, nil
+}
+
 // GetStats returns database connection statistics.
 func (dm *DatabaseManager) GetStats() sql.DBStats {
 	return dm.db.Stats()
@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+func newTestManager(t *testing.T, path string) *DatabaseManager {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.DatabasePath = path
+
+	dm, err := NewDatabaseManager(config)
+	testutil.AssertNoError(t, err)
+	t.Cleanup(func() { dm.Close() })
+
+	return dm
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	dm := newTestManager(t, filepath.Join(t.TempDir(), "site.db"))
+
+	statuses, err := dm.MigrationStatuses(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, len(statuses) > 0, "Expected embedded migrations")
+
+	for _, status := range statuses {
+		testutil.AssertFalse(t, status.Applied, "Expected "+status.Version+" to be pending")
+	}
+
+	testutil.AssertNoError(t, dm.Migrate(ctx))
+
+	statuses, err = dm.MigrationStatuses(ctx)
+	testutil.AssertNoError(t, err)
+
+	for _, status := range statuses {
+		testutil.AssertTrue(t, status.Applied && status.AppliedAt != nil, "Expected "+status.Version+" to be applied")
+	}
+
+	reverted, err := dm.MigrateDown(ctx, 1)
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, reverted, 1)
+	testutil.AssertEqual(t, statuses[len(statuses)-1].Version, reverted[0])
+
+	exists, err := dm.tableExists(ctx, "admin_sessions")
+	testutil.AssertNoError(t, err)
+	testutil.AssertFalse(t, exists, "Expected the down migration to drop admin_sessions")
+
+	// Rolling back everything leaves an empty schema that migrates again
+	reverted, err = dm.MigrateDown(ctx, len(statuses))
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, reverted, len(statuses)-1)
+
+	pending, err := dm.PendingMigrations(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, pending, len(statuses))
+
+	testutil.AssertNoError(t, dm.Migrate(ctx))
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "site.db")
+
+	dm := newTestManager(t, dbPath)
+	testutil.AssertNoError(t, dm.Migrate(ctx))
+
+	_, err := dm.Queries().CreateContact(ctx, CreateContactParams{Name: "Ada", Email: "ada@example.com", Message: "Hello there"})
+	testutil.AssertNoError(t, err)
+
+	backup := filepath.Join(dir, "backups", "site.db")
+	testutil.AssertNoError(t, dm.Backup(ctx, backup))
+
+	err = dm.Backup(ctx, backup)
+	testutil.AssertTrue(t, errors.Is(err, ErrBackupExists), "Expected an existing backup to be kept")
+
+	info, err := os.Stat(backup)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, os.FileMode(0o600), info.Mode().Perm())
+
+	t.Run("restore", func(t *testing.T) {
+		target := filepath.Join(t.TempDir(), "restored.db")
+		testutil.AssertNoError(t, Restore(ctx, backup, target))
+
+		restored := newTestManager(t, target)
+		count, err := restored.Queries().CountContacts(ctx)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, int64(1), count)
+	})
+
+	t.Run("not a backup", func(t *testing.T) {
+		other := filepath.Join(t.TempDir(), "other.db")
+		newTestManager(t, other)
+
+		err := Restore(ctx, other, filepath.Join(t.TempDir(), "restored.db"))
+		testutil.AssertTrue(t, errors.Is(err, ErrInvalidBackup), "Expected a database without migrations to be rejected")
+	})
+}
+
+func TestRollupAnalytics(t *testing.T) {
+	ctx := context.Background()
+	dm := newTestManager(t, filepath.Join(t.TempDir(), "site.db"))
+	testutil.AssertNoError(t, dm.Migrate(ctx))
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	events := []struct {
+		at      time.Time
+		session string
+	}{
+		{day.Add(9 * time.Hour), "a"},
+		{day.Add(10 * time.Hour), "a"},
+		{day.Add(11 * time.Hour), "b"},
+		{day.Add(24 * time.Hour), "c"},
+	}
+
+	for _, event := range events {
+		_, err := dm.DB().ExecContext(ctx,
+			"INSERT INTO analytics_events (event_type, page_path, session_id, created_at) VALUES ('page_view', '/', ?, ?)",
+			event.session, event.at)
+		testutil.AssertNoError(t, err)
+	}
+
+	// Only whole days before the cutoff are rolled up
+	rollup, err := dm.RollupAnalytics(ctx, day.Add(30*time.Hour), true)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, int64(1), rollup.Days)
+	testutil.AssertEqual(t, int64(3), rollup.Pruned)
+
+	var count, sessions int64
+	err = dm.DB().QueryRowContext(ctx,
+		"SELECT event_count, unique_sessions FROM analytics_daily WHERE day = '2026-03-01' AND page_path = '/'",
+	).Scan(&count, &sessions)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, int64(3), count)
+	testutil.AssertEqual(t, int64(2), sessions)
+
+	var remaining int64
+	testutil.AssertNoError(t, dm.DB().QueryRowContext(ctx, "SELECT COUNT(*) FROM analytics_events").Scan(&remaining))
+	testutil.AssertEqual(t, int64(1), remaining)
+}
+
+func TestContactRepository_DeleteBefore(t *testing.T) {
+	ctx := context.Background()
+	dm := newTestManager(t, filepath.Join(t.TempDir(), "site.db"))
+	testutil.AssertNoError(t, dm.Migrate(ctx))
+
+	_, err := dm.Queries().CreateContact(ctx, CreateContactParams{Name: "Ada", Email: "ada@example.com", Message: "Hello there"})
+	testutil.AssertNoError(t, err)
+
+	repo := NewContactRepository(dm.Queries())
+	now := time.Now()
+
+	count, err := repo.CountBefore(ctx, now.Add(-time.Hour))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 0, count)
+
+	deleted, err := repo.DeleteBefore(ctx, now.Add(time.Hour))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, deleted)
+
+	remaining, err := repo.Count(ctx, "")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 0, remaining)
+}
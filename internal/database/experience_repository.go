@@ -0,0 +1,262 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+)
+
+// ExperienceRepository implements repository.ExperienceRepository using sqlc
+// generated code. Achievements and technologies are kept as JSON. Filtering,
+// ordering and paging are left to the experience service.
+type ExperienceRepository struct {
+	queries *Queries
+}
+
+// NewExperienceRepository creates a new database experience repository.
+func NewExperienceRepository(queries *Queries) *ExperienceRepository {
+	return &ExperienceRepository{
+		queries: queries,
+	}
+}
+
+// Create stores a new experience under its ID.
+func (r *ExperienceRepository) Create(ctx context.Context, entity *domain.Experience) error {
+	if entity == nil {
+		return fmt.Errorf("experience cannot be nil")
+	}
+
+	achievements, technologies, err := experienceJSON(entity)
+	if err != nil {
+		return err
+	}
+
+	created, err := r.queries.CreateExperience(ctx, CreateExperienceParams{
+		ID:           entity.ID,
+		Company:      entity.CompanyName,
+		Position:     entity.Position,
+		Description:  entity.Description,
+		Location:     nullStringFromString(entity.Location),
+		IsRemote:     sql.NullBool{Bool: entity.IsRemote, Valid: true},
+		Achievements: achievements,
+		Technologies: technologies,
+		StartDate:    entity.StartDate,
+		EndDate:      nullTimeFromPtr(entity.EndDate),
+		IsCurrent:    sql.NullBool{Bool: entity.IsCurrent(), Valid: true},
+		SortOrder:    sql.NullInt64{Int64: 0, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create experience: %w", err)
+	}
+
+	entity.CreatedAt, entity.UpdatedAt = created.CreatedAt.Time, created.UpdatedAt.Time
+
+	return nil
+}
+
+// GetByID retrieves an experience by its ID.
+func (r *ExperienceRepository) GetByID(ctx context.Context, id string) (*domain.Experience, error) {
+	row, err := r.queries.GetExperience(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound("experience")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experience: %w", err)
+	}
+
+	return toDomainExperience(row)
+}
+
+// Update replaces the stored experience with entity.
+func (r *ExperienceRepository) Update(ctx context.Context, entity *domain.Experience) error {
+	if entity == nil {
+		return fmt.Errorf("experience cannot be nil")
+	}
+
+	achievements, technologies, err := experienceJSON(entity)
+	if err != nil {
+		return err
+	}
+
+	updated, err := r.queries.UpdateExperience(ctx, UpdateExperienceParams{
+		Company:      entity.CompanyName,
+		Position:     entity.Position,
+		Description:  entity.Description,
+		Location:     nullStringFromString(entity.Location),
+		IsRemote:     sql.NullBool{Bool: entity.IsRemote, Valid: true},
+		Achievements: achievements,
+		Technologies: technologies,
+		StartDate:    entity.StartDate,
+		EndDate:      nullTimeFromPtr(entity.EndDate),
+		IsCurrent:    sql.NullBool{Bool: entity.IsCurrent(), Valid: true},
+		SortOrder:    sql.NullInt64{Int64: 0, Valid: true},
+		ID:           entity.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound("experience")
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update experience: %w", err)
+	}
+
+	entity.UpdatedAt = updated.UpdatedAt.Time
+
+	return nil
+}
+
+// Delete removes an experience by ID.
+func (r *ExperienceRepository) Delete(ctx context.Context, id string) error {
+	if err := r.queries.DeleteExperience(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete experience: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves all experiences, current ones and then the most recent first.
+func (r *ExperienceRepository) List(ctx context.Context, _ repository.ExperienceFilter) ([]*domain.Experience, error) {
+	rows, err := r.queries.ListExperiences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiences: %w", err)
+	}
+
+	experiences := make([]*domain.Experience, 0, len(rows))
+
+	for _, row := range rows {
+		exp, err := toDomainExperience(row)
+		if err != nil {
+			return nil, err
+		}
+
+		experiences = append(experiences, exp)
+	}
+
+	return experiences, nil
+}
+
+// GetCurrent retrieves the experiences without an end date.
+func (r *ExperienceRepository) GetCurrent(ctx context.Context) ([]*domain.Experience, error) {
+	return r.listWhere(ctx, (*domain.Experience).IsCurrent)
+}
+
+// GetByCompany retrieves the experiences at companyName.
+func (r *ExperienceRepository) GetByCompany(ctx context.Context, companyName string) ([]*domain.Experience, error) {
+	return r.listWhere(ctx, func(exp *domain.Experience) bool {
+		return exp.CompanyName == companyName
+	})
+}
+
+// GetByDateRange retrieves the experiences that lie within startDate and endDate.
+func (r *ExperienceRepository) GetByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*domain.Experience, error) {
+	return r.listWhere(ctx, func(exp *domain.Experience) bool {
+		return !exp.StartDate.Before(startDate) && (exp.EndDate == nil || !exp.EndDate.After(endDate))
+	})
+}
+
+// GetWithTechnology retrieves the experiences that used technologyName.
+func (r *ExperienceRepository) GetWithTechnology(ctx context.Context, technologyName string) ([]*domain.Experience, error) {
+	return r.listWhere(ctx, func(exp *domain.Experience) bool {
+		return hasTechnology(exp.Technologies, technologyName)
+	})
+}
+
+func (r *ExperienceRepository) listWhere(ctx context.Context, keep func(*domain.Experience) bool) ([]*domain.Experience, error) {
+	experiences, err := r.List(ctx, repository.ExperienceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterRows(experiences, keep), nil
+}
+
+// experienceJSON encodes the achievements and technologies of exp.
+func experienceJSON(exp *domain.Experience) (achievements, technologies sql.NullString, err error) {
+	if achievements, err = nullJSON(exp.Achievements); err != nil {
+		return achievements, technologies, fmt.Errorf("failed to encode achievements: %w", err)
+	}
+
+	if technologies, err = nullJSON(exp.Technologies); err != nil {
+		return achievements, technologies, fmt.Errorf("failed to encode technologies: %w", err)
+	}
+
+	return achievements, technologies, nil
+}
+
+// toDomainExperience converts a database Experience to a domain Experience.
+func toDomainExperience(row Experience) (*domain.Experience, error) {
+	exp := &domain.Experience{
+		ID:           row.ID,
+		CompanyName:  row.Company,
+		Position:     row.Position,
+		Description:  row.Description,
+		Location:     stringFromNullString(row.Location),
+		IsRemote:     row.IsRemote.Bool,
+		StartDate:    row.StartDate,
+		EndDate:      ptrFromNullTime(row.EndDate),
+		Technologies: []domain.Technology{},
+		Achievements: []domain.Achievement{},
+		CreatedAt:    row.CreatedAt.Time,
+		UpdatedAt:    row.UpdatedAt.Time,
+	}
+
+	if err := fromNullJSON(row.Achievements, &exp.Achievements); err != nil {
+		return nil, fmt.Errorf("failed to decode achievements of experience %s: %w", row.ID, err)
+	}
+
+	if err := fromNullJSON(row.Technologies, &exp.Technologies); err != nil {
+		return nil, fmt.Errorf("failed to decode technologies of experience %s: %w", row.ID, err)
+	}
+
+	return exp, nil
+}
+
+// nullJSON encodes v for a JSON text column.
+func nullJSON(v any) (sql.NullString, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// fromNullJSON decodes a JSON text column into v, leaving v as it is for NULL.
+func fromNullJSON(ns sql.NullString, v any) error {
+	if !ns.Valid || ns.String == "" {
+		return nil
+	}
+
+	return json.Unmarshal([]byte(ns.String), v)
+}
+
+// hasTechnology reports whether technologies include one named name.
+func hasTechnology(technologies []domain.Technology, name string) bool {
+	for _, tech := range technologies {
+		if tech.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterRows returns the items keep accepts.
+func filterRows[T any](items []T, keep func(T) bool) []T {
+	kept := make([]T, 0, len(items))
+
+	for _, item := range items {
+		if keep(item) {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept
+}
@@ -9,6 +9,21 @@ import (
 	"time"
 )
 
+type AdminSession struct {
+	IDHash    string       `json:"id_hash"`
+	TokenID   string       `json:"token_id"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type AnalyticsDaily struct {
+	Day            time.Time `json:"day"`
+	EventType      string    `json:"event_type"`
+	PagePath       string    `json:"page_path"`
+	EventCount     int64     `json:"event_count"`
+	UniqueSessions int64     `json:"unique_sessions"`
+}
+
 type AnalyticsEvent struct {
 	ID        string         `json:"id"`
 	EventType string         `json:"event_type"`
@@ -21,6 +36,18 @@ type AnalyticsEvent struct {
 	CreatedAt sql.NullTime   `json:"created_at"`
 }
 
+type ApiToken struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	TokenPrefix string       `json:"token_prefix"`
+	TokenHash   string       `json:"token_hash"`
+	Scopes      string       `json:"scopes"`
+	ExpiresAt   sql.NullTime `json:"expires_at"`
+	LastUsedAt  sql.NullTime `json:"last_used_at"`
+	RevokedAt   sql.NullTime `json:"revoked_at"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+}
+
 type Contact struct {
 	ID        string         `json:"id"`
 	Name      string         `json:"name"`
@@ -34,6 +61,12 @@ type Contact struct {
 	Source    sql.NullString `json:"source"`
 }
 
+type ContentRevision struct {
+	ID        int64     `json:"id"`
+	Revision  int64     `json:"revision"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type Experience struct {
 	ID           string         `json:"id"`
 	Company      string         `json:"company"`
@@ -48,19 +81,26 @@ type Experience struct {
 	IsActive     sql.NullBool   `json:"is_active"`
 	CreatedAt    sql.NullTime   `json:"created_at"`
 	UpdatedAt    sql.NullTime   `json:"updated_at"`
+	Location     sql.NullString `json:"location"`
+	IsRemote     sql.NullBool   `json:"is_remote"`
 }
 
 type Service struct {
-	ID          string         `json:"id"`
-	Title       string         `json:"title"`
-	Description string         `json:"description"`
-	Features    sql.NullString `json:"features"`
-	IconSvg     sql.NullString `json:"icon_svg"`
-	ColorScheme sql.NullString `json:"color_scheme"`
-	SortOrder   sql.NullInt64  `json:"sort_order"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   sql.NullTime   `json:"created_at"`
-	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	ID           string         `json:"id"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	Features     sql.NullString `json:"features"`
+	IconSvg      sql.NullString `json:"icon_svg"`
+	ColorScheme  sql.NullString `json:"color_scheme"`
+	SortOrder    sql.NullInt64  `json:"sort_order"`
+	IsActive     sql.NullBool   `json:"is_active"`
+	CreatedAt    sql.NullTime   `json:"created_at"`
+	UpdatedAt    sql.NullTime   `json:"updated_at"`
+	Category     string         `json:"category"`
+	Duration     sql.NullString `json:"duration"`
+	Pricing      sql.NullString `json:"pricing"`
+	Deliverables sql.NullString `json:"deliverables"`
+	Technologies sql.NullString `json:"technologies"`
 }
 
 type Technology struct {
@@ -7,24 +7,36 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
 	CountContacts(ctx context.Context) (int64, error)
+	CountContactsBefore(ctx context.Context, createdAt sql.NullTime) (int64, error)
 	CountContactsByStatus(ctx context.Context, status sql.NullString) (int64, error)
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (ApiToken, error)
+	CreateAdminSession(ctx context.Context, arg CreateAdminSessionParams) error
 	CreateAnalyticsEvent(ctx context.Context, arg CreateAnalyticsEventParams) (AnalyticsEvent, error)
 	CreateContact(ctx context.Context, arg CreateContactParams) (Contact, error)
 	CreateExperience(ctx context.Context, arg CreateExperienceParams) (Experience, error)
 	CreateService(ctx context.Context, arg CreateServiceParams) (Service, error)
 	CreateTechnology(ctx context.Context, arg CreateTechnologyParams) (Technology, error)
+	DeleteAdminSession(ctx context.Context, idHash string) error
+	DeleteAnalyticsEventsBefore(ctx context.Context, createdAt sql.NullTime) (int64, error)
 	DeleteContact(ctx context.Context, id string) error
+	DeleteContactsBefore(ctx context.Context, createdAt sql.NullTime) (int64, error)
 	DeleteExperience(ctx context.Context, id string) error
+	DeleteExpiredAdminSessions(ctx context.Context, expiresAt time.Time) (int64, error)
 	DeleteOldAnalyticsEvents(ctx context.Context) error
 	DeleteService(ctx context.Context, id string) error
 	DeleteTechnology(ctx context.Context, id string) error
+	GetAPIToken(ctx context.Context, id string) (ApiToken, error)
+	GetAPITokenByHash(ctx context.Context, tokenHash string) (ApiToken, error)
+	GetAdminSession(ctx context.Context, idHash string) (AdminSession, error)
 	GetAnalyticsEvent(ctx context.Context, id string) (AnalyticsEvent, error)
 	GetContact(ctx context.Context, id string) (Contact, error)
 	GetContactByEmail(ctx context.Context, email string) (Contact, error)
+	GetContentRevision(ctx context.Context) (ContentRevision, error)
 	GetCurrentExperience(ctx context.Context) (Experience, error)
 	GetEventCountsByType(ctx context.Context) ([]GetEventCountsByTypeRow, error)
 	GetExperience(ctx context.Context, id string) (Experience, error)
@@ -32,6 +44,8 @@ type Querier interface {
 	GetService(ctx context.Context, id string) (Service, error)
 	GetTechnology(ctx context.Context, id string) (Technology, error)
 	GetTechnologyByName(ctx context.Context, name string) (Technology, error)
+	ListAPITokens(ctx context.Context) ([]ApiToken, error)
+	ListAllServices(ctx context.Context) ([]Service, error)
 	ListAnalyticsEvents(ctx context.Context, arg ListAnalyticsEventsParams) ([]AnalyticsEvent, error)
 	ListAnalyticsEventsByType(ctx context.Context, arg ListAnalyticsEventsByTypeParams) ([]AnalyticsEvent, error)
 	ListContacts(ctx context.Context, arg ListContactsParams) ([]Contact, error)
@@ -44,6 +58,9 @@ type Querier interface {
 	ListTechnologies(ctx context.Context) ([]Technology, error)
 	ListTechnologiesByCategory(ctx context.Context, category string) ([]Technology, error)
 	ListTechnologiesByLevel(ctx context.Context, proficiencyLevel string) ([]Technology, error)
+	RevokeAPIToken(ctx context.Context, arg RevokeAPITokenParams) (int64, error)
+	RollupAnalyticsEvents(ctx context.Context, createdAt sql.NullTime) (int64, error)
+	TouchAPIToken(ctx context.Context, arg TouchAPITokenParams) error
 	UpdateContactStatus(ctx context.Context, arg UpdateContactStatusParams) (Contact, error)
 	UpdateExperience(ctx context.Context, arg UpdateExperienceParams) (Experience, error)
 	UpdateService(ctx context.Context, arg UpdateServiceParams) (Service, error)
@@ -43,4 +43,22 @@ ORDER BY date DESC, event_count DESC;
 
 -- name: DeleteOldAnalyticsEvents :exec
 DELETE FROM analytics_events 
-WHERE created_at < datetime('now', '-90 days');
\ No newline at end of file
+WHERE created_at < datetime('now', '-90 days');
+
+-- name: RollupAnalyticsEvents :execrows
+INSERT INTO analytics_daily (day, event_type, page_path, event_count, unique_sessions)
+SELECT
+    DATE(created_at),
+    event_type,
+    COALESCE(page_path, ''),
+    COUNT(*),
+    COUNT(DISTINCT session_id)
+FROM analytics_events
+WHERE created_at < ?
+GROUP BY DATE(created_at), event_type, COALESCE(page_path, '')
+ON CONFLICT (day, event_type, page_path) DO UPDATE SET
+    event_count = excluded.event_count,
+    unique_sessions = excluded.unique_sessions;
+
+-- name: DeleteAnalyticsEventsBefore :execrows
+DELETE FROM analytics_events WHERE created_at < ?;
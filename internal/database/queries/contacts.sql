@@ -35,4 +35,10 @@ DELETE FROM contacts WHERE id = ?;
 SELECT COUNT(*) FROM contacts;
 
 -- name: CountContactsByStatus :one
-SELECT COUNT(*) FROM contacts WHERE status = ?;
\ No newline at end of file
+SELECT COUNT(*) FROM contacts WHERE status = ?;
+
+-- name: CountContactsBefore :one
+SELECT COUNT(*) FROM contacts WHERE created_at < ?;
+
+-- name: DeleteContactsBefore :execrows
+DELETE FROM contacts WHERE created_at < ?;
@@ -43,20 +43,26 @@ SELECT * FROM services
 WHERE is_active = TRUE
 ORDER BY sort_order, title;
 
+-- name: ListAllServices :many
+SELECT * FROM services
+ORDER BY sort_order, title;
+
 -- name: GetService :one
 SELECT * FROM services WHERE id = ?;
 
 -- name: CreateService :one
 INSERT INTO services (
-    title, description, features, icon_svg, color_scheme, sort_order
+    id, title, description, category, duration, pricing, deliverables, technologies,
+    features, icon_svg, color_scheme, sort_order, is_active
 ) VALUES (
-    ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 ) RETURNING *;
 
 -- name: UpdateService :one
 UPDATE services
-SET title = ?, description = ?, features = ?, icon_svg = ?,
-    color_scheme = ?, sort_order = ?, updated_at = CURRENT_TIMESTAMP
+SET title = ?, description = ?, category = ?, duration = ?, pricing = ?,
+    deliverables = ?, technologies = ?, features = ?, icon_svg = ?,
+    color_scheme = ?, sort_order = ?, is_active = ?, updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
 RETURNING *;
 
@@ -79,19 +85,22 @@ LIMIT 1;
 
 -- name: CreateExperience :one
 INSERT INTO experiences (
-    company, position, description, achievements, technologies,
+    id, company, position, description, location, is_remote, achievements, technologies,
     start_date, end_date, is_current, sort_order
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 ) RETURNING *;
 
 -- name: UpdateExperience :one
 UPDATE experiences
-SET company = ?, position = ?, description = ?, achievements = ?,
-    technologies = ?, start_date = ?, end_date = ?, is_current = ?,
+SET company = ?, position = ?, description = ?, location = ?, is_remote = ?,
+    achievements = ?, technologies = ?, start_date = ?, end_date = ?, is_current = ?,
     sort_order = ?, updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
 RETURNING *;
 
 -- name: DeleteExperience :exec
 DELETE FROM experiences WHERE id = ?;
+
+-- name: GetContentRevision :one
+SELECT * FROM content_revision WHERE id = 1;
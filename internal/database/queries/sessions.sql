@@ -0,0 +1,15 @@
+-- name: CreateAdminSession :exec
+INSERT INTO admin_sessions (
+    id_hash, token_id, expires_at
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: GetAdminSession :one
+SELECT * FROM admin_sessions WHERE id_hash = ?;
+
+-- name: DeleteAdminSession :exec
+DELETE FROM admin_sessions WHERE id_hash = ?;
+
+-- name: DeleteExpiredAdminSessions :execrows
+DELETE FROM admin_sessions WHERE expires_at <= ?;
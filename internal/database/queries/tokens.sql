@@ -0,0 +1,26 @@
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (
+    name, token_prefix, token_hash, scopes, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?
+) RETURNING *;
+
+-- name: GetAPIToken :one
+SELECT * FROM api_tokens WHERE id = ?;
+
+-- name: GetAPITokenByHash :one
+SELECT * FROM api_tokens WHERE token_hash = ?;
+
+-- name: ListAPITokens :many
+SELECT * FROM api_tokens
+ORDER BY created_at DESC;
+
+-- name: RevokeAPIToken :execrows
+UPDATE api_tokens
+SET revoked_at = ?
+WHERE id = ? AND revoked_at IS NULL;
+
+-- name: TouchAPIToken :exec
+UPDATE api_tokens
+SET last_used_at = ?
+WHERE id = ?;
@@ -0,0 +1,5 @@
+DROP TABLE experiences;
+DROP TABLE services;
+DROP TABLE technologies;
+DROP TABLE analytics_events;
+DROP TABLE contacts;
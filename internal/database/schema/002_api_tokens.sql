@@ -0,0 +1,15 @@
+-- Personal access tokens for scripted API access (CI, deploy scripts)
+
+CREATE TABLE api_tokens (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(16)))),
+    name TEXT NOT NULL,
+    token_prefix TEXT NOT NULL, -- first characters of the token, shown in listings
+    token_hash TEXT NOT NULL UNIQUE, -- SHA-256 of the full token, hex encoded
+    scopes TEXT NOT NULL, -- space separated list, e.g. 'content:write contacts:read'
+    expires_at DATETIME, -- NULL for tokens that never expire
+    last_used_at DATETIME,
+    revoked_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_tokens_created_at ON api_tokens(created_at);
@@ -0,0 +1,10 @@
+-- Daily analytics totals, kept after the raw events are pruned
+
+CREATE TABLE analytics_daily (
+    day DATE NOT NULL,
+    event_type TEXT NOT NULL,
+    page_path TEXT NOT NULL DEFAULT '', -- empty for events without a page
+    event_count INTEGER NOT NULL,
+    unique_sessions INTEGER NOT NULL,
+    PRIMARY KEY (day, event_type, page_path)
+);
@@ -0,0 +1,8 @@
+ALTER TABLE services DROP COLUMN technologies;
+ALTER TABLE services DROP COLUMN deliverables;
+ALTER TABLE services DROP COLUMN pricing;
+ALTER TABLE services DROP COLUMN duration;
+ALTER TABLE services DROP COLUMN category;
+
+ALTER TABLE experiences DROP COLUMN is_remote;
+ALTER TABLE experiences DROP COLUMN location;
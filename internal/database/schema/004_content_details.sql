@@ -0,0 +1,11 @@
+-- Experience and service fields the content editor and API write, so that
+-- portfolio content survives restarts and is shared by every instance
+
+ALTER TABLE experiences ADD COLUMN location TEXT;
+ALTER TABLE experiences ADD COLUMN is_remote BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE services ADD COLUMN category TEXT NOT NULL DEFAULT 'consulting';
+ALTER TABLE services ADD COLUMN duration TEXT;
+ALTER TABLE services ADD COLUMN pricing TEXT; -- JSON pricing object
+ALTER TABLE services ADD COLUMN deliverables TEXT; -- JSON array of deliverables
+ALTER TABLE services ADD COLUMN technologies TEXT; -- JSON array of technologies
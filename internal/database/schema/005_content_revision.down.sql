@@ -0,0 +1,10 @@
+DROP TRIGGER services_delete_revision;
+DROP TRIGGER services_update_revision;
+DROP TRIGGER services_insert_revision;
+DROP TRIGGER experiences_delete_revision;
+DROP TRIGGER experiences_update_revision;
+DROP TRIGGER experiences_insert_revision;
+DROP TRIGGER technologies_delete_revision;
+DROP TRIGGER technologies_update_revision;
+DROP TRIGGER technologies_insert_revision;
+DROP TABLE content_revision;
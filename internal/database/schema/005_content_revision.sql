@@ -0,0 +1,55 @@
+-- Content revision, moved on by every write to the portfolio content, so that
+-- a server's page cache also notices writes by the CLI and other instances
+
+CREATE TABLE content_revision (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    revision INTEGER NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+
+INSERT INTO content_revision (id, revision, updated_at) VALUES (1, 1, CURRENT_TIMESTAMP);
+
+CREATE TRIGGER technologies_insert_revision AFTER INSERT ON technologies
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER technologies_update_revision AFTER UPDATE ON technologies
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER technologies_delete_revision AFTER DELETE ON technologies
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER experiences_insert_revision AFTER INSERT ON experiences
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER experiences_update_revision AFTER UPDATE ON experiences
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER experiences_delete_revision AFTER DELETE ON experiences
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER services_insert_revision AFTER INSERT ON services
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER services_update_revision AFTER UPDATE ON services
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
+
+CREATE TRIGGER services_delete_revision AFTER DELETE ON services
+BEGIN
+    UPDATE content_revision SET revision = revision + 1, updated_at = CURRENT_TIMESTAMP WHERE id = 1;
+END;
@@ -0,0 +1,10 @@
+-- Content editor sessions; the browser only holds the random session ID
+
+CREATE TABLE admin_sessions (
+    id_hash TEXT PRIMARY KEY, -- SHA-256 of the session ID, hex encoded
+    token_id TEXT NOT NULL REFERENCES api_tokens(id) ON DELETE CASCADE,
+    expires_at DATETIME NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_admin_sessions_expires_at ON admin_sessions(expires_at);
@@ -0,0 +1,256 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+)
+
+// ServiceRepository implements repository.ServiceRepository using sqlc
+// generated code. Pricing, deliverables and technologies are kept as JSON.
+type ServiceRepository struct {
+	queries *Queries
+}
+
+// NewServiceRepository creates a new database service repository.
+func NewServiceRepository(queries *Queries) *ServiceRepository {
+	return &ServiceRepository{
+		queries: queries,
+	}
+}
+
+// Create stores a new service under its ID.
+func (r *ServiceRepository) Create(ctx context.Context, entity *domain.Service) error {
+	if entity == nil {
+		return fmt.Errorf("service cannot be nil")
+	}
+
+	pricing, deliverables, technologies, err := serviceJSON(entity)
+	if err != nil {
+		return err
+	}
+
+	created, err := r.queries.CreateService(ctx, CreateServiceParams{
+		ID:           entity.ID,
+		Title:        entity.Name,
+		Description:  entity.Description,
+		Category:     string(entity.Category),
+		Duration:     nullStringFromString(entity.Duration),
+		Pricing:      pricing,
+		Deliverables: deliverables,
+		Technologies: technologies,
+		SortOrder:    sql.NullInt64{Int64: 0, Valid: true},
+		IsActive:     sql.NullBool{Bool: entity.IsActive, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create service: %w", err)
+	}
+
+	entity.CreatedAt, entity.UpdatedAt = created.CreatedAt.Time, created.UpdatedAt.Time
+
+	return nil
+}
+
+// GetByID retrieves a service by its ID.
+func (r *ServiceRepository) GetByID(ctx context.Context, id string) (*domain.Service, error) {
+	row, err := r.queries.GetService(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound("service")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	return toDomainService(row)
+}
+
+// Update replaces the stored service with entity.
+func (r *ServiceRepository) Update(ctx context.Context, entity *domain.Service) error {
+	if entity == nil {
+		return fmt.Errorf("service cannot be nil")
+	}
+
+	pricing, deliverables, technologies, err := serviceJSON(entity)
+	if err != nil {
+		return err
+	}
+
+	updated, err := r.queries.UpdateService(ctx, UpdateServiceParams{
+		Title:        entity.Name,
+		Description:  entity.Description,
+		Category:     string(entity.Category),
+		Duration:     nullStringFromString(entity.Duration),
+		Pricing:      pricing,
+		Deliverables: deliverables,
+		Technologies: technologies,
+		SortOrder:    sql.NullInt64{Int64: 0, Valid: true},
+		IsActive:     sql.NullBool{Bool: entity.IsActive, Valid: true},
+		ID:           entity.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound("service")
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update service: %w", err)
+	}
+
+	entity.UpdatedAt = updated.UpdatedAt.Time
+
+	return nil
+}
+
+// Delete removes a service by ID.
+func (r *ServiceRepository) Delete(ctx context.Context, id string) error {
+	if err := r.queries.DeleteService(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete service: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves the services in the filter's category and active state.
+// Any other filtering, ordering and paging is left to the portfolio service.
+func (r *ServiceRepository) List(ctx context.Context, filter repository.ServiceFilter) ([]*domain.Service, error) {
+	rows, err := r.queries.ListAllServices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	services, err := toDomainServices(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterRows(services, func(svc *domain.Service) bool {
+		return (filter.Category == nil || svc.Category == *filter.Category) &&
+			(filter.IsActive == nil || svc.IsActive == *filter.IsActive)
+	}), nil
+}
+
+// GetByName retrieves a service by its name.
+func (r *ServiceRepository) GetByName(ctx context.Context, name string) (*domain.Service, error) {
+	services, err := r.List(ctx, repository.ServiceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, svc := range services {
+		if svc.Name == name {
+			return svc, nil
+		}
+	}
+
+	return nil, domain.ErrNotFound("service")
+}
+
+// GetActive retrieves the active services.
+func (r *ServiceRepository) GetActive(ctx context.Context) ([]*domain.Service, error) {
+	rows, err := r.queries.ListServices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active services: %w", err)
+	}
+
+	return toDomainServices(rows)
+}
+
+// GetByCategory retrieves the services in category.
+func (r *ServiceRepository) GetByCategory(ctx context.Context, category domain.ServiceType) ([]*domain.Service, error) {
+	return r.List(ctx, repository.ServiceFilter{Category: &category})
+}
+
+// GetWithTechnology retrieves the services that use technologyName.
+func (r *ServiceRepository) GetWithTechnology(ctx context.Context, technologyName string) ([]*domain.Service, error) {
+	services, err := r.List(ctx, repository.ServiceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterRows(services, func(svc *domain.Service) bool {
+		return hasTechnology(svc.Technologies, technologyName)
+	}), nil
+}
+
+// GetByPricingType retrieves the services priced by pricingType.
+func (r *ServiceRepository) GetByPricingType(ctx context.Context, pricingType domain.PricingType) ([]*domain.Service, error) {
+	services, err := r.List(ctx, repository.ServiceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterRows(services, func(svc *domain.Service) bool {
+		return svc.Pricing != nil && svc.Pricing.Type == pricingType
+	}), nil
+}
+
+// serviceJSON encodes the pricing, deliverables and technologies of svc.
+func serviceJSON(svc *domain.Service) (pricing, deliverables, technologies sql.NullString, err error) {
+	if svc.Pricing != nil {
+		if pricing, err = nullJSON(svc.Pricing); err != nil {
+			return pricing, deliverables, technologies, fmt.Errorf("failed to encode pricing: %w", err)
+		}
+	}
+
+	if deliverables, err = nullJSON(svc.Deliverables); err != nil {
+		return pricing, deliverables, technologies, fmt.Errorf("failed to encode deliverables: %w", err)
+	}
+
+	if technologies, err = nullJSON(svc.Technologies); err != nil {
+		return pricing, deliverables, technologies, fmt.Errorf("failed to encode technologies: %w", err)
+	}
+
+	return pricing, deliverables, technologies, nil
+}
+
+func toDomainServices(rows []Service) ([]*domain.Service, error) {
+	services := make([]*domain.Service, 0, len(rows))
+
+	for _, row := range rows {
+		svc, err := toDomainService(row)
+		if err != nil {
+			return nil, err
+		}
+
+		services = append(services, svc)
+	}
+
+	return services, nil
+}
+
+// toDomainService converts a database Service to a domain Service.
+func toDomainService(row Service) (*domain.Service, error) {
+	svc := &domain.Service{
+		ID:           row.ID,
+		Name:         row.Title,
+		Description:  row.Description,
+		Category:     domain.ServiceType(row.Category),
+		Duration:     stringFromNullString(row.Duration),
+		Technologies: []domain.Technology{},
+		Deliverables: []domain.Deliverable{},
+		IsActive:     row.IsActive.Bool,
+		CreatedAt:    row.CreatedAt.Time,
+		UpdatedAt:    row.UpdatedAt.Time,
+	}
+
+	if row.Pricing.Valid && row.Pricing.String != "" {
+		svc.Pricing = &domain.PricingInfo{}
+		if err := fromNullJSON(row.Pricing, svc.Pricing); err != nil {
+			return nil, fmt.Errorf("failed to decode pricing of service %s: %w", row.ID, err)
+		}
+	}
+
+	if err := fromNullJSON(row.Deliverables, &svc.Deliverables); err != nil {
+		return nil, fmt.Errorf("failed to decode deliverables of service %s: %w", row.ID, err)
+	}
+
+	if err := fromNullJSON(row.Technologies, &svc.Technologies); err != nil {
+		return nil, fmt.Errorf("failed to decode technologies of service %s: %w", row.ID, err)
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// SessionRepository implements domain.SessionRepository using sqlc generated code.
+type SessionRepository struct {
+	queries *Queries
+}
+
+// NewSessionRepository creates a new database session repository.
+func NewSessionRepository(queries *Queries) *SessionRepository {
+	return &SessionRepository{
+		queries: queries,
+	}
+}
+
+// Save stores a new session.
+func (r *SessionRepository) Save(ctx context.Context, session *domain.AdminSession) error {
+	if session == nil {
+		return fmt.Errorf("%w", domain.ErrSessionNil)
+	}
+
+	err := r.queries.CreateAdminSession(ctx, CreateAdminSessionParams{
+		IDHash:    session.IDHash,
+		TokenID:   session.TokenID,
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+
+	return nil
+}
+
+// FindByHash retrieves a session by the hash of its ID.
+func (r *SessionRepository) FindByHash(ctx context.Context, hash string) (*domain.AdminSession, error) {
+	row, err := r.queries.GetAdminSession(ctx, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w", domain.ErrSessionNotFound)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+
+	return &domain.AdminSession{
+		IDHash:    row.IDHash,
+		TokenID:   row.TokenID,
+		ExpiresAt: row.ExpiresAt,
+		CreatedAt: row.CreatedAt.Time,
+	}, nil
+}
+
+// Delete removes a session by the hash of its ID.
+func (r *SessionRepository) Delete(ctx context.Context, hash string) error {
+	if err := r.queries.DeleteAdminSession(ctx, hash); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the sessions that expired at or before now.
+func (r *SessionRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	deleted, err := r.queries.DeleteExpiredAdminSessions(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	return int(deleted), nil
+}
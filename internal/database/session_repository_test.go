@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestSessionRepository(t *testing.T) {
+	ctx := context.Background()
+	queries := newMigratedManager(t).Queries()
+
+	token := &domain.APIToken{Name: "editor", TokenHash: "token-hash", Scopes: []domain.Scope{domain.ScopeContentWrite}}
+	testutil.AssertNoError(t, NewTokenRepository(queries).Save(ctx, token))
+
+	repo := NewSessionRepository(queries)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for hash, expiresAt := range map[string]time.Time{"live": now.Add(time.Hour), "stale": now.Add(-time.Minute)} {
+		testutil.AssertNoError(t, repo.Save(ctx, &domain.AdminSession{IDHash: hash, TokenID: token.ID, ExpiresAt: expiresAt}))
+	}
+
+	stored, err := repo.FindByHash(ctx, "live")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, token.ID, stored.TokenID)
+	testutil.AssertTrue(t, stored.ExpiresAt.Equal(now.Add(time.Hour)), "Expected the expiry to be stored")
+
+	deleted, err := repo.DeleteExpired(ctx, now)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, deleted)
+
+	_, err = repo.FindByHash(ctx, "stale")
+	testutil.AssertTrue(t, errors.Is(err, domain.ErrSessionNotFound), "Expected the expired session to be gone")
+
+	testutil.AssertNoError(t, repo.Delete(ctx, "live"))
+
+	_, err = repo.FindByHash(ctx, "live")
+	testutil.AssertTrue(t, errors.Is(err, domain.ErrSessionNotFound), "Expected the session to be gone")
+}
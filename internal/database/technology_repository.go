@@ -136,9 +136,14 @@ func (r *TechnologyRepository) List(ctx context.Context, filter repository.Techn
 	}
 
 	// Convert to domain entities
-	technologies := make([]*domain.Technology, len(dbTechs))
-	for i, dbTech := range dbTechs {
-		technologies[i] = r.toDomainTechnology(dbTech)
+	technologies := make([]*domain.Technology, 0, len(dbTechs))
+	for _, dbTech := range dbTechs {
+		// The category query cannot also filter by level, so apply it here
+		if filter.Category != nil && filter.Level != nil && domain.Level(dbTech.ProficiencyLevel) != *filter.Level {
+			continue
+		}
+
+		technologies = append(technologies, r.toDomainTechnology(dbTech))
 	}
 
 	// Apply client-side pagination if needed (since sqlc doesn't support LIMIT/OFFSET in all queries)
@@ -0,0 +1,197 @@
+// Package database provides database repository implementations using sqlc generated code.
+// This file implements the TokenRepository interface with SQLite backend.
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// TokenRepository implements domain.TokenRepository using sqlc generated code.
+type TokenRepository struct {
+	queries *Queries
+}
+
+// NewTokenRepository creates a new database token repository.
+func NewTokenRepository(queries *Queries) *TokenRepository {
+	return &TokenRepository{
+		queries: queries,
+	}
+}
+
+// Save stores a newly issued token.
+func (r *TokenRepository) Save(ctx context.Context, token *domain.APIToken) error {
+	if token == nil {
+		return fmt.Errorf("%w", domain.ErrTokenNil)
+	}
+
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	params := CreateAPITokenParams{
+		Name:        token.Name,
+		TokenPrefix: token.Prefix,
+		TokenHash:   token.TokenHash,
+		Scopes:      joinScopes(token.Scopes),
+		ExpiresAt:   nullTimeFromPtr(token.ExpiresAt),
+	}
+
+	created, err := r.queries.CreateAPIToken(ctx, params)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrIssueToken, err)
+	}
+
+	// Update the token with the generated ID and timestamps
+	token.ID = created.ID
+	if created.CreatedAt.Valid {
+		token.CreatedAt = created.CreatedAt.Time
+	}
+
+	return nil
+}
+
+// FindByID retrieves a token by ID.
+func (r *TokenRepository) FindByID(ctx context.Context, id string) (*domain.APIToken, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w", domain.ErrIDEmpty)
+	}
+
+	dbToken, err := r.queries.GetAPIToken(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w", domain.ErrTokenNotFound)
+		}
+		return nil, fmt.Errorf("failed to find token: %w", err)
+	}
+
+	return r.toDomainToken(dbToken), nil
+}
+
+// FindByHash retrieves a token by the hash of its secret.
+func (r *TokenRepository) FindByHash(ctx context.Context, hash string) (*domain.APIToken, error) {
+	dbToken, err := r.queries.GetAPITokenByHash(ctx, hash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w", domain.ErrTokenNotFound)
+		}
+		return nil, fmt.Errorf("failed to find token: %w", err)
+	}
+
+	return r.toDomainToken(dbToken), nil
+}
+
+// FindAll retrieves all tokens, including revoked and expired ones.
+func (r *TokenRepository) FindAll(ctx context.Context) ([]*domain.APIToken, error) {
+	dbTokens, err := r.queries.ListAPITokens(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrListTokens, err)
+	}
+
+	tokens := make([]*domain.APIToken, len(dbTokens))
+	for i, dbToken := range dbTokens {
+		tokens[i] = r.toDomainToken(dbToken)
+	}
+
+	return tokens, nil
+}
+
+// Revoke marks a token as revoked.
+func (r *TokenRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	if id == "" {
+		return fmt.Errorf("%w", domain.ErrIDEmpty)
+	}
+
+	affected, err := r.queries.RevokeAPIToken(ctx, RevokeAPITokenParams{
+		RevokedAt: sql.NullTime{Time: at, Valid: true},
+		ID:        id,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrRevokeToken, err)
+	}
+
+	if affected == 0 {
+		// Either unknown or already revoked; only the former is an error
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TouchLastUsed records when a token was last used.
+func (r *TokenRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	err := r.queries.TouchAPIToken(ctx, TouchAPITokenParams{
+		LastUsedAt: sql.NullTime{Time: at, Valid: true},
+		ID:         id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update token usage: %w", err)
+	}
+
+	return nil
+}
+
+// toDomainToken converts a database ApiToken to a domain APIToken.
+func (r *TokenRepository) toDomainToken(dbToken ApiToken) *domain.APIToken {
+	token := &domain.APIToken{
+		ID:         dbToken.ID,
+		Name:       dbToken.Name,
+		Prefix:     dbToken.TokenPrefix,
+		TokenHash:  dbToken.TokenHash,
+		Scopes:     splitScopes(dbToken.Scopes),
+		ExpiresAt:  ptrFromNullTime(dbToken.ExpiresAt),
+		LastUsedAt: ptrFromNullTime(dbToken.LastUsedAt),
+		RevokedAt:  ptrFromNullTime(dbToken.RevokedAt),
+	}
+
+	if dbToken.CreatedAt.Valid {
+		token.CreatedAt = dbToken.CreatedAt.Time
+	}
+
+	return token
+}
+
+// joinScopes encodes scopes as the space separated list stored in the database.
+func joinScopes(scopes []domain.Scope) string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+
+	return strings.Join(names, " ")
+}
+
+// splitScopes decodes the space separated scope list stored in the database.
+func splitScopes(raw string) []domain.Scope {
+	fields := strings.Fields(raw)
+	scopes := make([]domain.Scope, len(fields))
+	for i, field := range fields {
+		scopes[i] = domain.Scope(field)
+	}
+
+	return scopes
+}
+
+func nullTimeFromPtr(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{
+		Time:  *t,
+		Valid: true,
+	}
+}
+
+func ptrFromNullTime(nt sql.NullTime) *time.Time {
+	if nt.Valid {
+		return &nt.Time
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package devserver runs the website in development mode. It watches the
+// templates, static files and seed content, regenerates templates and styles,
+// rebuilds and restarts the server when code changed, and reloads connected
+// browsers through a script it injects into every HTML page.
+package devserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Timings of the watch and restart cycle.
+const (
+	debounceDelay   = 150 * time.Millisecond
+	readyTimeout    = 30 * time.Second
+	shutdownTimeout = 5 * time.Second
+)
+
+// Config describes the project the dev server builds and where it listens.
+type Config struct {
+	// Addr is the address browsers connect to, e.g. "localhost:8080".
+	Addr string
+	// Package is the main package of the website, built with go build.
+	Package string
+	// Watch lists the directories watched recursively for changes.
+	Watch []string
+	// Templ regenerates the Go code of the templates.
+	Templ []string
+	// Tailwind rebuilds the stylesheet from TailwindInput into TailwindOutput.
+	Tailwind       []string
+	TailwindInput  string
+	TailwindOutput string
+	// StaticDir is served from disk instead of the embedded assets, so that
+	// static changes need no rebuild.
+	StaticDir string
+}
+
+// DefaultConfig returns the configuration for this repository, serving on addr.
+// Seed content lives with the in-memory repositories in internal/container,
+// and Tailwind is the one installed from package.json.
+func DefaultConfig(addr string) Config {
+	input := filepath.Join("static", "css", "input.css")
+	output := filepath.Join("static", "css", "styles.css")
+
+	return Config{
+		Addr:           addr,
+		Package:        ".",
+		Watch:          []string{"templates", "static", filepath.Join("internal", "container")},
+		Templ:          []string{"templ", "generate"},
+		Tailwind:       []string{filepath.Join("node_modules", ".bin", "tailwindcss"), "-i", input, "-o", output},
+		TailwindInput:  input,
+		TailwindOutput: output,
+		StaticDir:      "static",
+	}
+}
+
+// Server is the development server: a reverse proxy in front of the website
+// that restarts it on change and tells browsers to reload.
+type Server struct {
+	app    *app
+	reload *broker
+	cfg    Config
+	binary string
+}
+
+// New creates a development server for cfg.
+func New(cfg Config) *Server {
+	return &Server{
+		cfg:    cfg,
+		reload: newBroker(),
+		binary: filepath.Join(os.TempDir(), "holger-hahn-website-dev"),
+	}
+}
+
+// Run builds and starts the website, serves it on the configured address and
+// watches for changes until ctx is canceled.
+func (s *Server) Run(ctx context.Context) error {
+	upstream, err := freeAddress()
+	if err != nil {
+		return err
+	}
+
+	s.app = &app{binary: s.binary, addr: upstream, env: []string{"STATIC_DIR=" + s.cfg.StaticDir}}
+
+	w, err := s.newWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	// Start from fresh templates and styles; a failing build leaves the
+	// proxy answering with an error page until the next change fixes it.
+	s.apply(ctx, changeTemplates)
+
+	server := &http.Server{
+		Addr:              s.cfg.Addr,
+		Handler:           s.handler(&url.URL{Scheme: "http", Host: upstream}),
+		ReadHeaderTimeout: readyTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	log.Printf("🔧 Development server on http://%s, watching %v", s.cfg.Addr, s.cfg.Watch)
+
+	watchErr := s.watch(ctx, w)
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	s.reload.close()
+
+	err = errors.Join(watchErr, server.Shutdown(shutdownCtx), s.app.stop())
+
+	select {
+	case serr := <-serveErr:
+		if !errors.Is(serr, http.ErrServerClosed) {
+			err = errors.Join(err, serr)
+		}
+	default:
+	}
+
+	return err
+}
+
+// handler routes the reload events to the broker and everything else to the
+// website at upstream.
+func (s *Server) handler(upstream *url.URL) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(reloadPath, s.reload)
+	mux.Handle("/", newProxy(upstream))
+
+	return mux
+}
+
+// apply reacts to the changes c: it regenerates templates and styles, rebuilds
+// and restarts the website when needed and finally reloads the browsers.
+func (s *Server) apply(ctx context.Context, c change) {
+	if c&changeTemplates != 0 {
+		runTool(ctx, "templ", s.cfg.Templ)
+	}
+
+	// Tailwind scans the templates for classes, so both changes restyle.
+	if c&(changeTemplates|changeStyles) != 0 {
+		runTool(ctx, "tailwind", s.cfg.Tailwind)
+	}
+
+	if c&(changeTemplates|changeCode) != 0 {
+		err := s.restart(ctx)
+		if err != nil {
+			log.Printf("❌ %v", err)
+			return
+		}
+	}
+
+	s.reload.broadcast()
+}
+
+// restart builds the website and replaces the running server with the new
+// binary. The old server keeps running when the build fails.
+func (s *Server) restart(ctx context.Context) error {
+	started := time.Now()
+
+	err := build(ctx, s.cfg.Package, s.binary)
+	if err != nil {
+		return fmt.Errorf("build failed: %w", err)
+	}
+
+	err = s.app.stop()
+	if err != nil {
+		log.Printf("Stopping server: %v", err)
+	}
+
+	err = s.app.start(ctx)
+	if err != nil {
+		return fmt.Errorf("server did not start: %w", err)
+	}
+
+	log.Printf("♻️  Rebuilt and restarted in %s", time.Since(started).Round(time.Millisecond))
+
+	return nil
+}
+
+// freeAddress returns a loopback address with a port nothing listens on.
+func freeAddress() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	addr := l.Addr().String()
+
+	return addr, l.Close()
+}
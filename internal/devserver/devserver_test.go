@@ -0,0 +1,123 @@
+package devserver
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestClassify(t *testing.T) {
+	s := New(DefaultConfig("localhost:0"))
+
+	tests := map[string]change{
+		filepath.Join("templates", "hero.templ"):                         changeTemplates,
+		filepath.Join("templates", "hero_templ.go"):                      0,
+		filepath.Join("templates", ".hero.templ.swp"):                    0,
+		filepath.Join("static", "css", "input.css"):                      changeStyles,
+		filepath.Join("static", "css", "styles.css"):                     0,
+		filepath.Join("static", "css", "modern-theme.css"):               changeStatic,
+		filepath.Join("static", "images", "Holger-Hahn.webp"):            changeStatic,
+		filepath.Join("internal", "container", "memory_repositories.go"): changeCode,
+	}
+
+	for file, want := range tests {
+		if got := s.classify(file); got != want {
+			t.Errorf("classify(%q) = %d, want %d", file, got, want)
+		}
+	}
+}
+
+func TestInjectScript(t *testing.T) {
+	testutil.AssertEqual(t, "<html><body><p>x</p>"+reloadScript+"</body></html>", injectScript("<html><body><p>x</p></body></html>"))
+	testutil.AssertEqual(t, "<p>fragment</p>"+reloadScript, injectScript("<p>fragment</p>"))
+}
+
+func TestProxyInjectsReloadScript(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/data.json" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"ok":true}`)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("ETag", `"page"`)
+		_, _ = io.WriteString(w, "<html><body>Home</body></html>")
+	}))
+	defer upstream.Close()
+
+	target, err := url.Parse(upstream.URL)
+	testutil.AssertNoError(t, err)
+
+	proxy := httptest.NewServer(New(DefaultConfig("localhost:0")).handler(target))
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/")
+	testutil.AssertNoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	testutil.AssertNoError(t, err)
+	testutil.AssertNoError(t, resp.Body.Close())
+
+	testutil.AssertTrue(t, strings.Contains(string(body), reloadScript+"</body>"), "HTML should carry the reload script")
+	testutil.AssertEqual(t, int64(len(body)), resp.ContentLength)
+	testutil.AssertEqual(t, "", resp.Header.Get("ETag"))
+
+	resp, err = http.Get(proxy.URL + "/data.json")
+	testutil.AssertNoError(t, err)
+
+	body, err = io.ReadAll(resp.Body)
+	testutil.AssertNoError(t, err)
+	testutil.AssertNoError(t, resp.Body.Close())
+	testutil.AssertEqual(t, `{"ok":true}`, string(body))
+}
+
+func TestProxyUnavailable(t *testing.T) {
+	target, err := url.Parse("http://127.0.0.1:1")
+	testutil.AssertNoError(t, err)
+
+	rec := httptest.NewRecorder()
+	New(DefaultConfig("localhost:0")).handler(target).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	testutil.AssertEqual(t, http.StatusBadGateway, rec.Code)
+	testutil.AssertTrue(t, strings.Contains(rec.Body.String(), reloadScript), "error page should reload once the server is back")
+}
+
+func TestBrokerStreamsReloads(t *testing.T) {
+	b := newBroker()
+
+	server := httptest.NewServer(b)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	testutil.AssertNoError(t, err)
+
+	defer resp.Body.Close()
+
+	testutil.AssertEqual(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := bufio.NewReader(resp.Body)
+
+	line, err := events.ReadString('\n')
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, ": connected\n", line)
+
+	b.broadcast()
+
+	for line == "\n" || strings.HasPrefix(line, ":") {
+		line, err = events.ReadString('\n')
+		testutil.AssertNoError(t, err)
+	}
+
+	testutil.AssertEqual(t, "data: reload\n", line)
+
+	b.close()
+}
@@ -0,0 +1,151 @@
+package devserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"sync/atomic"
+	"time"
+)
+
+// errExited is returned when the website exits before accepting connections.
+var errExited = errors.New("server exited during startup")
+
+// app is the website running behind the proxy.
+type app struct {
+	proc   *process
+	binary string
+	addr   string
+	env    []string
+}
+
+// process is one run of the website binary.
+type process struct {
+	cmd      *exec.Cmd
+	exited   chan struct{}
+	stopping atomic.Bool
+}
+
+// start runs the binary listening on the app's address and waits until it
+// accepts connections.
+func (a *app) start(ctx context.Context) error {
+	host, port, err := net.SplitHostPort(a.addr)
+	if err != nil {
+		return err
+	}
+
+	// #nosec G204 - The binary was just built from this repository
+	cmd := exec.Command(a.binary)
+	cmd.Env = append(os.Environ(), "SERVER_HOST="+host, "SERVER_PORT="+port, "ENVIRONMENT=development")
+	cmd.Env = append(cmd.Env, a.env...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	proc := &process{cmd: cmd, exited: make(chan struct{})}
+
+	go func() {
+		err := cmd.Wait()
+		if err != nil && !proc.stopping.Load() {
+			log.Printf("Server exited: %v", err)
+		}
+
+		close(proc.exited)
+	}()
+
+	a.proc = proc
+
+	return a.waitReady(ctx)
+}
+
+// waitReady polls the app's address until it accepts connections, the
+// process exits or readyTimeout passes.
+func (a *app) waitReady(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(debounceDelay / 2)
+	defer ticker.Stop()
+
+	var dialer net.Dialer
+
+	for {
+		conn, err := dialer.DialContext(ctx, "tcp", a.addr)
+		if err == nil {
+			return conn.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-a.proc.exited:
+			return errExited
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop interrupts the running app and kills it if it does not exit in time.
+func (a *app) stop() error {
+	proc := a.proc
+	if proc == nil {
+		return nil
+	}
+
+	a.proc = nil
+	proc.stopping.Store(true)
+
+	select {
+	case <-proc.exited:
+		return nil
+	default:
+	}
+
+	err := proc.cmd.Process.Signal(os.Interrupt)
+	if err != nil {
+		return proc.cmd.Process.Kill()
+	}
+
+	select {
+	case <-proc.exited:
+		return nil
+	case <-time.After(shutdownTimeout):
+		return proc.cmd.Process.Kill()
+	}
+}
+
+// build compiles the main package pkg into binary.
+func build(ctx context.Context, pkg, binary string) error {
+	// #nosec G204 - Fixed go build invocation
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", binary, pkg)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// runTool runs a code generator and logs, but otherwise ignores, failures so
+// that a missing tool does not stop development.
+func runTool(ctx context.Context, name string, command []string) {
+	if len(command) == 0 {
+		return
+	}
+
+	// #nosec G204 - Commands come from the dev server configuration
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if err != nil {
+		log.Printf("⚠️  %s", fmt.Errorf("%s failed: %w", name, err))
+	}
+}
@@ -0,0 +1,167 @@
+package devserver
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// reloadPath is the server-sent events stream the injected script listens to.
+const reloadPath = "/_dev/reload"
+
+// reloadScript reloads the page when the dev server sends an event.
+const reloadScript = `<script>new EventSource("` + reloadPath + `").onmessage = function () { location.reload(); };</script>`
+
+// unavailablePage is served while the website is being rebuilt or broken; it
+// reloads itself once the website is back.
+const unavailablePage = `<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><title>Rebuilding...</title></head>
+<body><h1>Rebuilding...</h1><p>The page reloads when the server is back. See the terminal for build errors.</p></body>
+</html>`
+
+// broker fans reload events out to the connected browsers.
+type broker struct {
+	clients map[chan struct{}]struct{}
+	done    chan struct{}
+	mu      sync.Mutex
+}
+
+func newBroker() *broker {
+	return &broker{clients: map[chan struct{}]struct{}{}, done: make(chan struct{})}
+}
+
+// broadcast asks every connected browser to reload.
+func (b *broker) broadcast() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for client := range b.clients {
+		select {
+		case client <- struct{}{}:
+		default:
+			// A reload is already pending for this client.
+		}
+	}
+}
+
+// close ends every event stream so that the HTTP server can shut down.
+func (b *broker) close() {
+	close(b.done)
+}
+
+func (b *broker) subscribe() chan struct{} {
+	client := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	b.clients[client] = struct{}{}
+	b.mu.Unlock()
+
+	return client
+}
+
+func (b *broker) unsubscribe(client chan struct{}) {
+	b.mu.Lock()
+	delete(b.clients, client)
+	b.mu.Unlock()
+}
+
+// ServeHTTP streams a "reload" event for every broadcast.
+func (b *broker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	client := b.subscribe()
+	defer b.unsubscribe(client)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-b.done:
+			return
+		case <-client:
+			fmt.Fprint(w, "data: reload\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// newProxy forwards requests to the website at upstream and injects the
+// reload script into HTML responses.
+func newProxy(upstream *url.URL) *httputil.ReverseProxy {
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(upstream)
+			r.SetXForwarded()
+			r.Out.Host = r.In.Host
+			// Uncompressed responses can be rewritten.
+			r.Out.Header.Del("Accept-Encoding")
+		},
+		ModifyResponse: injectReloadScript,
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			log.Printf("Proxy error: %v", err)
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = io.WriteString(w, injectScript(unavailablePage))
+		},
+	}
+}
+
+// injectReloadScript adds the reload script to HTML responses.
+func injectReloadScript(resp *http.Response) error {
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") || resp.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified || resp.Request.Method == http.MethodHead {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	err = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	page := injectScript(string(body))
+
+	resp.Body = io.NopCloser(bytes.NewReader([]byte(page)))
+	resp.ContentLength = int64(len(page))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(page)))
+	// The validators describe the page without the script.
+	resp.Header.Del("ETag")
+	resp.Header.Del("Last-Modified")
+
+	return nil
+}
+
+// injectScript inserts the reload script before the closing body tag, or
+// appends it to documents without one.
+func injectScript(page string) string {
+	i := strings.LastIndex(strings.ToLower(page), "</body>")
+	if i < 0 {
+		return page + reloadScript
+	}
+
+	return page[:i] + reloadScript + page[i:]
+}
@@ -0,0 +1,123 @@
+package devserver
+
+import (
+	"context"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// change is a set of kinds of changed files.
+type change int
+
+// Kinds of changes, each implying different work before the browser reloads.
+const (
+	// changeTemplates regenerates templates and styles and rebuilds.
+	changeTemplates change = 1 << iota
+	// changeStyles rebuilds the stylesheet.
+	changeStyles
+	// changeStatic needs a reload only; static files are served from disk.
+	changeStatic
+	// changeCode rebuilds, e.g. after editing the seed content.
+	changeCode
+)
+
+// classify returns the kind of change to file, or zero for files to ignore.
+func (s *Server) classify(file string) change {
+	file = filepath.Clean(file)
+	base := filepath.Base(file)
+
+	switch {
+	case strings.HasPrefix(base, "."), strings.HasSuffix(base, "~"), strings.HasSuffix(base, "_templ.go"):
+		// Editor swap files and generated template code.
+		return 0
+	case file == filepath.Clean(s.cfg.TailwindOutput):
+		// Generated by the dev server itself after every style change.
+		return 0
+	case file == filepath.Clean(s.cfg.TailwindInput):
+		return changeStyles
+	case filepath.Ext(file) == ".templ":
+		return changeTemplates
+	case filepath.Ext(file) == ".go":
+		return changeCode
+	case strings.HasPrefix(file, "static"+string(filepath.Separator)):
+		return changeStatic
+	default:
+		return 0
+	}
+}
+
+// newWatcher watches every directory below the configured roots.
+func (s *Server) newWatcher() (*fsnotify.Watcher, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, root := range s.cfg.Watch {
+		err = addTree(w, root)
+		if err != nil {
+			_ = w.Close()
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// addTree watches dir and its subdirectories; fsnotify is not recursive.
+func addTree(w *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return err
+		}
+
+		return w.Add(path)
+	})
+}
+
+// watch collects file events until they settle for debounceDelay and then
+// applies them together, until ctx is canceled.
+func (s *Server) watch(ctx context.Context, w *fsnotify.Watcher) error {
+	var pending change
+
+	timer := time.NewTimer(debounceDelay)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+
+			log.Printf("Watcher error: %v", err)
+		case event, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Has(fsnotify.Create) {
+				// New directories are watched too; errors mean it was a file.
+				_ = addTree(w, event.Name)
+			}
+
+			if c := s.classify(event.Name); c != 0 {
+				pending |= c
+
+				timer.Reset(debounceDelay)
+			}
+		case <-timer.C:
+			log.Printf("🔄 Change detected, reloading...")
+			s.apply(ctx, pending)
+
+			pending = 0
+		}
+	}
+}
@@ -6,6 +6,7 @@ package domain
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 )
@@ -34,6 +35,16 @@ const (
 	StatusArchived ContactStatus = "archived"
 )
 
+// AllContactStatuses returns every contact status in workflow order.
+func AllContactStatuses() []ContactStatus {
+	return []ContactStatus{StatusNew, StatusRead, StatusReplied, StatusArchived}
+}
+
+// IsValid checks if the contact status is valid.
+func (s ContactStatus) IsValid() bool {
+	return slices.Contains(AllContactStatuses(), s)
+}
+
 // NewContact creates a new contact with validation.
 func NewContact(name, company, email, message, subject string) (*Contact, error) {
 	if err := validateName(name); err != nil {
@@ -13,6 +13,7 @@ type DomainError struct {
 	Type    ErrorType `json:"type"`
 	Message string    `json:"message"`
 	Code    string    `json:"code,omitempty"`
+	Field   string    `json:"field,omitempty"`
 }
 
 // ErrorType represents the type of domain error.
@@ -44,12 +45,26 @@ var (
 	ErrTechnologyNameEmpty    = errors.New("technology name cannot be empty")
 	ErrTechnologyIDEmpty      = errors.New("technology ID cannot be empty")
 	ErrCategoryEmpty          = errors.New("category cannot be empty")
+	ErrTokenNameEmpty         = errors.New("token name cannot be empty")
+	ErrTokenHashEmpty         = errors.New("token hash cannot be empty")
+	ErrTokenScopesEmpty       = errors.New("token must have at least one scope")
+	ErrInvalidScope           = errors.New("invalid token scope")
 
 	// Not found errors.
 	ErrContactNotFound    = errors.New("contact not found")
 	ErrTechnologyNotFound = errors.New("technology not found")
 	ErrExperienceNotFound = errors.New("experience not found")
 	ErrServiceNotFound    = errors.New("service not found")
+	ErrTokenNotFound      = errors.New("token not found")
+	ErrSessionNotFound    = errors.New("session not found")
+
+	// Authentication errors.
+	ErrTokenMissing      = errors.New("missing bearer token")
+	ErrTokenInvalid      = errors.New("invalid token")
+	ErrTokenExpired      = errors.New("token has expired")
+	ErrTokenRevoked      = errors.New("token has been revoked")
+	ErrInsufficientScope = errors.New("token lacks required scope")
+	ErrSessionInvalid    = errors.New("invalid or expired session")
 
 	// Conflict errors.
 	ErrTechnologyExists = errors.New("technology already exists")
@@ -59,6 +74,8 @@ var (
 	ErrContactNil     = errors.New("contact cannot be nil")
 	ErrIDEmpty        = errors.New("id cannot be empty")
 	ErrInvalidContact = errors.New("invalid contact")
+	ErrTokenNil       = errors.New("token cannot be nil")
+	ErrSessionNil     = errors.New("session cannot be nil")
 
 	// Service errors.
 	ErrCreateTechnology = errors.New("failed to create technology")
@@ -68,9 +85,14 @@ var (
 	ErrSaveContact      = errors.New("failed to save contact")
 	ErrFindContact      = errors.New("failed to find contact")
 	ErrListContacts     = errors.New("failed to list contacts")
+	ErrPurgeContacts    = errors.New("failed to purge contacts")
 	ErrValidationFailed = errors.New("validation failed")
 	ErrTechByCategory   = errors.New("failed to get technologies by category")
 	ErrTechByLevel      = errors.New("failed to get technologies by level")
+	ErrIssueToken       = errors.New("failed to issue token")
+	ErrRevokeToken      = errors.New("failed to revoke token")
+	ErrListTokens       = errors.New("failed to list tokens")
+	ErrStartSession     = errors.New("failed to start session")
 
 	// Handler errors.
 	ErrLoadExperiences  = errors.New("failed to load experiences")
@@ -87,9 +109,28 @@ func ErrInvalidInput(message string) error {
 	}
 }
 
+// ErrInvalidField creates a validation error for a specific request field.
+func ErrInvalidField(field, message string) error {
+	return &DomainError{
+		Type:    ErrorTypeValidation,
+		Message: message,
+		Field:   field,
+	}
+}
+
+// AsDomainError extracts a DomainError from err, following wrapped errors.
+func AsDomainError(err error) (*DomainError, bool) {
+	var domErr *DomainError
+	if errors.As(err, &domErr) {
+		return domErr, true
+	}
+
+	return nil, false
+}
+
 // IsValidationError checks if an error is a validation error.
 func IsValidationError(err error) bool {
-	if domErr, ok := err.(*DomainError); ok {
+	if domErr, ok := AsDomainError(err); ok {
 		return domErr.Type == ErrorTypeValidation
 	}
 
@@ -98,7 +139,7 @@ func IsValidationError(err error) bool {
 
 // IsNotFoundError checks if an error is a not found error.
 func IsNotFoundError(err error) bool {
-	if domErr, ok := err.(*DomainError); ok {
+	if domErr, ok := AsDomainError(err); ok {
 		return domErr.Type == ErrorTypeNotFound
 	}
 
@@ -107,7 +148,7 @@ func IsNotFoundError(err error) bool {
 
 // IsConflictError checks if an error is a conflict error.
 func IsConflictError(err error) bool {
-	if domErr, ok := err.(*DomainError); ok {
+	if domErr, ok := AsDomainError(err); ok {
 		return domErr.Type == ErrorTypeConflict
 	}
 
@@ -140,7 +181,7 @@ func ErrInternal(message string) error {
 
 // IsInternalError checks if the error is an internal error.
 func IsInternalError(err error) bool {
-	if domainErr, ok := err.(*DomainError); ok {
+	if domainErr, ok := AsDomainError(err); ok {
 		return domainErr.Type == ErrorTypeInternal
 	}
 
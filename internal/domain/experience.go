@@ -245,3 +245,17 @@ func (e *Experience) SetEndDate(endDate time.Time) error {
 
 	return nil
 }
+
+// RemoveAchievement removes an achievement by ID and reports whether it was present.
+func (e *Experience) RemoveAchievement(id string) bool {
+	for i, achievement := range e.Achievements {
+		if achievement.ID == id {
+			e.Achievements = append(e.Achievements[:i], e.Achievements[i+1:]...)
+			e.UpdatedAt = time.Now()
+
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,7 +3,10 @@
 // and external system interactions following clean architecture principles.
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ContactRepository defines the interface for contact persistence.
 type ContactRepository interface {
@@ -24,6 +27,49 @@ type ContactRepository interface {
 
 	// Count returns the total number of contacts
 	Count(ctx context.Context, status ContactStatus) (int, error)
+
+	// CountBefore returns the number of contacts submitted before the given time
+	CountBefore(ctx context.Context, before time.Time) (int, error)
+
+	// DeleteBefore removes contacts submitted before the given time and
+	// returns how many were removed (for data retention)
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// TokenRepository defines the interface for API token persistence.
+type TokenRepository interface {
+	// Save stores a newly issued token
+	Save(ctx context.Context, token *APIToken) error
+
+	// FindByID retrieves a token by ID
+	FindByID(ctx context.Context, id string) (*APIToken, error)
+
+	// FindByHash retrieves a token by the hash of its secret
+	FindByHash(ctx context.Context, hash string) (*APIToken, error)
+
+	// FindAll retrieves all tokens, including revoked and expired ones
+	FindAll(ctx context.Context) ([]*APIToken, error)
+
+	// Revoke marks a token as revoked
+	Revoke(ctx context.Context, id string, at time.Time) error
+
+	// TouchLastUsed records when a token was last used
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
+
+// SessionRepository defines the interface for admin session persistence.
+type SessionRepository interface {
+	// Save stores a new session
+	Save(ctx context.Context, session *AdminSession) error
+
+	// FindByHash retrieves a session by the hash of its ID
+	FindByHash(ctx context.Context, hash string) (*AdminSession, error)
+
+	// Delete removes a session by the hash of its ID
+	Delete(ctx context.Context, hash string) error
+
+	// DeleteExpired removes the sessions that expired at or before now and returns how many were removed
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
 }
 
 // EmailService defines the interface for sending emails.
@@ -35,6 +81,14 @@ type EmailService interface {
 	SendConfirmationEmail(ctx context.Context, contact *Contact) error
 }
 
+// ContactMailer is implemented by an EmailService that sends in the background.
+type ContactMailer interface {
+	// SendContactEmails queues the notification and the confirmation about
+	// contact. Unless it returns an error, done is called once both were
+	// attempted, with the errors of sending them.
+	SendContactEmails(ctx context.Context, contact *Contact, done func(error)) error
+}
+
 // LoggingService defines the interface for structured logging.
 type LoggingService interface {
 	// Info logs an info message with structured data
@@ -46,3 +100,24 @@ type LoggingService interface {
 	// Warn logs a warning message with structured data
 	Warn(ctx context.Context, message string, fields map[string]interface{})
 }
+
+// SubmissionOutcome is how a contact form submission ended.
+type SubmissionOutcome string
+
+// Contact form submission outcomes.
+const (
+	SubmissionSaved            SubmissionOutcome = "saved"
+	SubmissionValidationFailed SubmissionOutcome = "validation_failure"
+	SubmissionSaveFailed       SubmissionOutcome = "save_failure"
+	SubmissionEmailFailed      SubmissionOutcome = "email_failure"
+	SubmissionSpam             SubmissionOutcome = "spam"
+)
+
+// MetricsService defines the interface for recording business metrics.
+type MetricsService interface {
+	// RecordContactSubmission counts a contact form submission by outcome
+	RecordContactSubmission(outcome SubmissionOutcome)
+
+	// RecordEmail records how long sending an email of the given kind took and whether it failed
+	RecordEmail(kind string, duration time.Duration, err error)
+}
@@ -88,6 +88,22 @@ func NewDeliverable(name, description, timeline string) *Deliverable {
 	}
 }
 
+// AllServiceTypes returns every service category.
+func AllServiceTypes() []ServiceType {
+	return []ServiceType{
+		ServiceTypeConsulting, ServiceTypeDevelopment, ServiceTypeArchitecture,
+		ServiceTypeAuditing, ServiceTypeTraining, ServiceTypeMentoring,
+	}
+}
+
+// AllPricingTypes returns every pricing model.
+func AllPricingTypes() []PricingType {
+	return []PricingType{
+		PricingTypeHourly, PricingTypeDaily, PricingTypeProject,
+		PricingTypeRetainer, PricingTypeCustom,
+	}
+}
+
 // IsValid checks if the service type is valid.
 func (st ServiceType) IsValid() bool {
 	switch st {
@@ -149,6 +165,20 @@ func (s *Service) AddDeliverable(deliverable Deliverable) {
 	s.UpdatedAt = time.Now()
 }
 
+// RemoveDeliverable removes a deliverable by ID and reports whether it was present.
+func (s *Service) RemoveDeliverable(id string) bool {
+	for i, deliverable := range s.Deliverables {
+		if deliverable.ID == id {
+			s.Deliverables = append(s.Deliverables[:i], s.Deliverables[i+1:]...)
+			s.UpdatedAt = time.Now()
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // SetPricing sets the pricing information for the service.
 func (s *Service) SetPricing(pricing PricingInfo) error {
 	if !pricing.Type.IsValid() {
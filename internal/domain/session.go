@@ -0,0 +1,20 @@
+// Package domain provides core business domain entities and value objects for the portfolio website.
+// It defines the admin session entity that keeps an editor signed in to the content editor
+// without handing the API token itself to the browser.
+package domain
+
+import "time"
+
+// AdminSession maps a random session ID held in the editor's cookie to the API
+// token they signed in with. Only a hash of the session ID is kept.
+type AdminSession struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	IDHash    string    `json:"-"`
+	TokenID   string    `json:"token_id"`
+}
+
+// IsExpired checks if the session has passed its expiry time.
+func (s *AdminSession) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -29,6 +29,11 @@ const (
 	LevelExpert       Level = "expert"
 )
 
+// AllLevels returns every proficiency level in ascending order.
+func AllLevels() []Level {
+	return []Level{LevelBeginner, LevelIntermediate, LevelAdvanced, LevelExpert}
+}
+
 // IsValid checks if the level is valid.
 func (l Level) IsValid() bool {
 	switch l {
@@ -0,0 +1,121 @@
+// Package domain provides core business domain entities and value objects for the portfolio website.
+// It defines the API token entity used for scripted machine access, including scopes,
+// expiry and revocation rules.
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Scope represents a permission granted to an API token.
+type Scope string
+
+const (
+	ScopeContentWrite Scope = "content:write"
+	ScopeContactsRead Scope = "contacts:read"
+	ScopeMetricsRead  Scope = "metrics:read"
+)
+
+// AllScopes returns every scope a token can be granted.
+func AllScopes() []Scope {
+	return []Scope{ScopeContentWrite, ScopeContactsRead, ScopeMetricsRead}
+}
+
+// IsValid checks if the scope is known.
+func (s Scope) IsValid() bool {
+	for _, scope := range AllScopes() {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseScopes converts raw scope names into validated scopes, dropping duplicates.
+func ParseScopes(raw []string) ([]Scope, error) {
+	scopes := make([]Scope, 0, len(raw))
+	seen := make(map[Scope]bool, len(raw))
+
+	for _, name := range raw {
+		scope := Scope(strings.TrimSpace(name))
+		if scope == "" {
+			continue
+		}
+
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+
+	if len(scopes) == 0 {
+		return nil, ErrTokenScopesEmpty
+	}
+
+	return scopes, nil
+}
+
+// APIToken represents a personal access token used to call the API from scripts and CI.
+// Only a hash of the secret is kept; the plaintext value is shown once when issued.
+type APIToken struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	TokenHash  string     `json:"-"`
+	Scopes     []Scope    `json:"scopes"`
+}
+
+// HasScope checks if the token grants the given scope.
+func (t *APIToken) HasScope(scope Scope) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExpired checks if the token has passed its expiry time.
+func (t *APIToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// IsRevoked checks if the token has been revoked.
+func (t *APIToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// Validate performs domain validation.
+func (t *APIToken) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return ErrTokenNameEmpty
+	}
+
+	if t.TokenHash == "" {
+		return ErrTokenHashEmpty
+	}
+
+	if len(t.Scopes) == 0 {
+		return ErrTokenScopesEmpty
+	}
+
+	for _, scope := range t.Scopes {
+		if !scope.IsValid() {
+			return fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+	}
+
+	return nil
+}
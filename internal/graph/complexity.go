@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/service"
+)
+
+// nestedListSize is the assumed length of lists below the root, such as an
+// experience's technologies, which take no limit argument.
+const nestedListSize = 10
+
+// complexity estimates the cost of one operation before it is executed.
+// Every field costs one; the fields below a list are multiplied by its limit
+// argument (or default page size) at the root and by nestedListSize elsewhere.
+type complexity struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// checkComplexity rejects operations that are too deep or too expensive.
+func checkComplexity(schema *graphql.Schema, doc *ast.Document, operation *ast.OperationDefinition, variables map[string]interface{}) error {
+	cost, err := operationCost(schema, doc, operation, variables)
+	if err != nil {
+		return err
+	}
+
+	if cost > constants.GraphQLMaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the limit of %d", cost, constants.GraphQLMaxComplexity)
+	}
+
+	return nil
+}
+
+// operationCost estimates the cost of operation, failing when it is too deep.
+func operationCost(schema *graphql.Schema, doc *ast.Document, operation *ast.OperationDefinition, variables map[string]interface{}) (int, error) {
+	c := complexity{schema: schema, fragments: map[string]*ast.FragmentDefinition{}, variables: variables}
+
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			c.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	return c.selectionCost(operation.SelectionSet, schema.QueryType(), 1, true)
+}
+
+func (c *complexity) selectionCost(set *ast.SelectionSet, parent *graphql.Object, depth int, root bool) (int, error) {
+	if set == nil || parent == nil {
+		return 0, nil
+	}
+
+	if depth > constants.GraphQLMaxDepth {
+		return 0, fmt.Errorf("query depth exceeds the limit of %d", constants.GraphQLMaxDepth)
+	}
+
+	total := 0
+
+	for _, selection := range set.Selections {
+		var (
+			cost int
+			err  error
+		)
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			cost, err = c.fieldCost(selection, parent, depth, root)
+		case *ast.InlineFragment:
+			cost, err = c.selectionCost(selection.SelectionSet, c.narrow(parent, selection.TypeCondition), depth, root)
+		case *ast.FragmentSpread:
+			if fragment := c.fragments[selection.Name.Value]; fragment != nil {
+				cost, err = c.selectionCost(fragment.SelectionSet, c.narrow(parent, fragment.TypeCondition), depth, root)
+			}
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		total += cost
+	}
+
+	return total, nil
+}
+
+func (c *complexity) fieldCost(field *ast.Field, parent *graphql.Object, depth int, root bool) (int, error) {
+	def, ok := parent.Fields()[field.Name.Value]
+	if !ok {
+		// Introspection and __typename are bounded by the schema itself.
+		return 1, nil
+	}
+
+	typ, isList := unwrap(def.Type)
+
+	object, ok := typ.(*graphql.Object)
+	if !ok {
+		return 1, nil
+	}
+
+	children, err := c.selectionCost(field.SelectionSet, object, depth+1, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if !isList {
+		return 1 + children, nil
+	}
+
+	size := nestedListSize
+	if root {
+		size = c.limit(field)
+	}
+
+	return 1 + size*children, nil
+}
+
+// limit reads the limit argument of a root list field, clamped the way the
+// services page their results: a negative limit must not make a field cheap.
+func (c *complexity) limit(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+
+		switch value := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil {
+				return service.NewPagination(n, 0).Limit
+			}
+		case *ast.Variable:
+			if n, ok := c.variables[value.Name.Value].(float64); ok {
+				return service.NewPagination(int(n), 0).Limit
+			}
+		}
+	}
+
+	return constants.DefaultPageSize
+}
+
+// narrow resolves a fragment's type condition, keeping parent when absent.
+func (c *complexity) narrow(parent *graphql.Object, condition *ast.Named) *graphql.Object {
+	if condition == nil {
+		return parent
+	}
+
+	if object, ok := c.schema.Type(condition.Name.Value).(*graphql.Object); ok {
+		return object
+	}
+
+	return parent
+}
+
+// unwrap strips non-null and list wrappers, reporting whether a list was seen.
+func unwrap(typ graphql.Type) (graphql.Type, bool) {
+	isList := false
+
+	for {
+		switch t := typ.(type) {
+		case *graphql.NonNull:
+			typ = t.OfType
+		case *graphql.List:
+			isList = true
+			typ = t.OfType
+		default:
+			return typ, isList
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+)
+
+// technologyLoader collects the technology IDs requested while one query level
+// resolves and fetches them with a single repository call once the first
+// result is needed. Results are cached for the rest of the request.
+//
+// The technology repository has no multi-ID lookup, so a batch is served by one
+// unfiltered List rather than a GetByID per experience or service.
+type technologyLoader struct {
+	repo    repository.TechnologyRepository
+	loaded  map[string]*domain.Technology
+	pending map[string]struct{}
+	err     error
+	mu      sync.Mutex
+}
+
+func newTechnologyLoader(repo repository.TechnologyRepository) *technologyLoader {
+	return &technologyLoader{
+		repo:    repo,
+		loaded:  make(map[string]*domain.Technology),
+		pending: make(map[string]struct{}),
+	}
+}
+
+// loadMany queues ids and returns a thunk resolving to the current technologies.
+// Technologies removed from the repository fall back to the embedded snapshot.
+func (l *technologyLoader) loadMany(ctx context.Context, snapshot []domain.Technology) func() (interface{}, error) {
+	l.mu.Lock()
+	for _, tech := range snapshot {
+		if _, ok := l.loaded[tech.ID]; !ok {
+			l.pending[tech.ID] = struct{}{}
+		}
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		if err := l.dispatch(ctx); err != nil {
+			return nil, err
+		}
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		result := make([]*domain.Technology, len(snapshot))
+		for i := range snapshot {
+			if tech := l.loaded[snapshot[i].ID]; tech != nil {
+				result[i] = tech
+			} else {
+				result[i] = &snapshot[i]
+			}
+		}
+
+		return result, nil
+	}
+}
+
+// dispatch loads every pending ID in one repository call.
+func (l *technologyLoader) dispatch(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.err != nil || len(l.pending) == 0 {
+		return l.err
+	}
+
+	technologies, err := l.repo.List(ctx, repository.TechnologyFilter{})
+	if err != nil {
+		l.err = err
+		return err
+	}
+
+	byID := make(map[string]*domain.Technology, len(technologies))
+	for _, tech := range technologies {
+		byID[tech.ID] = tech
+	}
+
+	for id := range l.pending {
+		l.loaded[id] = byID[id]
+	}
+
+	clear(l.pending)
+
+	return nil
+}
+
+type loaderKey struct{}
+
+// withLoaders attaches fresh per-request loaders to ctx.
+func withLoaders(ctx context.Context, repo repository.TechnologyRepository) context.Context {
+	return context.WithValue(ctx, loaderKey{}, newTechnologyLoader(repo))
+}
+
+// technologiesFrom returns the loader attached by withLoaders.
+func technologiesFrom(ctx context.Context) *technologyLoader {
+	loader, _ := ctx.Value(loaderKey{}).(*technologyLoader)
+	return loader
+}
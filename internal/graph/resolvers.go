@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"github.com/graphql-go/graphql"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+)
+
+// listTechnologies resolves Query.technologies.
+func (r *resolver) listTechnologies(p graphql.ResolveParams) (interface{}, error) {
+	filter := service.TechnologyFilter{
+		Category: optional[string](p.Args, "category"),
+		Level:    optional[domain.Level](p.Args, "level"),
+		Limit:    optional[int](p.Args, "limit"),
+		Offset:   optional[int](p.Args, "offset"),
+		OrderBy:  optional[string](p.Args, "orderBy"),
+		OrderDir: optional[string](p.Args, "orderDir"),
+	}
+
+	page, err := r.technologies.QueryTechnologies(p.Context, filter)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return page.Items, nil
+}
+
+// getTechnology resolves Query.technology, returning null when it does not exist.
+func (r *resolver) getTechnology(p graphql.ResolveParams) (interface{}, error) {
+	tech, err := r.technologies.GetTechnology(p.Context, p.Args["id"].(string))
+	if domain.IsNotFoundError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return tech, nil
+}
+
+// listExperiences resolves Query.experiences.
+func (r *resolver) listExperiences(p graphql.ResolveParams) (interface{}, error) {
+	filter := service.ExperienceFilter{
+		CompanyName: optional[string](p.Args, "companyName"),
+		Position:    optional[string](p.Args, "position"),
+		Location:    optional[string](p.Args, "location"),
+		Technology:  optional[string](p.Args, "technology"),
+		IsCurrent:   optional[bool](p.Args, "isCurrent"),
+		IsRemote:    optional[bool](p.Args, "isRemote"),
+		Limit:       optional[int](p.Args, "limit"),
+		Offset:      optional[int](p.Args, "offset"),
+		OrderBy:     optional[string](p.Args, "orderBy"),
+		OrderDir:    optional[string](p.Args, "orderDir"),
+	}
+
+	var err error
+	if filter.StartAfter, err = optionalDate(p.Args, "startAfter"); err != nil {
+		return nil, err
+	}
+
+	if filter.EndBefore, err = optionalDate(p.Args, "endBefore"); err != nil {
+		return nil, err
+	}
+
+	page, err := r.experiences.QueryExperiences(p.Context, filter)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return page.Items, nil
+}
+
+// getExperience resolves Query.experience, returning null when it does not exist.
+func (r *resolver) getExperience(p graphql.ResolveParams) (interface{}, error) {
+	exp, err := r.experiences.GetExperience(p.Context, p.Args["id"].(string))
+	if domain.IsNotFoundError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return exp, nil
+}
+
+// listServices resolves Query.services.
+func (r *resolver) listServices(p graphql.ResolveParams) (interface{}, error) {
+	filter := service.ServiceFilter{
+		Category:    optional[domain.ServiceType](p.Args, "category"),
+		IsActive:    optional[bool](p.Args, "isActive"),
+		Technology:  optional[string](p.Args, "technology"),
+		PricingType: optional[domain.PricingType](p.Args, "pricingType"),
+		MinPrice:    optional[float64](p.Args, "minPrice"),
+		MaxPrice:    optional[float64](p.Args, "maxPrice"),
+		Limit:       optional[int](p.Args, "limit"),
+		Offset:      optional[int](p.Args, "offset"),
+		OrderBy:     optional[string](p.Args, "orderBy"),
+		OrderDir:    optional[string](p.Args, "orderDir"),
+	}
+
+	page, err := r.portfolio.QueryServices(p.Context, filter)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return page.Items, nil
+}
+
+// getService resolves Query.service, returning null when it does not exist.
+func (r *resolver) getService(p graphql.ResolveParams) (interface{}, error) {
+	svc, err := r.portfolio.GetService(p.Context, p.Args["id"].(string))
+	if domain.IsNotFoundError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return svc, nil
+}
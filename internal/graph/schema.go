@@ -0,0 +1,384 @@
+package graph
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+)
+
+// errInternal is reported in place of failures that are not the caller's fault.
+var errInternal = errors.New("internal server error")
+
+// resolver builds the schema on top of the portfolio services.
+type resolver struct {
+	technologies *service.TechnologyService
+	experiences  *service.ExperienceService
+	portfolio    *service.PortfolioService
+}
+
+// prop resolves a field by reading it from the source object.
+func prop[T any](typ graphql.Output, get func(T) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			source, ok := p.Source.(T)
+			if !ok {
+				return nil, nil
+			}
+
+			return get(source), nil
+		},
+	}
+}
+
+// nonNull wraps typ as non-nullable.
+func nonNull(typ graphql.Type) graphql.Output {
+	return graphql.NewNonNull(typ)
+}
+
+// listOf is a non-null list of non-null typ.
+func listOf(typ graphql.Type) graphql.Output {
+	return graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(typ)))
+}
+
+// enumOf maps each domain value to an upper-case GraphQL enum value.
+func enumOf[T ~string](name, description string, values []T) *graphql.Enum {
+	config := graphql.EnumValueConfigMap{}
+	for _, v := range values {
+		config[upperSnake(string(v))] = &graphql.EnumValueConfig{Value: v}
+	}
+
+	return graphql.NewEnum(graphql.EnumConfig{Name: name, Description: description, Values: config})
+}
+
+func upperSnake(value string) string {
+	out := []byte(value)
+	for i, b := range out {
+		switch {
+		case b >= 'a' && b <= 'z':
+			out[i] = b - 'a' + 'A'
+		case b == '-' || b == ' ':
+			out[i] = '_'
+		}
+	}
+
+	return string(out)
+}
+
+// timeOrNil dereferences optional timestamps for the DateTime scalar.
+func timeOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return *t
+}
+
+// pointers converts embedded value slices into the pointer form resolvers expect.
+func pointers[T any](values []T) []*T {
+	result := make([]*T, len(values))
+	for i := range values {
+		result[i] = &values[i]
+	}
+
+	return result
+}
+
+// resolveError hides internal failures while passing validation messages through.
+func resolveError(err error) error {
+	if domain.IsValidationError(err) || domain.IsNotFoundError(err) {
+		return err
+	}
+
+	slog.Error("GraphQL resolver failed", "error", err)
+
+	return errInternal
+}
+
+// optional reads an optional argument of type T.
+func optional[T any](args map[string]interface{}, name string) *T {
+	value, ok := args[name].(T)
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
+
+// optionalDate reads an optional YYYY-MM-DD argument.
+func optionalDate(args map[string]interface{}, name string) (*time.Time, error) {
+	raw := optional[string](args, name)
+	if raw == nil {
+		return nil, nil
+	}
+
+	value, err := time.Parse(time.DateOnly, *raw)
+	if err != nil {
+		return nil, domain.ErrInvalidField(name, name+" must be a date in YYYY-MM-DD format")
+	}
+
+	return &value, nil
+}
+
+// pageArgs are shared by every list field.
+func pageArgs(args graphql.FieldConfigArgument, orderBy *graphql.Enum) graphql.FieldConfigArgument {
+	args["orderBy"] = &graphql.ArgumentConfig{Type: orderBy}
+	args["orderDir"] = &graphql.ArgumentConfig{Type: orderDirEnum}
+	args["limit"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: constants.DefaultPageSize}
+	args["offset"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0}
+
+	return args
+}
+
+var orderDirEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "OrderDirection",
+	Values: graphql.EnumValueConfigMap{
+		"ASC":  &graphql.EnumValueConfig{Value: "asc"},
+		"DESC": &graphql.EnumValueConfig{Value: "desc"},
+	},
+})
+
+// orderEnum lists the fields an entity type can be ordered by.
+func orderEnum(name, entityType string) *graphql.Enum {
+	return enumOf(name, "", service.OrderFields(entityType))
+}
+
+// newSchema builds the read-only schema mirroring the domain model.
+func newSchema(r *resolver) (graphql.Schema, error) {
+	levelEnum := enumOf("Level", "Proficiency level in a technology.", domain.AllLevels())
+	serviceTypeEnum := enumOf("ServiceType", "Kind of service offering.", domain.AllServiceTypes())
+	pricingTypeEnum := enumOf("PricingType", "How a service is priced.", domain.AllPricingTypes())
+
+	technologyType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Technology",
+		Fields: graphql.Fields{
+			"id":          prop(nonNull(graphql.ID), func(t *domain.Technology) interface{} { return t.ID }),
+			"name":        prop(nonNull(graphql.String), func(t *domain.Technology) interface{} { return t.Name }),
+			"category":    prop(nonNull(graphql.String), func(t *domain.Technology) interface{} { return t.Category }),
+			"level":       prop(nonNull(levelEnum), func(t *domain.Technology) interface{} { return t.Level }),
+			"description": prop(graphql.String, func(t *domain.Technology) interface{} { return t.Description }),
+			"iconUrl":     prop(graphql.String, func(t *domain.Technology) interface{} { return t.IconURL }),
+			"createdAt":   prop(nonNull(graphql.DateTime), func(t *domain.Technology) interface{} { return t.CreatedAt }),
+			"updatedAt":   prop(nonNull(graphql.DateTime), func(t *domain.Technology) interface{} { return t.UpdatedAt }),
+		},
+	})
+
+	// currentTechnologies resolves embedded technology references against the
+	// repository through the request's batching loader.
+	currentTechnologies := func(snapshot func(p graphql.ResolveParams) []domain.Technology) *graphql.Field {
+		return &graphql.Field{
+			Type: listOf(technologyType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				technologies := snapshot(p)
+				if loader := technologiesFrom(p.Context); loader != nil {
+					thunk := loader.loadMany(p.Context, technologies)
+
+					return func() (interface{}, error) {
+						result, err := thunk()
+						if err != nil {
+							return nil, resolveError(err)
+						}
+
+						return result, nil
+					}, nil
+				}
+
+				return pointers(technologies), nil
+			},
+		}
+	}
+
+	metricsType := newMetricsType()
+
+	achievementType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Achievement",
+		Fields: graphql.Fields{
+			"id":          prop(nonNull(graphql.ID), func(a *domain.Achievement) interface{} { return a.ID }),
+			"title":       prop(nonNull(graphql.String), func(a *domain.Achievement) interface{} { return a.Title }),
+			"description": prop(graphql.String, func(a *domain.Achievement) interface{} { return a.Description }),
+			"impact":      prop(graphql.String, func(a *domain.Achievement) interface{} { return a.Impact }),
+			"metrics":     prop(metricsType, func(a *domain.Achievement) interface{} { return a.Metrics }),
+			"createdAt":   prop(nonNull(graphql.DateTime), func(a *domain.Achievement) interface{} { return a.CreatedAt }),
+		},
+	})
+
+	experienceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Experience",
+		Fields: graphql.Fields{
+			"id":          prop(nonNull(graphql.ID), func(e *domain.Experience) interface{} { return e.ID }),
+			"companyName": prop(nonNull(graphql.String), func(e *domain.Experience) interface{} { return e.CompanyName }),
+			"position":    prop(nonNull(graphql.String), func(e *domain.Experience) interface{} { return e.Position }),
+			"description": prop(graphql.String, func(e *domain.Experience) interface{} { return e.Description }),
+			"location":    prop(graphql.String, func(e *domain.Experience) interface{} { return e.Location }),
+			"startDate":   prop(nonNull(graphql.DateTime), func(e *domain.Experience) interface{} { return e.StartDate }),
+			"endDate":     prop(graphql.DateTime, func(e *domain.Experience) interface{} { return timeOrNil(e.EndDate) }),
+			"isRemote":    prop(nonNull(graphql.Boolean), func(e *domain.Experience) interface{} { return e.IsRemote }),
+			"isCurrent":   prop(nonNull(graphql.Boolean), func(e *domain.Experience) interface{} { return e.IsCurrent() }),
+			"technologies": currentTechnologies(func(p graphql.ResolveParams) []domain.Technology {
+				return p.Source.(*domain.Experience).Technologies
+			}),
+			"achievements": prop(listOf(achievementType), func(e *domain.Experience) interface{} { return pointers(e.Achievements) }),
+			"createdAt":    prop(nonNull(graphql.DateTime), func(e *domain.Experience) interface{} { return e.CreatedAt }),
+			"updatedAt":    prop(nonNull(graphql.DateTime), func(e *domain.Experience) interface{} { return e.UpdatedAt }),
+		},
+	})
+
+	pricingType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PricingInfo",
+		Fields: graphql.Fields{
+			"type":        prop(nonNull(pricingTypeEnum), func(p *domain.PricingInfo) interface{} { return p.Type }),
+			"amount":      prop(graphql.Float, func(p *domain.PricingInfo) interface{} { return p.Amount }),
+			"currency":    prop(graphql.String, func(p *domain.PricingInfo) interface{} { return p.Currency }),
+			"description": prop(graphql.String, func(p *domain.PricingInfo) interface{} { return p.Description }),
+		},
+	})
+
+	deliverableType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Deliverable",
+		Fields: graphql.Fields{
+			"id":          prop(nonNull(graphql.ID), func(d *domain.Deliverable) interface{} { return d.ID }),
+			"name":        prop(nonNull(graphql.String), func(d *domain.Deliverable) interface{} { return d.Name }),
+			"description": prop(graphql.String, func(d *domain.Deliverable) interface{} { return d.Description }),
+			"timeline":    prop(graphql.String, func(d *domain.Deliverable) interface{} { return d.Timeline }),
+			"createdAt":   prop(nonNull(graphql.DateTime), func(d *domain.Deliverable) interface{} { return d.CreatedAt }),
+		},
+	})
+
+	serviceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Service",
+		Fields: graphql.Fields{
+			"id":          prop(nonNull(graphql.ID), func(s *domain.Service) interface{} { return s.ID }),
+			"name":        prop(nonNull(graphql.String), func(s *domain.Service) interface{} { return s.Name }),
+			"description": prop(graphql.String, func(s *domain.Service) interface{} { return s.Description }),
+			"category":    prop(nonNull(serviceTypeEnum), func(s *domain.Service) interface{} { return s.Category }),
+			"duration":    prop(graphql.String, func(s *domain.Service) interface{} { return s.Duration }),
+			"pricing":     prop(pricingType, func(s *domain.Service) interface{} { return s.Pricing }),
+			"isActive":    prop(nonNull(graphql.Boolean), func(s *domain.Service) interface{} { return s.IsActive }),
+			"technologies": currentTechnologies(func(p graphql.ResolveParams) []domain.Technology {
+				return p.Source.(*domain.Service).Technologies
+			}),
+			"deliverables": prop(listOf(deliverableType), func(s *domain.Service) interface{} { return pointers(s.Deliverables) }),
+			"createdAt":    prop(nonNull(graphql.DateTime), func(s *domain.Service) interface{} { return s.CreatedAt }),
+			"updatedAt":    prop(nonNull(graphql.DateTime), func(s *domain.Service) interface{} { return s.UpdatedAt }),
+		},
+	})
+
+	idArgs := graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: nonNull(graphql.ID)}}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"technologies": &graphql.Field{
+				Type: listOf(technologyType),
+				Args: pageArgs(graphql.FieldConfigArgument{
+					"category": &graphql.ArgumentConfig{Type: graphql.String},
+					"level":    &graphql.ArgumentConfig{Type: levelEnum},
+				}, orderEnum("TechnologyOrderField", "technology")),
+				Resolve: r.listTechnologies,
+			},
+			"technology": &graphql.Field{Type: technologyType, Args: idArgs, Resolve: r.getTechnology},
+			"experiences": &graphql.Field{
+				Type: listOf(experienceType),
+				Args: pageArgs(graphql.FieldConfigArgument{
+					"companyName": &graphql.ArgumentConfig{Type: graphql.String},
+					"position":    &graphql.ArgumentConfig{Type: graphql.String},
+					"location":    &graphql.ArgumentConfig{Type: graphql.String},
+					"technology":  &graphql.ArgumentConfig{Type: graphql.String},
+					"isCurrent":   &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"isRemote":    &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"startAfter":  &graphql.ArgumentConfig{Type: graphql.String, Description: "YYYY-MM-DD"},
+					"endBefore":   &graphql.ArgumentConfig{Type: graphql.String, Description: "YYYY-MM-DD"},
+				}, orderEnum("ExperienceOrderField", "experience")),
+				Resolve: r.listExperiences,
+			},
+			"experience": &graphql.Field{Type: experienceType, Args: idArgs, Resolve: r.getExperience},
+			"services": &graphql.Field{
+				Type: listOf(serviceType),
+				Args: pageArgs(graphql.FieldConfigArgument{
+					"category":    &graphql.ArgumentConfig{Type: serviceTypeEnum},
+					"isActive":    &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"technology":  &graphql.ArgumentConfig{Type: graphql.String},
+					"pricingType": &graphql.ArgumentConfig{Type: pricingTypeEnum},
+					"minPrice":    &graphql.ArgumentConfig{Type: graphql.Float},
+					"maxPrice":    &graphql.ArgumentConfig{Type: graphql.Float},
+				}, orderEnum("ServiceOrderField", "service")),
+				Resolve: r.listServices,
+			},
+			"service": &graphql.Field{Type: serviceType, Args: idArgs, Resolve: r.getService},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// newMetricsType mirrors domain.Metrics and its metric kinds.
+func newMetricsType() *graphql.Object {
+	coverage := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CoverageMetric",
+		Fields: graphql.Fields{
+			"unit":        prop(graphql.String, func(m *domain.CoverageMetric) interface{} { return m.Unit }),
+			"before":      prop(nonNull(graphql.Float), func(m *domain.CoverageMetric) interface{} { return m.Before }),
+			"after":       prop(nonNull(graphql.Float), func(m *domain.CoverageMetric) interface{} { return m.After }),
+			"improvement": prop(nonNull(graphql.Float), func(m *domain.CoverageMetric) interface{} { return m.Improvement }),
+		},
+	})
+
+	timing := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TimeMetric",
+		Fields: graphql.Fields{
+			"unit":        prop(graphql.String, func(m *domain.TimeMetric) interface{} { return m.Unit }),
+			"before":      prop(nonNull(graphql.Int), func(m *domain.TimeMetric) interface{} { return m.Before }),
+			"after":       prop(nonNull(graphql.Int), func(m *domain.TimeMetric) interface{} { return m.After }),
+			"improvement": prop(nonNull(graphql.Float), func(m *domain.TimeMetric) interface{} { return m.Improvement }),
+		},
+	})
+
+	reliability := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReliabilityMetric",
+		Fields: graphql.Fields{
+			"unit":      prop(graphql.String, func(m *domain.ReliabilityMetric) interface{} { return m.Unit }),
+			"uptime":    prop(nonNull(graphql.Float), func(m *domain.ReliabilityMetric) interface{} { return m.Uptime }),
+			"mtbf":      prop(nonNull(graphql.Int), func(m *domain.ReliabilityMetric) interface{} { return m.MTBF }),
+			"mttr":      prop(nonNull(graphql.Int), func(m *domain.ReliabilityMetric) interface{} { return m.MTTR }),
+			"incidents": prop(nonNull(graphql.Int), func(m *domain.ReliabilityMetric) interface{} { return m.Incidents }),
+		},
+	})
+
+	productivity := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProductivityMetric",
+		Fields: graphql.Fields{
+			"unit":                prop(graphql.String, func(m *domain.ProductivityMetric) interface{} { return m.Unit }),
+			"deploymentFrequency": prop(nonNull(graphql.Int), func(m *domain.ProductivityMetric) interface{} { return m.DeploymentFrequency }),
+			"leadTime":            prop(nonNull(graphql.Int), func(m *domain.ProductivityMetric) interface{} { return m.LeadTime }),
+			"cycleTime":           prop(nonNull(graphql.Int), func(m *domain.ProductivityMetric) interface{} { return m.CycleTime }),
+			"efficiency":          prop(nonNull(graphql.Float), func(m *domain.ProductivityMetric) interface{} { return m.Efficiency }),
+		},
+	})
+
+	cost := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CostMetric",
+		Fields: graphql.Fields{
+			"unit":           prop(graphql.String, func(m *domain.CostMetric) interface{} { return m.Unit }),
+			"monthlySavings": prop(nonNull(graphql.Float), func(m *domain.CostMetric) interface{} { return m.MonthlySavings }),
+			"annualSavings":  prop(nonNull(graphql.Float), func(m *domain.CostMetric) interface{} { return m.AnnualSavings }),
+			"roi":            prop(nonNull(graphql.Float), func(m *domain.CostMetric) interface{} { return m.ROI }),
+			"paybackPeriod":  prop(nonNull(graphql.Int), func(m *domain.CostMetric) interface{} { return m.PaybackPeriod }),
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Metrics",
+		Fields: graphql.Fields{
+			"testCoverage":      prop(coverage, func(m *domain.Metrics) interface{} { return m.TestCoverage }),
+			"deploymentTime":    prop(timing, func(m *domain.Metrics) interface{} { return m.DeploymentTime }),
+			"systemReliability": prop(reliability, func(m *domain.Metrics) interface{} { return m.SystemReliability }),
+			"productivity":      prop(productivity, func(m *domain.Metrics) interface{} { return m.Productivity }),
+			"costSavings":       prop(cost, func(m *domain.Metrics) interface{} { return m.CostSavings }),
+		},
+	})
+}
@@ -0,0 +1,119 @@
+// Package graph exposes the portfolio domain as a read-only GraphQL schema.
+// Resolvers sit on the service layer; technology lookups are batched per
+// request and every operation is checked against depth and complexity limits
+// before it runs.
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/service"
+)
+
+// Request is a GraphQL request as sent over HTTP.
+type Request struct {
+	Variables     map[string]interface{} `json:"variables"`
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Server executes GraphQL requests against the portfolio schema.
+type Server struct {
+	schema   graphql.Schema
+	techRepo repository.TechnologyRepository
+}
+
+// NewServer builds the schema on top of the portfolio services.
+func NewServer(
+	technologyService *service.TechnologyService,
+	experienceService *service.ExperienceService,
+	portfolioService *service.PortfolioService,
+	techRepo repository.TechnologyRepository,
+) (*Server, error) {
+	schema, err := newSchema(&resolver{
+		technologies: technologyService,
+		experiences:  experienceService,
+		portfolio:    portfolioService,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{schema: schema, techRepo: techRepo}, nil
+}
+
+// Execute runs req. Requests that fail to parse, validate or stay within the
+// complexity limits are rejected before any resolver runs; rejected reports
+// whether that happened.
+func (s *Server) Execute(ctx context.Context, req Request) (result *graphql.Result, rejected bool) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, true
+	}
+
+	if validation := graphql.ValidateDocument(&s.schema, doc, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}, true
+	}
+
+	operation, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, true
+	}
+
+	if operation.Operation != ast.OperationTypeQuery {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(errors.New("only query operations are supported"))}, true
+	}
+
+	if err := checkComplexity(&s.schema, doc, operation, req.Variables); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, true
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, s.techRepo),
+	}), false
+}
+
+// selectOperation picks the operation to run: the one named, or the only one.
+// Without a name a document with several operations is ambiguous, since the
+// executor would refuse it after the complexity check ran on a guess.
+func selectOperation(doc *ast.Document, name string) (*ast.OperationDefinition, error) {
+	var operations []*ast.OperationDefinition
+
+	for _, def := range doc.Definitions {
+		if operation, ok := def.(*ast.OperationDefinition); ok {
+			operations = append(operations, operation)
+		}
+	}
+
+	if name == "" {
+		switch len(operations) {
+		case 0:
+			return nil, errors.New("the document has no operations")
+		case 1:
+			return operations[0], nil
+		default:
+			return nil, errors.New("operationName is required when the document has several operations")
+		}
+	}
+
+	for _, operation := range operations {
+		if operation.Name != nil && operation.Name.Value == name {
+			return operation, nil
+		}
+	}
+
+	return nil, errors.New("no operation named " + name)
+}
@@ -0,0 +1,228 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/testutil"
+)
+
+type fixture struct {
+	server   *Server
+	techRepo *testutil.MockTechnologyRepository
+	expRepo  *testutil.MockExperienceRepository
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	techRepo := testutil.NewMockTechnologyRepository()
+	expRepo := testutil.NewMockExperienceRepository()
+	serviceRepo := testutil.NewMockServiceRepository()
+
+	server, err := NewServer(
+		service.NewTechnologyService(techRepo),
+		service.NewExperienceService(expRepo, techRepo),
+		service.NewPortfolioService(serviceRepo, techRepo),
+		techRepo,
+	)
+	testutil.AssertNoError(t, err)
+
+	return &fixture{server: server, techRepo: techRepo, expRepo: expRepo}
+}
+
+func (f *fixture) run(t *testing.T, query string) (map[string]any, bool) {
+	t.Helper()
+
+	result, rejected := f.server.Execute(context.Background(), Request{Query: query})
+	if !rejected && result.HasErrors() {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+
+	raw, err := json.Marshal(result)
+	testutil.AssertNoError(t, err)
+
+	var body map[string]any
+	testutil.AssertNoError(t, json.Unmarshal(raw, &body))
+
+	return body, rejected
+}
+
+func TestServer_BatchesTechnologyLookups(t *testing.T) {
+	f := newFixture(t)
+	fixtures := testutil.NewExperienceFixtures()
+	techFixtures := testutil.NewTechnologyFixtures()
+
+	technologies := techFixtures.TechnologiesList()
+	f.techRepo.PreloadTechnologies(technologies)
+
+	experiences := fixtures.ExperiencesList()
+	for _, exp := range experiences {
+		exp.AddTechnology(*technologies[0])
+		exp.AddTechnology(*technologies[3])
+	}
+
+	f.expRepo.PreloadExperiences(experiences)
+
+	// The repository now disagrees with the snapshot embedded in each experience.
+	technologies[0].Level = domain.LevelIntermediate
+	f.techRepo.ClearCallLog()
+
+	body, _ := f.run(t, `{ experiences { id technologies { name level } } }`)
+
+	calls := f.techRepo.GetCallLog()
+	testutil.AssertLen(t, calls, 1)
+	testutil.AssertEqual(t, "List(filter)", calls[0])
+
+	list := body["data"].(map[string]any)["experiences"].([]any)
+	testutil.AssertLen(t, list, len(experiences))
+
+	for _, item := range list {
+		techs := item.(map[string]any)["technologies"].([]any)
+		testutil.AssertLen(t, techs, 2)
+		testutil.AssertEqual[any](t, "INTERMEDIATE", techs[0].(map[string]any)["level"])
+	}
+}
+
+func TestServer_ResolvesNestedMetrics(t *testing.T) {
+	f := newFixture(t)
+	exp := testutil.NewExperienceFixtures().ExperienceWithAchievements()
+	f.expRepo.PreloadExperiences([]*domain.Experience{exp})
+
+	body, _ := f.run(t, `{ experience(id: "`+exp.ID+`") { companyName isCurrent achievements { title metrics { testCoverage { before after } } } } }`)
+
+	found := body["data"].(map[string]any)["experience"].(map[string]any)
+	testutil.AssertEqual[any](t, exp.CompanyName, found["companyName"])
+	testutil.AssertLen(t, found["achievements"].([]any), len(exp.Achievements))
+
+	missing, _ := f.run(t, `{ experience(id: "missing") { id } }`)
+	testutil.AssertNil(t, missing["data"].(map[string]any)["experience"])
+}
+
+func TestServer_RejectsExpensiveQueries(t *testing.T) {
+	f := newFixture(t)
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "complexity",
+			query: `{ experiences(limit: 100) { technologies { id name category level description } achievements { metrics { testCoverage { before after unit improvement } } } } }`,
+			want:  "complexity",
+		},
+		{
+			name:  "complexity through fragments",
+			query: `{ services(limit: 100) { ...svc } } fragment svc on Service { technologies { id name category level description iconUrl createdAt updatedAt } deliverables { id name description timeline createdAt } }`,
+			want:  "complexity",
+		},
+		{
+			name:  "invalid field",
+			query: `{ experiences { salary } }`,
+			want:  "salary",
+		},
+		{
+			name:  "mutation",
+			query: `mutation { deleteExperience(id: "1") }`,
+			want:  "only query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, rejected := f.run(t, tt.query)
+
+			testutil.AssertTrue(t, rejected, "Query should be rejected before execution")
+
+			raw, _ := json.Marshal(body["errors"])
+			testutil.AssertTrue(t, strings.Contains(strings.ToLower(string(raw)), tt.want), "Unexpected errors: "+string(raw))
+		})
+	}
+
+	_, rejected := f.run(t, `{ experiences(limit: 5) { technologies { name } achievements { title } } }`)
+	testutil.AssertFalse(t, rejected, "A modest query should run")
+}
+
+func TestServer_SelectsOperation(t *testing.T) {
+	f := newFixture(t)
+
+	const document = `query Cheap { technologies { name } } query Costly { experiences(limit: 100) { technologies { id name category level description } achievements { metrics { testCoverage { before after unit improvement } } } } }`
+
+	run := func(operationName string) (*graphql.Result, bool) {
+		return f.server.Execute(context.Background(), Request{Query: document, OperationName: operationName})
+	}
+
+	result, rejected := run("")
+	testutil.AssertTrue(t, rejected, "An ambiguous document should be rejected")
+	testutil.AssertTrue(t, strings.Contains(result.Errors[0].Message, "operationName is required"), "Unexpected error: "+result.Errors[0].Message)
+
+	result, rejected = run("Cheap")
+	testutil.AssertFalse(t, rejected, "The named operation should run")
+	testutil.AssertFalse(t, result.HasErrors(), "Expected no errors")
+
+	result, rejected = run("Costly")
+	testutil.AssertTrue(t, rejected, "The named operation should be checked for complexity")
+	testutil.AssertTrue(t, strings.Contains(result.Errors[0].Message, "complexity"), "Unexpected error: "+result.Errors[0].Message)
+
+	_, rejected = run("Missing")
+	testutil.AssertTrue(t, rejected, "An unknown operation should be rejected")
+}
+
+func TestComplexity_ClampsLimits(t *testing.T) {
+	f := newFixture(t)
+
+	cost := func(t *testing.T, query string, variables map[string]interface{}) int {
+		t.Helper()
+
+		doc, err := parser.Parse(parser.ParseParams{Source: query})
+		testutil.AssertNoError(t, err)
+
+		operation, err := selectOperation(doc, "")
+		testutil.AssertNoError(t, err)
+
+		n, err := operationCost(&f.server.schema, doc, operation, variables)
+		testutil.AssertNoError(t, err)
+
+		return n
+	}
+
+	const fields = `{ technologies { id name } }`
+
+	withLimit := func(limit string) string {
+		return `query($n: Int) { experiences(limit: ` + limit + `) ` + fields + ` }`
+	}
+
+	defaultCost := cost(t, withLimit("10"), nil)
+	maxCost := cost(t, withLimit("100"), nil)
+
+	testutil.AssertEqual(t, defaultCost, cost(t, withLimit("0"), nil))
+	testutil.AssertEqual(t, defaultCost, cost(t, withLimit("-1000"), nil))
+	testutil.AssertEqual(t, defaultCost, cost(t, withLimit("$n"), map[string]interface{}{"n": float64(-1000)}))
+	testutil.AssertEqual(t, maxCost, cost(t, withLimit("100000"), nil))
+
+	// A negative alias must not pay for an expensive sibling
+	expensive := `technologies { id name category level description } achievements { metrics { testCoverage { before after unit improvement } } }`
+	aliased := map[string]string{
+		"literal":  `{ a: experiences(limit: 100) { ` + expensive + ` } b: experiences(limit: -100000) { ` + expensive + ` } }`,
+		"variable": `query($n: Int) { a: experiences(limit: 100) { ` + expensive + ` } b: experiences(limit: $n) { ` + expensive + ` } }`,
+	}
+
+	for name, query := range aliased {
+		t.Run("alias offset "+name, func(t *testing.T) {
+			result, rejected := f.server.Execute(context.Background(), Request{
+				Query:     query,
+				Variables: map[string]interface{}{"n": float64(-100000)},
+			})
+
+			testutil.AssertTrue(t, rejected, "Negative limits should not offset the cost of other fields")
+			testutil.AssertTrue(t, result.HasErrors(), "Expected a complexity error")
+		})
+	}
+}
@@ -0,0 +1,747 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/templates"
+)
+
+// adminDateLayout is the value format of <input type="date">.
+const adminDateLayout = "2006-01-02"
+
+// AdminHandlers serves the browser-based content editor under /admin.
+type AdminHandlers struct {
+	technologyService *service.TechnologyService
+	experienceService *service.ExperienceService
+	portfolioService  *service.PortfolioService
+	sessions          SessionManager
+	sessionMaxAge     time.Duration
+	secureCookies     bool
+}
+
+// SessionManager signs editors in with an API token and keeps their sessions
+// server-side, so the browser only ever holds a session ID.
+type SessionManager interface {
+	TokenAuthenticator
+	StartSession(ctx context.Context, token *domain.APIToken, maxAge time.Duration) (string, time.Time, error)
+	ResumeSession(ctx context.Context, id string) (*domain.APIToken, error)
+	EndSession(ctx context.Context, id string) error
+}
+
+// NewAdminHandlers creates the content editor handlers. Editors sign in with an
+// API token carrying the content:write scope and stay signed in for at most
+// sessionMaxAge; secureCookies should be set whenever the site is served over
+// HTTPS.
+func NewAdminHandlers(
+	technologyService *service.TechnologyService,
+	experienceService *service.ExperienceService,
+	portfolioService *service.PortfolioService,
+	sessions SessionManager,
+	sessionMaxAge time.Duration,
+	secureCookies bool,
+) *AdminHandlers {
+	return &AdminHandlers{
+		technologyService: technologyService,
+		experienceService: experienceService,
+		portfolioService:  portfolioService,
+		sessions:          sessions,
+		sessionMaxAge:     sessionMaxAge,
+		secureCookies:     secureCookies,
+	}
+}
+
+// RequireSession returns middleware that admits requests whose session cookie
+// names a live session of a token with the content:write scope and sends
+// everyone else to the login page.
+func (h *AdminHandlers) RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := c.Cookie(constants.AdminSessionCookie)
+		if err == nil && id != "" {
+			token, authErr := h.sessions.ResumeSession(c.Request.Context(), id)
+			if authErr == nil && token.HasScope(domain.ScopeContentWrite) {
+				c.Set(apiTokenContextKey, token)
+				c.Next()
+
+				return
+			}
+		}
+
+		h.clearSession(c)
+
+		if c.GetHeader("HX-Request") == "true" {
+			c.Header("HX-Redirect", "/admin/login")
+			c.AbortWithStatus(http.StatusUnauthorized)
+
+			return
+		}
+
+		c.Redirect(http.StatusSeeOther, "/admin/login")
+		c.Abort()
+	}
+}
+
+// LoginPage handles GET /admin/login.
+func (h *AdminHandlers) LoginPage(c *gin.Context) {
+	h.render(c, http.StatusOK, templates.AdminLogin(""))
+}
+
+// Login handles POST /admin/login by exchanging a pasted API token for a session cookie.
+func (h *AdminHandlers) Login(c *gin.Context) {
+	secret := strings.TrimSpace(c.PostForm("token"))
+
+	token, err := h.sessions.Authenticate(c.Request.Context(), secret)
+	if err != nil {
+		status, message := http.StatusUnauthorized, "That token is invalid, expired or revoked."
+		if !errors.Is(err, domain.ErrTokenInvalid) &&
+			!errors.Is(err, domain.ErrTokenExpired) &&
+			!errors.Is(err, domain.ErrTokenRevoked) {
+			status, message = http.StatusInternalServerError, "Sign-in is currently unavailable."
+		}
+
+		h.render(c, status, templates.AdminLogin(message))
+
+		return
+	}
+
+	if !token.HasScope(domain.ScopeContentWrite) {
+		h.render(c, http.StatusForbidden, templates.AdminLogin("That token lacks the content:write scope."))
+		return
+	}
+
+	// The session never outlives the token, and neither does the cookie
+	id, expiresAt, err := h.sessions.StartSession(c.Request.Context(), token, h.sessionMaxAge)
+	if err != nil {
+		slog.ErrorContext(c.Request.Context(), "Failed to start admin session", "error", err)
+		h.render(c, http.StatusInternalServerError, templates.AdminLogin("Sign-in is currently unavailable."))
+
+		return
+	}
+
+	h.setSession(c, id, int(time.Until(expiresAt).Round(time.Second).Seconds()))
+	c.Redirect(http.StatusSeeOther, "/admin")
+}
+
+// Logout handles POST /admin/logout by ending the session server-side, so a
+// copied cookie stops working too.
+func (h *AdminHandlers) Logout(c *gin.Context) {
+	if id, err := c.Cookie(constants.AdminSessionCookie); err == nil {
+		if err := h.sessions.EndSession(c.Request.Context(), id); err != nil {
+			slog.ErrorContext(c.Request.Context(), "Failed to end admin session", "error", err)
+		}
+	}
+
+	h.clearSession(c)
+	c.Redirect(http.StatusSeeOther, "/admin/login")
+}
+
+// Dashboard handles GET /admin.
+func (h *AdminHandlers) Dashboard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	technologies, err := h.technologyService.ListTechnologies(ctx, service.TechnologyFilter{})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	experiences, err := h.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	services, err := h.portfolioService.ListServices(ctx, service.ServiceFilter{})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminDashboard(len(technologies), len(experiences), len(services)))
+}
+
+// TechnologiesPage handles GET /admin/technologies.
+func (h *AdminHandlers) TechnologiesPage(c *gin.Context) {
+	technologies, err := h.technologyService.ListTechnologies(c.Request.Context(), service.TechnologyFilter{
+		OrderBy:  &[]string{"name"}[0],
+		OrderDir: &[]string{"asc"}[0],
+	})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminTechnologies(technologies))
+}
+
+// CreateTechnology handles POST /admin/technologies and responds with the new row.
+func (h *AdminHandlers) CreateTechnology(c *gin.Context) {
+	tech, err := h.technologyService.CreateTechnology(
+		c.Request.Context(),
+		c.PostForm("name"),
+		c.PostForm("category"),
+		domain.Level(c.PostForm("level")),
+	)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderPartial(c, templates.AdminTechnologyRow(tech))
+}
+
+// UpdateTechnology handles POST /admin/technologies/:id and responds with the updated row.
+func (h *AdminHandlers) UpdateTechnology(c *gin.Context) {
+	level := domain.Level(c.PostForm("level"))
+	description := c.PostForm("description")
+
+	tech, err := h.technologyService.UpdateTechnology(c.Request.Context(), c.Param("id"), service.TechnologyUpdate{
+		Level:       &level,
+		Description: &description,
+	})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderPartial(c, templates.AdminTechnologyRow(tech))
+}
+
+// DeleteTechnology handles DELETE /admin/technologies/:id.
+func (h *AdminHandlers) DeleteTechnology(c *gin.Context) {
+	if err := h.technologyService.DeleteTechnology(c.Request.Context(), c.Param("id")); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderPartial(c, templ.NopComponent)
+}
+
+// ExperiencesPage handles GET /admin/experiences.
+func (h *AdminHandlers) ExperiencesPage(c *gin.Context) {
+	experiences, err := h.experienceService.ListExperiences(c.Request.Context(), service.ExperienceFilter{})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminExperiences(experiences))
+}
+
+// CreateExperience handles POST /admin/experiences and redirects to the new experience's editor.
+func (h *AdminHandlers) CreateExperience(c *gin.Context) {
+	startDate, err := parseFormDate(c, "start_date")
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	req := service.CreateExperienceRequest{
+		CompanyName: c.PostForm("company_name"),
+		Position:    c.PostForm("position"),
+	}
+	if startDate != nil {
+		req.StartDate = *startDate
+	}
+
+	exp, err := h.experienceService.CreateExperience(c.Request.Context(), req)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	c.Header("HX-Redirect", "/admin/experiences/"+exp.ID)
+	c.Status(http.StatusCreated)
+}
+
+// ExperienceEditor handles GET /admin/experiences/:id.
+func (h *AdminHandlers) ExperienceEditor(c *gin.Context) {
+	exp, err := h.experienceService.GetExperience(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminExperienceEditor(exp))
+}
+
+// UpdateExperience handles POST /admin/experiences/:id.
+func (h *AdminHandlers) UpdateExperience(c *gin.Context) {
+	update, err := experienceUpdateFromForm(c)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	if _, err := h.experienceService.UpdateExperience(c.Request.Context(), c.Param("id"), update); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminSaved("Experience saved."))
+}
+
+// DeleteExperience handles DELETE /admin/experiences/:id.
+func (h *AdminHandlers) DeleteExperience(c *gin.Context) {
+	if err := h.experienceService.DeleteExperience(c.Request.Context(), c.Param("id")); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderPartial(c, templ.NopComponent)
+}
+
+// PreviewExperience handles POST /admin/experiences/:id/preview. It applies the
+// unsaved editor fields and any drafted achievement to a copy of the stored
+// experience and renders it with the public templates. Nothing is persisted.
+func (h *AdminHandlers) PreviewExperience(c *gin.Context) {
+	stored, err := h.experienceService.GetExperience(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	update, err := experienceUpdateFromForm(c)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	draft := *stored
+	draft.Achievements = append([]domain.Achievement(nil), stored.Achievements...)
+	applyExperienceDraft(&draft, update)
+
+	if title := strings.TrimSpace(c.PostForm("achievement_title")); title != "" {
+		req, err := achievementFromForm(c)
+		if err != nil {
+			h.renderError(c, err)
+			return
+		}
+
+		draft.Achievements = append(draft.Achievements, *domain.NewAchievementWithMetrics(req.Title, req.Description, req.Impact, req.Metrics))
+	}
+
+	h.renderPartial(c, templates.AdminExperiencePreview(&draft))
+}
+
+// AddAchievement handles POST /admin/experiences/:id/achievements and responds with the achievement list.
+func (h *AdminHandlers) AddAchievement(c *gin.Context) {
+	req, err := achievementFromForm(c)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.updateAchievements(c, func(ctx context.Context, id string) error {
+		return h.experienceService.AddAchievementToExperience(ctx, id, req)
+	})
+}
+
+// RemoveAchievement handles DELETE /admin/experiences/:id/achievements/:achievementId.
+func (h *AdminHandlers) RemoveAchievement(c *gin.Context) {
+	h.updateAchievements(c, func(ctx context.Context, id string) error {
+		return h.experienceService.RemoveAchievementFromExperience(ctx, id, c.Param("achievementId"))
+	})
+}
+
+// ServicesPage handles GET /admin/services.
+func (h *AdminHandlers) ServicesPage(c *gin.Context) {
+	services, err := h.portfolioService.ListServices(c.Request.Context(), service.ServiceFilter{})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminServices(services))
+}
+
+// CreateService handles POST /admin/services and redirects to the new service's editor.
+func (h *AdminHandlers) CreateService(c *gin.Context) {
+	svc, err := h.portfolioService.CreateService(c.Request.Context(), service.CreateServiceRequest{
+		Name:        c.PostForm("name"),
+		Description: c.PostForm("description"),
+		Category:    domain.ServiceType(c.PostForm("category")),
+	})
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	c.Header("HX-Redirect", "/admin/services/"+svc.ID)
+	c.Status(http.StatusCreated)
+}
+
+// ServiceEditor handles GET /admin/services/:id.
+func (h *AdminHandlers) ServiceEditor(c *gin.Context) {
+	svc, err := h.portfolioService.GetService(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminServiceEditor(svc))
+}
+
+// UpdateService handles POST /admin/services/:id.
+func (h *AdminHandlers) UpdateService(c *gin.Context) {
+	name := c.PostForm("name")
+	description := c.PostForm("description")
+	category := domain.ServiceType(c.PostForm("category"))
+	duration := c.PostForm("duration")
+
+	if _, err := h.portfolioService.UpdateService(c.Request.Context(), c.Param("id"), service.ServiceUpdate{
+		Name:        &name,
+		Description: &description,
+		Category:    &category,
+		Duration:    &duration,
+	}); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminSaved("Service saved."))
+}
+
+// DeleteService handles DELETE /admin/services/:id.
+func (h *AdminHandlers) DeleteService(c *gin.Context) {
+	if err := h.portfolioService.DeleteService(c.Request.Context(), c.Param("id")); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderPartial(c, templ.NopComponent)
+}
+
+// UpdatePricing handles POST /admin/services/:id/pricing.
+func (h *AdminHandlers) UpdatePricing(c *gin.Context) {
+	amount, _, err := parseFormNumber(c, "amount")
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	pricing := domain.PricingInfo{
+		Type:        domain.PricingType(c.PostForm("type")),
+		Amount:      amount,
+		Currency:    strings.TrimSpace(c.PostForm("currency")),
+		Description: strings.TrimSpace(c.PostForm("description")),
+	}
+
+	if err := h.portfolioService.UpdateServicePricing(c.Request.Context(), c.Param("id"), pricing); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.render(c, http.StatusOK, templates.AdminSaved("Pricing saved."))
+}
+
+// AddDeliverable handles POST /admin/services/:id/deliverables and responds with the deliverable list.
+func (h *AdminHandlers) AddDeliverable(c *gin.Context) {
+	req := service.DeliverableRequest{
+		Name:        c.PostForm("name"),
+		Description: c.PostForm("description"),
+		Timeline:    c.PostForm("timeline"),
+	}
+
+	h.updateService(c, templates.AdminDeliverables, func(ctx context.Context, id string) error {
+		return h.portfolioService.AddDeliverableToService(ctx, id, req)
+	})
+}
+
+// RemoveDeliverable handles DELETE /admin/services/:id/deliverables/:deliverableId.
+func (h *AdminHandlers) RemoveDeliverable(c *gin.Context) {
+	h.updateService(c, templates.AdminDeliverables, func(ctx context.Context, id string) error {
+		return h.portfolioService.RemoveDeliverableFromService(ctx, id, c.Param("deliverableId"))
+	})
+}
+
+// ActivateService handles POST /admin/services/:id/activate.
+func (h *AdminHandlers) ActivateService(c *gin.Context) {
+	h.updateService(c, templates.AdminServiceStatus, h.portfolioService.ActivateService)
+}
+
+// DeactivateService handles POST /admin/services/:id/deactivate.
+func (h *AdminHandlers) DeactivateService(c *gin.Context) {
+	h.updateService(c, templates.AdminServiceStatus, h.portfolioService.DeactivateService)
+}
+
+// updateAchievements runs a mutating achievement operation, responds with the
+// refreshed achievement list and asks the preview to reload.
+func (h *AdminHandlers) updateAchievements(c *gin.Context, operation func(context.Context, string) error) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := operation(ctx, id); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	exp, err := h.experienceService.GetExperience(ctx, id)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	c.Header("HX-Trigger", "achievementsChanged")
+	h.renderPartial(c, templates.AdminAchievements(exp))
+}
+
+// updateService runs a mutating service operation and responds with the given partial of the updated service.
+func (h *AdminHandlers) updateService(
+	c *gin.Context,
+	partial func(*domain.Service) templ.Component,
+	operation func(context.Context, string) error,
+) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := operation(ctx, id); err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	svc, err := h.portfolioService.GetService(ctx, id)
+	if err != nil {
+		h.renderError(c, err)
+		return
+	}
+
+	h.renderPartial(c, partial(svc))
+}
+
+// render writes a templ component with the given status code.
+func (h *AdminHandlers) render(c *gin.Context, status int, component templ.Component) {
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(status)
+
+	if err := renderComponent(c, component); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// renderPartial writes a successful htmx fragment and clears any error left in the flash area.
+func (h *AdminHandlers) renderPartial(c *gin.Context, component templ.Component) {
+	h.render(c, http.StatusOK, templ.Join(component, templates.AdminFlashClear()))
+}
+
+// renderError maps a service error to a status code and renders it into the
+// flash area, whatever element the request originally targeted.
+func (h *AdminHandlers) renderError(c *gin.Context, err error) {
+	status, message := http.StatusInternalServerError, "Something went wrong. Please try again."
+
+	switch {
+	case domain.IsValidationError(err):
+		status, message = http.StatusBadRequest, "Please check your input."
+	case domain.IsNotFoundError(err):
+		status, message = http.StatusNotFound, "That item no longer exists."
+	case domain.IsConflictError(err):
+		status, message = http.StatusConflict, "An item with that name already exists."
+	}
+
+	var details []string
+	if status != http.StatusInternalServerError {
+		details = []string{err.Error()}
+		if domErr, ok := domain.AsDomainError(err); ok && domErr.Field != "" {
+			details = []string{domErr.Field + ": " + domErr.Message}
+		}
+	}
+
+	c.Header("HX-Retarget", "#flash")
+	c.Header("HX-Reswap", "innerHTML")
+	h.render(c, status, templates.AdminFlash(message, details))
+}
+
+func (h *AdminHandlers) setSession(c *gin.Context, id string, maxAge int) {
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie(constants.AdminSessionCookie, id, maxAge, "/admin", "", h.secureCookies, true)
+}
+
+func (h *AdminHandlers) clearSession(c *gin.Context) {
+	h.setSession(c, "", -1)
+}
+
+// experienceUpdateFromForm reads the experience editor fields. The editor
+// always submits the end date, so an empty one makes the experience a current
+// position.
+func experienceUpdateFromForm(c *gin.Context) (service.ExperienceUpdate, error) {
+	startDate, err := parseFormDate(c, "start_date")
+	if err != nil {
+		return service.ExperienceUpdate{}, err
+	}
+
+	endDate, err := parseFormDate(c, "end_date")
+	if err != nil {
+		return service.ExperienceUpdate{}, err
+	}
+
+	companyName := c.PostForm("company_name")
+	position := c.PostForm("position")
+	description := c.PostForm("description")
+	location := c.PostForm("location")
+	isRemote := c.PostForm("is_remote") == "true"
+
+	return service.ExperienceUpdate{
+		StartDate:   startDate,
+		EndDate:     endDate,
+		CompanyName: &companyName,
+		Position:    &position,
+		Description: &description,
+		Location:    &location,
+		IsRemote:    &isRemote,
+		Current:     endDate == nil,
+	}, nil
+}
+
+// applyExperienceDraft applies editor fields to an experience for previewing
+// without the validation performed on save.
+func applyExperienceDraft(exp *domain.Experience, update service.ExperienceUpdate) {
+	exp.CompanyName = strings.TrimSpace(*update.CompanyName)
+	exp.Position = strings.TrimSpace(*update.Position)
+	exp.Description = strings.TrimSpace(*update.Description)
+	exp.Location = strings.TrimSpace(*update.Location)
+	exp.IsRemote = *update.IsRemote
+
+	if update.StartDate != nil {
+		exp.StartDate = *update.StartDate
+	}
+
+	if update.Current || update.EndDate != nil {
+		exp.EndDate = update.EndDate
+	}
+}
+
+// achievementFromForm reads the achievement form, including any metrics card
+// groups that have at least one value filled in.
+func achievementFromForm(c *gin.Context) (service.AchievementRequest, error) {
+	metrics, err := metricsFromForm(c)
+	if err != nil {
+		return service.AchievementRequest{}, err
+	}
+
+	return service.AchievementRequest{
+		Title:       c.PostForm("achievement_title"),
+		Description: strings.TrimSpace(c.PostForm("achievement_description")),
+		Impact:      strings.TrimSpace(c.PostForm("achievement_impact")),
+		Metrics:     metrics,
+	}, nil
+}
+
+// metricsFromForm builds typed metrics from the metrics card fields and
+// returns nil when none were filled in.
+func metricsFromForm(c *gin.Context) (*domain.Metrics, error) {
+	metrics := &domain.Metrics{}
+
+	values, present, err := parseFormNumbers(c, "coverage_before", "coverage_after")
+	if err != nil {
+		return nil, err
+	}
+
+	if present {
+		metrics.TestCoverage = domain.NewTestCoverageMetric(values[0], values[1])
+	}
+
+	values, present, err = parseFormNumbers(c, "deployment_before", "deployment_after")
+	if err != nil {
+		return nil, err
+	}
+
+	if present {
+		metrics.DeploymentTime = domain.NewDeploymentTimeMetric(roundInt(values[0]), roundInt(values[1]))
+	}
+
+	values, present, err = parseFormNumbers(c, "reliability_uptime", "reliability_mtbf", "reliability_mttr", "reliability_incidents")
+	if err != nil {
+		return nil, err
+	}
+
+	if present {
+		metrics.SystemReliability = domain.NewReliabilityMetric(values[0], roundInt(values[1]), roundInt(values[2]), roundInt(values[3]))
+	}
+
+	values, present, err = parseFormNumbers(c,
+		"productivity_deployment_frequency", "productivity_lead_time", "productivity_cycle_time", "productivity_efficiency")
+	if err != nil {
+		return nil, err
+	}
+
+	if present {
+		metrics.Productivity = domain.NewProductivityMetric(roundInt(values[0]), roundInt(values[1]), roundInt(values[2]), values[3])
+	}
+
+	values, present, err = parseFormNumbers(c, "cost_monthly_savings", "cost_annual_savings", "cost_roi", "cost_payback_period")
+	if err != nil {
+		return nil, err
+	}
+
+	if present {
+		metrics.CostSavings = domain.NewCostMetric(values[0], values[1], values[2], roundInt(values[3]))
+	}
+
+	if *metrics == (domain.Metrics{}) {
+		return nil, nil
+	}
+
+	return metrics, nil
+}
+
+// parseFormNumbers parses a group of numeric fields, treating blanks as zero,
+// and reports whether any field in the group was filled in.
+func parseFormNumbers(c *gin.Context, fields ...string) ([]float64, bool, error) {
+	values := make([]float64, len(fields))
+	anyPresent := false
+
+	for i, field := range fields {
+		value, present, err := parseFormNumber(c, field)
+		if err != nil {
+			return nil, false, err
+		}
+
+		values[i] = value
+		anyPresent = anyPresent || present
+	}
+
+	return values, anyPresent, nil
+}
+
+func parseFormNumber(c *gin.Context, field string) (float64, bool, error) {
+	raw := strings.TrimSpace(c.PostForm(field))
+	if raw == "" {
+		return 0, false, nil
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false, domain.ErrInvalidField(field, "must be a number")
+	}
+
+	return value, true, nil
+}
+
+func parseFormDate(c *gin.Context, field string) (*time.Time, error) {
+	raw := strings.TrimSpace(c.PostForm(field))
+	if raw == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(adminDateLayout, raw)
+	if err != nil {
+		return nil, domain.ErrInvalidField(field, "must be a date in YYYY-MM-DD format")
+	}
+
+	return &date, nil
+}
+
+func roundInt(value float64) int {
+	return int(math.Round(value))
+}
@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/testutil"
+)
+
+// stubAuthenticator knows an editor token with the content:write scope and a
+// reader token without it.
+type stubAuthenticator struct{}
+
+func (stubAuthenticator) Authenticate(_ context.Context, secret string) (*domain.APIToken, error) {
+	switch secret {
+	case "editor":
+		return &domain.APIToken{Name: "editor", Scopes: []domain.Scope{domain.ScopeContentWrite}}, nil
+	case "reader":
+		return &domain.APIToken{Name: "reader"}, nil
+	default:
+		return nil, domain.ErrTokenInvalid
+	}
+}
+
+// Session IDs the stub session manager starts out with.
+const (
+	editorSession = "editor-session"
+	readerSession = "reader-session"
+)
+
+// stubSessions keeps sessions in a map keyed by their ID.
+type stubSessions struct {
+	stubAuthenticator
+	sessions map[string]*domain.APIToken
+}
+
+func newStubSessions() *stubSessions {
+	editor, _ := stubAuthenticator{}.Authenticate(context.Background(), "editor")
+	reader, _ := stubAuthenticator{}.Authenticate(context.Background(), "reader")
+
+	return &stubSessions{sessions: map[string]*domain.APIToken{editorSession: editor, readerSession: reader}}
+}
+
+func (s *stubSessions) StartSession(_ context.Context, token *domain.APIToken, maxAge time.Duration) (string, time.Time, error) {
+	id := "started-" + token.Name
+	s.sessions[id] = token
+
+	return id, time.Now().Add(maxAge), nil
+}
+
+func (s *stubSessions) ResumeSession(_ context.Context, id string) (*domain.APIToken, error) {
+	token, ok := s.sessions[id]
+	if !ok {
+		return nil, domain.ErrSessionInvalid
+	}
+
+	return token, nil
+}
+
+func (s *stubSessions) EndSession(_ context.Context, id string) error {
+	delete(s.sessions, id)
+	return nil
+}
+
+// newAdminRouter serves the content editor over mock repositories.
+func newAdminRouter(t *testing.T) (*gin.Engine, *testutil.MockExperienceRepository) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	techRepo := testutil.NewMockTechnologyRepository()
+	expRepo := testutil.NewMockExperienceRepository()
+
+	h := NewAdminHandlers(
+		service.NewTechnologyService(techRepo),
+		service.NewExperienceService(expRepo, techRepo),
+		service.NewPortfolioService(testutil.NewMockServiceRepository(), techRepo),
+		newStubSessions(),
+		time.Hour,
+		false,
+	)
+
+	r := gin.New()
+	r.POST("/admin/login", h.Login)
+	r.POST("/admin/logout", h.Logout)
+
+	admin := r.Group("/admin", h.RequireSession())
+	admin.POST("/experiences", h.CreateExperience)
+	admin.GET("/experiences/:id", h.ExperienceEditor)
+	admin.POST("/experiences/:id", h.UpdateExperience)
+	admin.POST("/experiences/:id/preview", h.PreviewExperience)
+
+	return r, expRepo
+}
+
+// postForm submits form to target, signed in with session unless it is empty.
+func postForm(r http.Handler, target, session string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if session != "" {
+		req.AddCookie(&http.Cookie{Name: constants.AdminSessionCookie, Value: session})
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	return w
+}
+
+// sessionCookie returns the session cookie set by a response, if any.
+func sessionCookie(w *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == constants.AdminSessionCookie {
+			return cookie
+		}
+	}
+
+	return nil
+}
+
+func TestAdminLogin(t *testing.T) {
+	r, _ := newAdminRouter(t)
+
+	t.Run("valid token", func(t *testing.T) {
+		w := postForm(r, "/admin/login", "", url.Values{"token": {" editor "}})
+		testutil.AssertEqual(t, http.StatusSeeOther, w.Code)
+		testutil.AssertEqual(t, "/admin", w.Header().Get("Location"))
+
+		cookie := sessionCookie(w)
+		testutil.AssertTrue(t, cookie != nil, "Expected a session cookie")
+		testutil.AssertEqual(t, "started-editor", cookie.Value)
+		testutil.AssertEqual(t, 3600, cookie.MaxAge)
+		testutil.AssertTrue(t, cookie.HttpOnly, "Expected an HttpOnly cookie")
+		testutil.AssertEqual(t, http.SameSiteStrictMode, cookie.SameSite)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		w := postForm(r, "/admin/login", "", url.Values{"token": {"guess"}})
+		testutil.AssertEqual(t, http.StatusUnauthorized, w.Code)
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "That token is invalid"), "Expected the error message")
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), `name="token"`), "Expected the form to be shown again")
+		testutil.AssertTrue(t, sessionCookie(w) == nil, "Expected no session cookie")
+	})
+
+	t.Run("missing scope", func(t *testing.T) {
+		w := postForm(r, "/admin/login", "", url.Values{"token": {"reader"}})
+		testutil.AssertEqual(t, http.StatusForbidden, w.Code)
+		testutil.AssertTrue(t, sessionCookie(w) == nil, "Expected no session cookie")
+	})
+}
+
+func TestAdminRequireSession(t *testing.T) {
+	r, _ := newAdminRouter(t)
+
+	for _, session := range []string{"", "guess", "editor", readerSession} {
+		w := postForm(r, "/admin/experiences", session, url.Values{})
+		testutil.AssertEqual(t, http.StatusSeeOther, w.Code)
+		testutil.AssertEqual(t, "/admin/login", w.Header().Get("Location"))
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/experiences/1", nil)
+	req.Header.Set("HX-Request", "true")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	testutil.AssertEqual(t, http.StatusUnauthorized, w.Code)
+	testutil.AssertEqual(t, "/admin/login", w.Header().Get("HX-Redirect"))
+}
+
+func TestAdminLogout(t *testing.T) {
+	r, _ := newAdminRouter(t)
+
+	w := postForm(r, "/admin/logout", editorSession, url.Values{})
+	testutil.AssertEqual(t, http.StatusSeeOther, w.Code)
+	testutil.AssertEqual(t, "/admin/login", w.Header().Get("Location"))
+
+	cookie := sessionCookie(w)
+	testutil.AssertTrue(t, cookie != nil, "Expected the session cookie to be cleared")
+	testutil.AssertEqual(t, "", cookie.Value)
+	testutil.AssertTrue(t, cookie.MaxAge < 0, "Expected the session cookie to expire")
+
+	// A copy of the cookie no longer signs anyone in
+	w = postForm(r, "/admin/experiences", editorSession, url.Values{})
+	testutil.AssertEqual(t, http.StatusSeeOther, w.Code)
+	testutil.AssertEqual(t, "/admin/login", w.Header().Get("Location"))
+}
+
+func TestAdminExperienceEditor(t *testing.T) {
+	r, expRepo := newAdminRouter(t)
+	ctx := context.Background()
+
+	w := postForm(r, "/admin/experiences", editorSession, url.Values{
+		"company_name": {"Acme"},
+		"position":     {"Engineer"},
+		"start_date":   {"2020-01-01"},
+	})
+	testutil.AssertEqual(t, http.StatusCreated, w.Code)
+
+	editor := w.Header().Get("HX-Redirect")
+	testutil.AssertTrue(t, strings.HasPrefix(editor, "/admin/experiences/"), "Expected a redirect to the editor, got "+editor)
+	id := strings.TrimPrefix(editor, "/admin/experiences/")
+
+	form := url.Values{
+		"company_name": {"Acme"},
+		"position":     {"Lead Engineer"},
+		"start_date":   {"2020-01-01"},
+		"end_date":     {"2023-06-30"},
+		"location":     {"Berlin"},
+		"is_remote":    {"true"},
+	}
+
+	t.Run("edit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, editor, nil)
+		req.AddCookie(&http.Cookie{Name: constants.AdminSessionCookie, Value: editorSession})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		testutil.AssertEqual(t, http.StatusOK, w.Code)
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), `value="Acme"`), "Expected the stored company in the form")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		w := postForm(r, editor, editorSession, form)
+		testutil.AssertEqual(t, http.StatusOK, w.Code)
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "Experience saved."), "Expected a confirmation")
+
+		exp, err := expRepo.GetByID(ctx, id)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "Lead Engineer", exp.Position)
+		testutil.AssertEqual(t, "Berlin", exp.Location)
+		testutil.AssertTrue(t, exp.IsRemote, "Expected the remote flag to be saved")
+		testutil.AssertTrue(t, !exp.IsCurrent(), "Expected the end date to be saved")
+	})
+
+	t.Run("empty end date makes the position current", func(t *testing.T) {
+		current := url.Values{}
+		for key, values := range form {
+			current[key] = values
+		}
+
+		current.Set("end_date", "")
+
+		testutil.AssertEqual(t, http.StatusOK, postForm(r, editor, editorSession, current).Code)
+
+		exp, err := expRepo.GetByID(ctx, id)
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, exp.IsCurrent(), "Expected the end date to be cleared")
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		invalid := url.Values{}
+		for key, values := range form {
+			invalid[key] = values
+		}
+
+		invalid.Set("position", " ")
+
+		w := postForm(r, editor, editorSession, invalid)
+		testutil.AssertEqual(t, http.StatusBadRequest, w.Code)
+		testutil.AssertEqual(t, "#flash", w.Header().Get("HX-Retarget"))
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "Please check your input."), "Expected the error message")
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "position:"), "Expected the offending field")
+
+		exp, err := expRepo.GetByID(ctx, id)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "Lead Engineer", exp.Position)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		invalid := url.Values{}
+		for key, values := range form {
+			invalid[key] = values
+		}
+
+		invalid.Set("end_date", "30.06.2023")
+
+		w := postForm(r, editor, editorSession, invalid)
+		testutil.AssertEqual(t, http.StatusBadRequest, w.Code)
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "end_date:"), "Expected the offending field")
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		draft := url.Values{}
+		for key, values := range form {
+			draft[key] = values
+		}
+
+		draft.Set("position", "Head of Engineering")
+		draft.Set("achievement_title", "Shipped the platform")
+
+		w := postForm(r, editor+"/preview", editorSession, draft)
+		testutil.AssertEqual(t, http.StatusOK, w.Code)
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "Head of Engineering"), "Expected the drafted position")
+		testutil.AssertTrue(t, strings.Contains(w.Body.String(), "Shipped the platform"), "Expected the drafted achievement")
+
+		// Previewing saves nothing
+		exp, err := expRepo.GetByID(ctx, id)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "Lead Engineer", exp.Position)
+		testutil.AssertLen(t, exp.Achievements, 0)
+	})
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+)
+
+// apiTokenContextKey is the Gin context key under which the authenticated token is stored.
+const apiTokenContextKey = "api_token"
+
+// TokenAuthenticator resolves a bearer token to an active API token.
+type TokenAuthenticator interface {
+	Authenticate(ctx context.Context, secret string) (*domain.APIToken, error)
+}
+
+// RequireScope returns middleware that only admits requests carrying a valid
+// bearer token with the given scope.
+func RequireScope(auth TokenAuthenticator, scope domain.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authenticate(c, auth, scope)
+	}
+}
+
+// RequireScopeForWrites returns middleware that lets safe methods through and
+// requires a bearer token with the given scope for every other method.
+func RequireScopeForWrites(auth TokenAuthenticator, scope domain.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+		default:
+			authenticate(c, auth, scope)
+		}
+	}
+}
+
+// APITokenFromContext returns the token that authenticated the request, if any.
+func APITokenFromContext(c *gin.Context) (*domain.APIToken, bool) {
+	value, exists := c.Get(apiTokenContextKey)
+	if !exists {
+		return nil, false
+	}
+
+	token, ok := value.(*domain.APIToken)
+
+	return token, ok
+}
+
+// authenticate validates the bearer token on the request and aborts with
+// 401 or 403 if it is missing, invalid or lacks the required scope.
+func authenticate(c *gin.Context, auth TokenAuthenticator, scope domain.Scope) {
+	secret, ok := bearerToken(c.GetHeader("Authorization"))
+	if !ok {
+		abortUnauthorized(c, "invalid_request", domain.ErrTokenMissing)
+		return
+	}
+
+	token, err := auth.Authenticate(c.Request.Context(), secret)
+	if err != nil {
+		if errors.Is(err, domain.ErrTokenInvalid) ||
+			errors.Is(err, domain.ErrTokenExpired) ||
+			errors.Is(err, domain.ErrTokenRevoked) {
+			abortUnauthorized(c, "invalid_token", err)
+			return
+		}
+
+		slog.ErrorContext(c.Request.Context(), "Token authentication failed", "error", err)
+		AbortWithProblem(c, NewProblem(CodeInternal, ""))
+
+		return
+	}
+
+	if !token.HasScope(scope) {
+		c.Header("WWW-Authenticate", fmt.Sprintf(`Bearer realm="api", error="insufficient_scope", scope="%s"`, scope))
+		AbortWithProblem(c, NewProblem(CodeInsufficientScope, fmt.Sprintf("%s: %s", domain.ErrInsufficientScope.Error(), scope)))
+
+		return
+	}
+
+	c.Set(apiTokenContextKey, token)
+	c.Next()
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header.
+func bearerToken(header string) (string, bool) {
+	const scheme = "bearer "
+	if len(header) <= len(scheme) || !strings.EqualFold(header[:len(scheme)], scheme) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(header[len(scheme):])
+
+	return token, token != ""
+}
+
+// abortUnauthorized answers 401 with the OAuth error code in the challenge
+// and the token problem matching err in the body.
+func abortUnauthorized(c *gin.Context, code string, err error) {
+	c.Header("WWW-Authenticate", fmt.Sprintf(`Bearer realm="api", error="%s"`, code))
+
+	problem, ok := tokenProblem(err)
+	if !ok {
+		problem = NewProblem(CodeTokenInvalid, err.Error())
+	}
+
+	AbortWithProblem(c, problem)
+}
@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+)
+
+// CreateTechnologyRequest represents the payload for creating a technology.
+type CreateTechnologyRequest struct {
+	Name        string       `binding:"required" json:"name"`
+	Category    string       `binding:"required" json:"category"`
+	Level       domain.Level `binding:"required" json:"level"`
+	Description string       `json:"description,omitempty"`
+	IconURL     string       `json:"icon_url,omitempty"`
+}
+
+// TechnologyLinkRequest represents the payload for attaching a technology to a resource.
+type TechnologyLinkRequest struct {
+	TechnologyID string `binding:"required" json:"technology_id"`
+}
+
+// EndExperienceRequest represents the payload for ending an experience.
+type EndExperienceRequest struct {
+	EndDate *time.Time `binding:"required" json:"end_date"`
+}
+
+// GetTechnologyHandler handles GET /api/v1/technologies/:id.
+func (h *PortfolioHandlers) GetTechnologyHandler(c *gin.Context) {
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Technology, error) {
+		return h.technologyService.GetTechnology(ctx, c.Param("id"))
+	})
+}
+
+// CreateTechnologyHandler handles POST /api/v1/technologies.
+func (h *PortfolioHandlers) CreateTechnologyHandler(c *gin.Context) {
+	var req CreateTechnologyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	HandleCreateOperation(h.patterns, c, func(ctx context.Context) (*domain.Technology, error) {
+		return h.technologyService.CreateTechnologyFromRequest(ctx, service.CreateTechnologyRequest{
+			Name:        req.Name,
+			Category:    req.Category,
+			Level:       req.Level,
+			Description: req.Description,
+			IconURL:     req.IconURL,
+		})
+	})
+}
+
+// UpdateTechnologyHandler handles PATCH /api/v1/technologies/:id.
+func (h *PortfolioHandlers) UpdateTechnologyHandler(c *gin.Context) {
+	var req service.TechnologyUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Technology, error) {
+		return h.technologyService.UpdateTechnology(ctx, c.Param("id"), req)
+	})
+}
+
+// DeleteTechnologyHandler handles DELETE /api/v1/technologies/:id.
+func (h *PortfolioHandlers) DeleteTechnologyHandler(c *gin.Context) {
+	h.patterns.HandleDeleteOperation(c, func(ctx context.Context) error {
+		return h.technologyService.DeleteTechnology(ctx, c.Param("id"))
+	})
+}
+
+// GetExperienceHandler handles GET /api/v1/experiences/:id.
+func (h *PortfolioHandlers) GetExperienceHandler(c *gin.Context) {
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Experience, error) {
+		return h.experienceService.GetExperience(ctx, c.Param("id"))
+	})
+}
+
+// CreateExperienceHandler handles POST /api/v1/experiences.
+func (h *PortfolioHandlers) CreateExperienceHandler(c *gin.Context) {
+	var req service.CreateExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	HandleCreateOperation(h.patterns, c, func(ctx context.Context) (*domain.Experience, error) {
+		return h.experienceService.CreateExperience(ctx, req)
+	})
+}
+
+// UpdateExperienceHandler handles PATCH /api/v1/experiences/:id.
+func (h *PortfolioHandlers) UpdateExperienceHandler(c *gin.Context) {
+	var req service.ExperienceUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Experience, error) {
+		return h.experienceService.UpdateExperience(ctx, c.Param("id"), req)
+	})
+}
+
+// DeleteExperienceHandler handles DELETE /api/v1/experiences/:id.
+func (h *PortfolioHandlers) DeleteExperienceHandler(c *gin.Context) {
+	h.patterns.HandleDeleteOperation(c, func(ctx context.Context) error {
+		return h.experienceService.DeleteExperience(ctx, c.Param("id"))
+	})
+}
+
+// AddExperienceTechnologyHandler handles POST /api/v1/experiences/:id/technologies.
+func (h *PortfolioHandlers) AddExperienceTechnologyHandler(c *gin.Context) {
+	var req TechnologyLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	h.updateExperience(c, func(ctx context.Context, id string) error {
+		return h.experienceService.AddTechnologyToExperience(ctx, id, req.TechnologyID)
+	})
+}
+
+// AddExperienceAchievementHandler handles POST /api/v1/experiences/:id/achievements.
+func (h *PortfolioHandlers) AddExperienceAchievementHandler(c *gin.Context) {
+	var req service.AchievementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	h.updateExperience(c, func(ctx context.Context, id string) error {
+		return h.experienceService.AddAchievementToExperience(ctx, id, req)
+	})
+}
+
+// RemoveExperienceAchievementHandler handles DELETE /api/v1/experiences/:id/achievements/:achievementId.
+func (h *PortfolioHandlers) RemoveExperienceAchievementHandler(c *gin.Context) {
+	h.updateExperience(c, func(ctx context.Context, id string) error {
+		return h.experienceService.RemoveAchievementFromExperience(ctx, id, c.Param("achievementId"))
+	})
+}
+
+// EndExperienceHandler handles POST /api/v1/experiences/:id/end.
+func (h *PortfolioHandlers) EndExperienceHandler(c *gin.Context) {
+	var req EndExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	h.updateExperience(c, func(ctx context.Context, id string) error {
+		return h.experienceService.EndExperience(ctx, id, *req.EndDate)
+	})
+}
+
+// GetServiceHandler handles GET /api/v1/services/:id.
+func (h *PortfolioHandlers) GetServiceHandler(c *gin.Context) {
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Service, error) {
+		return h.portfolioService.GetService(ctx, c.Param("id"))
+	})
+}
+
+// CreateServiceHandler handles POST /api/v1/services.
+func (h *PortfolioHandlers) CreateServiceHandler(c *gin.Context) {
+	var req service.CreateServiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	HandleCreateOperation(h.patterns, c, func(ctx context.Context) (*domain.Service, error) {
+		return h.portfolioService.CreateService(ctx, req)
+	})
+}
+
+// UpdateServiceHandler handles PATCH /api/v1/services/:id.
+func (h *PortfolioHandlers) UpdateServiceHandler(c *gin.Context) {
+	var req service.ServiceUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Service, error) {
+		return h.portfolioService.UpdateService(ctx, c.Param("id"), req)
+	})
+}
+
+// DeleteServiceHandler handles DELETE /api/v1/services/:id.
+func (h *PortfolioHandlers) DeleteServiceHandler(c *gin.Context) {
+	h.patterns.HandleDeleteOperation(c, func(ctx context.Context) error {
+		return h.portfolioService.DeleteService(ctx, c.Param("id"))
+	})
+}
+
+// AddServiceTechnologyHandler handles POST /api/v1/services/:id/technologies.
+func (h *PortfolioHandlers) AddServiceTechnologyHandler(c *gin.Context) {
+	var req TechnologyLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	h.updateService(c, func(ctx context.Context, id string) error {
+		return h.portfolioService.AddTechnologyToService(ctx, id, req.TechnologyID)
+	})
+}
+
+// AddServiceDeliverableHandler handles POST /api/v1/services/:id/deliverables.
+func (h *PortfolioHandlers) AddServiceDeliverableHandler(c *gin.Context) {
+	var req service.DeliverableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	h.updateService(c, func(ctx context.Context, id string) error {
+		return h.portfolioService.AddDeliverableToService(ctx, id, req)
+	})
+}
+
+// RemoveServiceDeliverableHandler handles DELETE /api/v1/services/:id/deliverables/:deliverableId.
+func (h *PortfolioHandlers) RemoveServiceDeliverableHandler(c *gin.Context) {
+	h.updateService(c, func(ctx context.Context, id string) error {
+		return h.portfolioService.RemoveDeliverableFromService(ctx, id, c.Param("deliverableId"))
+	})
+}
+
+// UpdateServicePricingHandler handles PUT /api/v1/services/:id/pricing.
+func (h *PortfolioHandlers) UpdateServicePricingHandler(c *gin.Context) {
+	var req domain.PricingInfo
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.responses.HandleBindError(c, err)
+		return
+	}
+
+	h.updateService(c, func(ctx context.Context, id string) error {
+		return h.portfolioService.UpdateServicePricing(ctx, id, req)
+	})
+}
+
+// ActivateServiceHandler handles POST /api/v1/services/:id/activate.
+func (h *PortfolioHandlers) ActivateServiceHandler(c *gin.Context) {
+	h.updateService(c, h.portfolioService.ActivateService)
+}
+
+// DeactivateServiceHandler handles POST /api/v1/services/:id/deactivate.
+func (h *PortfolioHandlers) DeactivateServiceHandler(c *gin.Context) {
+	h.updateService(c, h.portfolioService.DeactivateService)
+}
+
+// updateExperience runs a mutating experience operation and responds with the updated experience.
+func (h *PortfolioHandlers) updateExperience(c *gin.Context, operation func(context.Context, string) error) {
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Experience, error) {
+		id := c.Param("id")
+		if err := operation(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return h.experienceService.GetExperience(ctx, id)
+	})
+}
+
+// updateService runs a mutating service operation and responds with the updated service.
+func (h *PortfolioHandlers) updateService(c *gin.Context, operation func(context.Context, string) error) {
+	HandleSingleOperation(h.patterns, c, func(ctx context.Context) (*domain.Service, error) {
+		id := c.Param("id")
+		if err := operation(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return h.portfolioService.GetService(ctx, id)
+	})
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/testutil"
+)
+
+// newContentRouter serves the portfolio API over mock repositories.
+func newContentRouter(t *testing.T) (*gin.Engine, *testutil.MockTechnologyRepository) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	techRepo := testutil.NewMockTechnologyRepository()
+	expRepo := testutil.NewMockExperienceRepository()
+
+	h := NewPortfolioHandlers(
+		service.NewTechnologyService(techRepo),
+		service.NewExperienceService(expRepo, techRepo),
+		service.NewPortfolioService(testutil.NewMockServiceRepository(), techRepo),
+		nil,
+	)
+
+	r := gin.New()
+	r.GET("/api/v1/technologies", h.TechnologiesHandler)
+	r.POST("/api/v1/technologies", h.CreateTechnologyHandler)
+	r.GET("/api/v1/technologies/:id", h.GetTechnologyHandler)
+	r.PATCH("/api/v1/technologies/:id", h.UpdateTechnologyHandler)
+	r.DELETE("/api/v1/technologies/:id", h.DeleteTechnologyHandler)
+	r.POST("/api/v1/experiences", h.CreateExperienceHandler)
+
+	return r, techRepo
+}
+
+// send makes a request with body encoded as JSON and decodes the response
+// into out.
+func send(t *testing.T, r http.Handler, method, target string, body, out any) int {
+	t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		testutil.AssertNoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, target, &payload)
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if out != nil {
+		testutil.AssertNoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+	}
+
+	return rec.Code
+}
+
+// fieldsOf lists the fields named in a problem's details.
+func fieldsOf(problem Problem) string {
+	fields := make([]string, len(problem.Details))
+	for i, detail := range problem.Details {
+		fields[i] = detail.Field
+	}
+
+	return strings.Join(fields, ",")
+}
+
+func TestTechnologyWriteEndpoints(t *testing.T) {
+	r, techRepo := newContentRouter(t)
+
+	var created domain.Technology
+
+	status := send(t, r, http.MethodPost, "/api/v1/technologies", map[string]any{
+		"name":        "Go",
+		"category":    "language",
+		"level":       "expert",
+		"description": "Services and tooling",
+		"icon_url":    "https://go.dev/favicon.ico",
+	}, &created)
+	testutil.AssertEqual(t, http.StatusCreated, status)
+	testutil.AssertEqual(t, "Services and tooling", created.Description)
+	testutil.AssertEqual(t, "https://go.dev/favicon.ico", created.IconURL)
+
+	item := "/api/v1/technologies/" + created.ID
+
+	t.Run("get", func(t *testing.T) {
+		var tech domain.Technology
+		testutil.AssertEqual(t, http.StatusOK, send(t, r, http.MethodGet, item, nil, &tech))
+		testutil.AssertEqual(t, "Go", tech.Name)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		var problem Problem
+		status := send(t, r, http.MethodPost, "/api/v1/technologies",
+			map[string]any{"name": "Go", "category": "language", "level": "expert"}, &problem)
+		testutil.AssertEqual(t, http.StatusConflict, status)
+		testutil.AssertEqual(t, CodeConflict, problem.Code)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		var tech domain.Technology
+		testutil.AssertEqual(t, http.StatusOK, send(t, r, http.MethodPatch, item, map[string]any{"level": "advanced"}, &tech))
+		testutil.AssertEqual(t, domain.LevelAdvanced, tech.Level)
+
+		var problem Problem
+		testutil.AssertEqual(t, http.StatusBadRequest, send(t, r, http.MethodPatch, item, map[string]any{"level": "guru"}, &problem))
+		testutil.AssertEqual(t, "level", fieldsOf(problem))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		testutil.AssertEqual(t, http.StatusNoContent, send(t, r, http.MethodDelete, item, nil, nil))
+
+		var problem Problem
+		testutil.AssertEqual(t, http.StatusNotFound, send(t, r, http.MethodDelete, item, nil, &problem))
+		testutil.AssertEqual(t, CodeNotFound, problem.Code)
+	})
+
+	testutil.AssertLen(t, mustList(t, techRepo), 0)
+}
+
+func TestCreateTechnologyRejectsInvalidFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   map[string]any
+		fields string
+	}{
+		{"missing fields", map[string]any{"level": "expert"}, "name,category"},
+		{"invalid level", map[string]any{"name": "Go", "category": "language", "level": "guru"}, "level"},
+		{"short name", map[string]any{"name": "G", "category": "language", "level": "expert"}, "name"},
+		{
+			"long description",
+			map[string]any{"name": "Go", "category": "language", "level": "expert", "description": strings.Repeat("x", 2001)},
+			"description",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, techRepo := newContentRouter(t)
+
+			var problem Problem
+			testutil.AssertEqual(t, http.StatusBadRequest, send(t, r, http.MethodPost, "/api/v1/technologies", tt.body, &problem))
+			testutil.AssertEqual(t, CodeValidationFailed, problem.Code)
+			testutil.AssertEqual(t, tt.fields, fieldsOf(problem))
+
+			// A rejected request stores nothing, so it can be retried
+			testutil.AssertLen(t, mustList(t, techRepo), 0)
+		})
+	}
+}
+
+func TestCreateExperienceRejectsInvalidFields(t *testing.T) {
+	r, _ := newContentRouter(t)
+
+	var problem Problem
+	status := send(t, r, http.MethodPost, "/api/v1/experiences", map[string]any{
+		"company_name": "Acme",
+		"position":     "",
+		"start_date":   "2024-01-01T00:00:00Z",
+	}, &problem)
+	testutil.AssertEqual(t, http.StatusBadRequest, status)
+	testutil.AssertEqual(t, CodeValidationFailed, problem.Code)
+	testutil.AssertEqual(t, "position", fieldsOf(problem))
+}
+
+func TestTechnologiesHandlerPages(t *testing.T) {
+	r, _ := newContentRouter(t)
+
+	for _, name := range []string{"Go", "Rust", "Zig"} {
+		body := map[string]any{"name": name, "category": "language", "level": "expert"}
+		testutil.AssertEqual(t, http.StatusCreated, send(t, r, http.MethodPost, "/api/v1/technologies", body, nil))
+	}
+
+	var page ListResponse[*domain.Technology]
+	testutil.AssertEqual(t, http.StatusOK, send(t, r, http.MethodGet, "/api/v1/technologies?limit=2", nil, &page))
+	testutil.AssertEqual(t, 2, page.Count)
+	testutil.AssertEqual(t, 3, page.Total)
+	testutil.AssertTrue(t, page.NextCursor != "", "Expected a cursor to the next page")
+
+	var problem Problem
+	testutil.AssertEqual(t, http.StatusBadRequest, send(t, r, http.MethodGet, "/api/v1/technologies?limit=1000", nil, &problem))
+	testutil.AssertEqual(t, CodeValidationFailed, problem.Code)
+}
+
+func mustList(t *testing.T, repo *testutil.MockTechnologyRepository) []string {
+	t.Helper()
+
+	technologies, err := repo.List(context.Background(), repository.TechnologyFilter{})
+	testutil.AssertNoError(t, err)
+
+	names := make([]string, len(technologies))
+	for i, tech := range technologies {
+		names[i] = tech.Name
+	}
+
+	return names
+}
+
+func TestRejectUnknownParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.GET("/items", RejectUnknownParams(TechnologyListParams()...), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	testutil.AssertEqual(t, http.StatusOK, send(t, r, http.MethodGet, "/items?category=language&limit=2", nil, nil))
+
+	var problem Problem
+	testutil.AssertEqual(t, http.StatusBadRequest, send(t, r, http.MethodGet, "/items?limit=2&junk=1", nil, &problem))
+	testutil.AssertEqual(t, "junk", fieldsOf(problem))
+}
@@ -0,0 +1,17 @@
+package handler
+
+import "github.com/gin-gonic/gin"
+
+// RequireFeature returns middleware answering as if the route did not exist
+// while enabled reports false. It is asked on every request, so feature flags
+// take effect on configuration reload.
+func RequireFeature(enabled func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !enabled() {
+			RouteNotFoundHandler(c)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/graph"
+)
+
+// GraphQLHandlers serves the read-only GraphQL endpoint.
+type GraphQLHandlers struct {
+	server *graph.Server
+}
+
+// NewGraphQLHandlers creates a new GraphQL handlers instance.
+func NewGraphQLHandlers(server *graph.Server) *GraphQLHandlers {
+	return &GraphQLHandlers{server: server}
+}
+
+// Query handles GET and POST /graphql. POST takes a JSON body with query,
+// operationName and variables; GET takes the same as query parameters.
+// Requests rejected before execution answer 400, everything else 200 with
+// any resolver errors in the errors array.
+func (h *GraphQLHandlers) Query(c *gin.Context) {
+	var req graph.Request
+
+	if c.Request.Method == http.MethodGet {
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+
+		if raw := c.Query("variables"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &req.Variables); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"errors": []gin.H{{"message": "variables must be a JSON object"}}})
+				return
+			}
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": []gin.H{{"message": "request body must be a JSON object with a query"}}})
+		return
+	}
+
+	if req.Query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": []gin.H{{"message": "query is required"}}})
+		return
+	}
+
+	result, rejected := h.server.Execute(c.Request.Context(), req)
+	if rejected {
+		c.JSON(http.StatusBadRequest, result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
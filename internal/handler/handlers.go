@@ -5,11 +5,13 @@ package handler
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
+	"github.com/a-h/templ"
 	"github.com/gin-gonic/gin"
 	"holger-hahn-website/internal/constants"
 	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/seo"
 	"holger-hahn-website/internal/service"
 	"holger-hahn-website/templates"
 )
@@ -19,6 +21,9 @@ type PortfolioHandlers struct {
 	technologyService *service.TechnologyService
 	experienceService *service.ExperienceService
 	portfolioService  *service.PortfolioService
+	site              *seo.Site
+	patterns          *CommonHandlerPatterns
+	responses         *ResponseHandler
 }
 
 // NewPortfolioHandlers creates a new portfolio handlers instance.
@@ -26,11 +31,15 @@ func NewPortfolioHandlers(
 	technologyService *service.TechnologyService,
 	experienceService *service.ExperienceService,
 	portfolioService *service.PortfolioService,
+	site *seo.Site,
 ) *PortfolioHandlers {
 	return &PortfolioHandlers{
 		technologyService: technologyService,
 		experienceService: experienceService,
 		portfolioService:  portfolioService,
+		site:              site,
+		patterns:          NewCommonHandlerPatterns(),
+		responses:         NewResponseHandler(),
 	}
 }
 
@@ -42,7 +51,7 @@ func (h *PortfolioHandlers) HomeHandler(c *gin.Context) {
 	experiences, err := h.experienceService.GetCurrentExperiences(ctx)
 	if err != nil {
 		// Log error for debugging but continue with empty data
-		gin.DefaultWriter.Write([]byte(fmt.Sprintf("Warning: Failed to load experiences: %v\n", err)))
+		slog.WarnContext(ctx, "Failed to load experiences", "error", err)
 		experiences = []*domain.Experience{} // Fallback to empty slice
 	}
 
@@ -50,7 +59,7 @@ func (h *PortfolioHandlers) HomeHandler(c *gin.Context) {
 	services, err := h.portfolioService.GetActiveServices(ctx)
 	if err != nil {
 		// Log error for debugging but continue with empty data
-		gin.DefaultWriter.Write([]byte(fmt.Sprintf("Warning: Failed to load services: %v\n", err)))
+		slog.WarnContext(ctx, "Failed to load services", "error", err)
 		services = []*domain.Service{} // Fallback to empty slice
 	}
 
@@ -64,7 +73,7 @@ func (h *PortfolioHandlers) HomeHandler(c *gin.Context) {
 	technologies, err := h.technologyService.ListTechnologies(ctx, techFilter)
 	if err != nil {
 		// Log error for debugging but continue with empty data
-		gin.DefaultWriter.Write([]byte(fmt.Sprintf("Warning: Failed to load technologies: %v\n", err)))
+		slog.WarnContext(ctx, "Failed to load technologies", "error", err)
 		technologies = []*domain.Technology{} // Fallback to empty slice
 	}
 
@@ -84,75 +93,119 @@ func (h *PortfolioHandlers) HomeHandler(c *gin.Context) {
 		Technologies: expertTechnologies,
 	}
 
+	// Structured data covers past positions too, so it loads its own content
+	content, err := h.site.Load(ctx)
+	if err != nil {
+		// Log error for debugging but continue with the basic page metadata
+		slog.WarnContext(ctx, "Failed to load structured data", "error", err)
+		content = seo.Content{}
+	}
+
 	// Render template with dynamic data (even if some data failed to load)
-	component := templates.IndexWithData(experiences)
+	h.renderPage(c, templates.IndexWithData(experiences, h.site.HomeHead(content)))
+}
 
-	c.Header("Content-Type", "text/html")
+// ServicePageHandler renders the detail page of an active service at
+// GET /services/:file/. The parameter shares its name with the PDF route.
+func (h *PortfolioHandlers) ServicePageHandler(c *gin.Context) {
+	svc, err := h.portfolioService.GetService(c.Request.Context(), c.Param("file"))
+	if err == nil && !svc.IsActive {
+		err = domain.ErrNotFound("service")
+	}
 
-	if err := component.Render(c.Request.Context(), c.Writer); err != nil {
-		// Template rendering failure is a critical error that should return HTTP error
-		gin.DefaultWriter.Write([]byte(fmt.Sprintf("Error: Failed to render template: %v\n", err)))
-		c.JSON(constants.HTTPInternalServerError, gin.H{"error": domain.ErrRenderTemplate.Error()})
+	if err != nil {
+		h.responses.HandleError(c, err)
 		return
 	}
+
+	h.renderPage(c, templates.ServicePage(svc, h.site.ServiceHead(svc)))
 }
 
-// HealthHandler provides a health check endpoint.
-func (h *PortfolioHandlers) HealthHandler(c *gin.Context) {
-	c.JSON(constants.HTTPOKStatus, gin.H{
-		"status":  "healthy",
-		"version": constants.AppVersion,
-	})
+// ExperiencePageHandler renders the detail page of an experience.
+func (h *PortfolioHandlers) ExperiencePageHandler(c *gin.Context) {
+	exp, err := h.experienceService.GetExperience(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	h.renderPage(c, templates.ExperiencePage(exp, h.site.ExperienceHead(exp)))
 }
 
-// TechnologiesHandler returns JSON list of technologies.
-func (h *PortfolioHandlers) TechnologiesHandler(c *gin.Context) {
-	ctx := context.Background()
+// renderPage writes component as HTML.
+func (h *PortfolioHandlers) renderPage(c *gin.Context, component templ.Component) {
+	c.Header("Content-Type", "text/html")
 
-	category := c.Query("category")
-	level := c.Query("level")
+	if err := renderComponent(c, component); err != nil {
+		// Template rendering failure is a critical error that should return HTTP error
+		h.responses.HandleTemplateError(c, err)
+	}
+}
 
-	filter := service.TechnologyFilter{}
-	if category != "" {
-		filter.Category = &category
+// SitemapHandler serves sitemap.xml listing the public pages.
+func (h *PortfolioHandlers) SitemapHandler(c *gin.Context) {
+	content, err := h.site.Load(c.Request.Context())
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
 	}
-	// TODO: Implement level filtering when level != ""
-	// Currently level filtering is not implemented
-	_ = level // Acknowledge the parameter to avoid unused variable warning
 
-	technologies, err := h.technologyService.ListTechnologies(ctx, filter)
+	sitemap, err := seo.MarshalSitemap(h.site.Sitemap(content))
 	if err != nil {
-		c.JSON(constants.HTTPInternalServerError, gin.H{"error": domain.ErrLoadTechnologies.Error()})
+		h.responses.HandleError(c, domain.ErrInternal(err.Error()))
 		return
 	}
 
-	c.JSON(constants.HTTPOKStatus, technologies)
+	c.Data(constants.HTTPOKStatus, "application/xml; charset=utf-8", sitemap)
 }
 
-// ExperiencesHandler returns JSON list of experiences.
-func (h *PortfolioHandlers) ExperiencesHandler(c *gin.Context) {
-	ctx := context.Background()
+// RobotsHandler serves robots.txt.
+func (h *PortfolioHandlers) RobotsHandler(c *gin.Context) {
+	c.String(constants.HTTPOKStatus, h.site.RobotsTXT())
+}
 
-	experiences, err := h.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+// TechnologiesHandler returns a page of technologies filtered by category and level.
+func (h *PortfolioHandlers) TechnologiesHandler(c *gin.Context) {
+	filter, page, err := technologyFilterFromQuery(c)
 	if err != nil {
-		c.JSON(constants.HTTPInternalServerError, gin.H{"error": domain.ErrLoadExperiences.Error()})
+		h.responses.HandleError(c, err)
 		return
 	}
 
-	c.JSON(constants.HTTPOKStatus, experiences)
+	HandleListOperation(h.patterns, c, page.Offset, domain.ErrLoadTechnologies,
+		func(ctx context.Context) (*service.Page[*domain.Technology], error) {
+			return h.technologyService.QueryTechnologies(ctx, filter)
+		})
 }
 
-// ServicesHandler returns JSON list of services.
-func (h *PortfolioHandlers) ServicesHandler(c *gin.Context) {
-	ctx := context.Background()
+// ExperiencesHandler returns a page of experiences filtered by company, role,
+// technology, remote/current status and date range.
+func (h *PortfolioHandlers) ExperiencesHandler(c *gin.Context) {
+	filter, page, err := experienceFilterFromQuery(c)
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
 
-	services, err := h.portfolioService.ListServices(ctx, service.ServiceFilter{})
+	HandleListOperation(h.patterns, c, page.Offset, domain.ErrLoadExperiences,
+		func(ctx context.Context) (*service.Page[*domain.Experience], error) {
+			return h.experienceService.QueryExperiences(ctx, filter)
+		})
+}
+
+// ServicesHandler returns a page of services filtered by category, status,
+// technology and pricing.
+func (h *PortfolioHandlers) ServicesHandler(c *gin.Context) {
+	filter, page, err := serviceFilterFromQuery(c)
 	if err != nil {
-		c.JSON(constants.HTTPInternalServerError, gin.H{"error": domain.ErrLoadServices.Error()})
+		h.responses.HandleError(c, err)
 		return
 	}
 
-	c.JSON(constants.HTTPOKStatus, services)
+	HandleListOperation(h.patterns, c, page.Offset, domain.ErrLoadServices,
+		func(ctx context.Context) (*service.Page[*domain.Service], error) {
+			return h.portfolioService.QueryServices(ctx, filter)
+		})
 }
 
 // PortfolioData represents the data structure passed to templates.
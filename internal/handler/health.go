@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/health"
+)
+
+// HealthHandlers serves the probes the hosting platform uses to decide
+// whether to restart an instance and whether to route traffic to it.
+type HealthHandlers struct {
+	checker *health.Checker
+}
+
+// NewHealthHandlers creates a new health handlers instance.
+func NewHealthHandlers(checker *health.Checker) *HealthHandlers {
+	return &HealthHandlers{checker: checker}
+}
+
+// Live handles GET /healthz/live. It checks no dependencies: answering at all
+// shows the process is not stuck, and restarting it would not fix a broken
+// database.
+func (h *HealthHandlers) Live(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.JSON(constants.HTTPOKStatus, health.Report{
+		Status:  health.StatusHealthy,
+		Version: constants.AppVersion,
+		Checks:  []health.Result{},
+	})
+}
+
+// Ready handles GET /healthz/ready and GET /health with the report of every
+// dependency check. It answers 503 only when a critical check failed; a
+// degraded instance keeps serving pages.
+func (h *HealthHandlers) Ready(c *gin.Context) {
+	report := h.checker.Run(c.Request.Context())
+	report.Version = constants.AppVersion
+
+	status := constants.HTTPOKStatus
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(status, report)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/metrics"
+)
+
+// MetricsHandlers exposes the Prometheus metrics.
+type MetricsHandlers struct {
+	exposition   http.Handler
+	requireToken func() bool
+}
+
+// NewMetricsHandlers creates a new metrics handlers instance. While
+// requireToken reports true, scrapers must send a token with the metrics:read
+// scope; it is asked on every request so the setting can change at runtime.
+func NewMetricsHandlers(m *metrics.Metrics, requireToken func() bool) *MetricsHandlers {
+	return &MetricsHandlers{exposition: m.Handler(), requireToken: requireToken}
+}
+
+// Guard returns the middleware protecting GET /metrics.
+func (h *MetricsHandlers) Guard(auth TokenAuthenticator) gin.HandlerFunc {
+	requireScope := RequireScope(auth, domain.ScopeMetricsRead)
+
+	return func(c *gin.Context) {
+		if h.requireToken() {
+			requireScope(c)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Metrics handles GET /metrics.
+func (h *MetricsHandlers) Metrics(c *gin.Context) {
+	h.exposition.ServeHTTP(c.Writer, c.Request)
+}
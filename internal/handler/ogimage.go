@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/ogimage"
+)
+
+// OGImageHandlers serves the Open Graph preview cards.
+type OGImageHandlers struct {
+	generator *ogimage.Generator
+	responses *ResponseHandler
+}
+
+// NewOGImageHandlers creates a new Open Graph image handlers instance.
+func NewOGImageHandlers(generator *ogimage.Generator) *OGImageHandlers {
+	return &OGImageHandlers{
+		generator: generator,
+		responses: NewResponseHandler(),
+	}
+}
+
+// Image handles GET /og/:name.png. Like the service PDFs, the route captures
+// "<name>.png" and the suffix is checked here.
+func (h *OGImageHandlers) Image(c *gin.Context) {
+	name, ok := strings.CutSuffix(c.Param("file"), ".png")
+	if !ok || name == "" {
+		h.responses.HandleError(c, domain.ErrNotFound("image"))
+		return
+	}
+
+	img, err := h.generator.Image(c.Request.Context(), name)
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	serveRevision(c, name+".png", img.Revision, img.ModTime, img.Body)
+}
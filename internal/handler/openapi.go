@@ -0,0 +1,31 @@
+package handler
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/templates"
+)
+
+// openAPISpec is the hand-maintained OpenAPI 3.1 description of /api/v1.
+// Contract tests validate every handler response against it, so update it
+// together with any change to a route or response shape.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// OpenAPISpec returns the OpenAPI document served at /api/v1/openapi.json.
+func OpenAPISpec() []byte {
+	return openAPISpec
+}
+
+// OpenAPIHandler handles GET /api/v1/openapi.json.
+func (h *PortfolioHandlers) OpenAPIHandler(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", openAPISpec)
+}
+
+// APIDocsHandler handles GET /api/docs with a browsable rendering of the spec.
+func (h *PortfolioHandlers) APIDocsHandler(c *gin.Context) {
+	h.responses.RenderTemplate(c, templates.APIDocs("/api/v1/openapi.json"))
+}
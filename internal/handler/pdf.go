@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/pdf"
+	"holger-hahn-website/internal/seo"
+)
+
+// PDFHandlers serves the CV and service one-pagers as PDF downloads.
+type PDFHandlers struct {
+	generator *pdf.Generator
+	responses *ResponseHandler
+	enabled   func() bool
+}
+
+// NewPDFHandlers creates a new PDF handlers instance. While enabled reports
+// false the PDFs answer as if they did not exist; it is asked on every request
+// so the feature flag can change at runtime.
+func NewPDFHandlers(generator *pdf.Generator, enabled func() bool) *PDFHandlers {
+	return &PDFHandlers{
+		generator: generator,
+		responses: NewResponseHandler(),
+		enabled:   enabled,
+	}
+}
+
+// CV handles GET /cv.pdf.
+func (h *PDFHandlers) CV(c *gin.Context) {
+	if !h.enabled() {
+		RouteNotFoundHandler(c)
+		return
+	}
+
+	doc, err := h.generator.CV(c.Request.Context())
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	servePDF(c, "holger-hahn-cv.pdf", doc)
+}
+
+// Service handles GET /services/:id.pdf. Gin matches whole path segments, so
+// the route captures "<id>.pdf" and the suffix is checked here. Paths without
+// the suffix are redirected to the service's detail page, whether or not PDFs
+// are enabled.
+func (h *PDFHandlers) Service(c *gin.Context) {
+	file := c.Param("file")
+
+	id, ok := strings.CutSuffix(file, ".pdf")
+	if !ok {
+		c.Redirect(http.StatusMovedPermanently, seo.ServicePath(file))
+		return
+	}
+
+	if !h.enabled() {
+		RouteNotFoundHandler(c)
+		return
+	}
+
+	if id == "" {
+		h.responses.HandleError(c, domain.ErrNotFound("service"))
+		return
+	}
+
+	doc, err := h.generator.Service(c.Request.Context(), id)
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	servePDF(c, "holger-hahn-service-"+id+".pdf", doc)
+}
+
+// servePDF sends doc inline under filename.
+func servePDF(c *gin.Context, filename string, doc *pdf.Document) {
+	c.Header("Content-Disposition", `inline; filename="`+filename+`"`)
+
+	serveRevision(c, filename, doc.Revision, doc.ModTime, doc.Body)
+}
+
+// serveRevision sends body with its content revision as ETag. Clients
+// revalidate on every request and get 304 Not Modified until the content
+// changes. The content type is derived from the extension of name.
+func serveRevision(c *gin.Context, name, revision string, modTime time.Time, body []byte) {
+	c.Header("ETag", `"`+revision+`"`)
+	c.Header("Cache-Control", "public, no-cache")
+
+	http.ServeContent(c.Writer, c.Request, name, modTime, bytes.NewReader(body))
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/seo"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestPDFHandlers_Disabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := NewPDFHandlers(nil, func() bool { return false })
+
+	r := gin.New()
+	r.GET("/cv.pdf", h.CV)
+	r.GET("/services/:file", h.Service)
+
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+
+		return w
+	}
+
+	testutil.AssertEqual(t, http.StatusNotFound, get("/cv.pdf").Code)
+	testutil.AssertEqual(t, http.StatusNotFound, get("/services/consulting.pdf").Code)
+
+	// Service ids still lead to the detail page
+	w := get("/services/consulting")
+	testutil.AssertEqual(t, http.StatusMovedPermanently, w.Code)
+	testutil.AssertEqual(t, seo.ServicePath("consulting"), w.Header().Get("Location"))
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/logging"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details.
+const ProblemContentType = "application/problem+json"
+
+// problemTypeBase prefixes problem codes to form the type URI, which
+// resolves to the catalog entry describing the problem.
+const problemTypeBase = "/api/v1/problems/"
+
+// ProblemCode identifies a kind of problem. Codes are stable: clients may
+// branch on them, so they are never renamed or reused.
+type ProblemCode string
+
+// Problem codes of the error catalog.
+const (
+	CodeValidationFailed  ProblemCode = "validation_failed"
+	CodeInvalidBody       ProblemCode = "invalid_body"
+	CodeNotFound          ProblemCode = "not_found"
+	CodeRouteNotFound     ProblemCode = "route_not_found"
+	CodeConflict          ProblemCode = "conflict"
+	CodeTokenMissing      ProblemCode = "token_missing"
+	CodeTokenInvalid      ProblemCode = "token_invalid"
+	CodeTokenExpired      ProblemCode = "token_expired"
+	CodeTokenRevoked      ProblemCode = "token_revoked"
+	CodeInsufficientScope ProblemCode = "insufficient_scope"
+	CodeRenderFailed      ProblemCode = "render_failed"
+	CodeInternal          ProblemCode = "internal_error"
+)
+
+// ProblemType is an entry of the error catalog.
+type ProblemType struct {
+	Code        ProblemCode `json:"code"`
+	Title       string      `json:"title"`
+	Status      int         `json:"status"`
+	Description string      `json:"description"`
+}
+
+// problemCatalog lists every problem the API reports.
+var problemCatalog = []ProblemType{
+	{CodeValidationFailed, "Validation failed", http.StatusBadRequest,
+		"A parameter or body field is missing or invalid; details lists each offending field."},
+	{CodeInvalidBody, "Invalid request body", http.StatusBadRequest,
+		"The request body is not valid JSON or a field has the wrong type."},
+	{CodeNotFound, "Resource not found", http.StatusNotFound,
+		"The requested resource does not exist."},
+	{CodeRouteNotFound, "Route not found", http.StatusNotFound,
+		"No endpoint exists at the requested path."},
+	{CodeConflict, "Conflict", http.StatusConflict,
+		"A resource with the same name already exists."},
+	{CodeTokenMissing, "Token missing", http.StatusUnauthorized,
+		"The endpoint requires an Authorization: Bearer token."},
+	{CodeTokenInvalid, "Token invalid", http.StatusUnauthorized,
+		"The bearer token is unknown."},
+	{CodeTokenExpired, "Token expired", http.StatusUnauthorized,
+		"The bearer token has expired."},
+	{CodeTokenRevoked, "Token revoked", http.StatusUnauthorized,
+		"The bearer token has been revoked."},
+	{CodeInsufficientScope, "Insufficient scope", http.StatusForbidden,
+		"The bearer token lacks the scope the endpoint requires."},
+	{CodeRenderFailed, "Rendering failed", http.StatusInternalServerError,
+		"The page could not be rendered."},
+	{CodeInternal, "Internal server error", http.StatusInternalServerError,
+		"An unexpected error occurred; quote the request ID when reporting it."},
+}
+
+// problemTypes indexes the catalog by code.
+var problemTypes = func() map[ProblemCode]ProblemType {
+	types := make(map[ProblemCode]ProblemType, len(problemCatalog))
+	for _, problemType := range problemCatalog {
+		types[problemType.Code] = problemType
+	}
+
+	return types
+}()
+
+// Problem is an RFC 9457 problem details object, extended with the stable
+// code, the request ID and field-level validation details.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      ProblemCode  `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Details   []FieldError `json:"details,omitempty"`
+}
+
+// NewProblem creates the problem of the catalog entry code with detail
+// describing this occurrence.
+func NewProblem(code ProblemCode, detail string) *Problem {
+	problemType, ok := problemTypes[code]
+	if !ok {
+		problemType = problemTypes[CodeInternal]
+	}
+
+	return &Problem{
+		Type:   problemTypeBase + string(problemType.Code),
+		Title:  problemType.Title,
+		Status: problemType.Status,
+		Detail: detail,
+		Code:   problemType.Code,
+	}
+}
+
+// WithDetails adds field-level validation details to p.
+func (p *Problem) WithDetails(details ...FieldError) *Problem {
+	p.Details = append(p.Details, details...)
+	return p
+}
+
+// AbortWithProblem writes p as application/problem+json for the current
+// request and stops the handler chain.
+func AbortWithProblem(c *gin.Context, p *Problem) {
+	p.Instance = c.Request.URL.Path
+	p.RequestID = logging.RequestIDFromContext(c.Request.Context())
+
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}
+
+// tokenProblem returns the problem for a failed token authentication, or
+// false when err is not a token error.
+func tokenProblem(err error) (*Problem, bool) {
+	switch {
+	case errors.Is(err, domain.ErrTokenMissing):
+		return NewProblem(CodeTokenMissing, err.Error()), true
+	case errors.Is(err, domain.ErrTokenInvalid):
+		return NewProblem(CodeTokenInvalid, err.Error()), true
+	case errors.Is(err, domain.ErrTokenExpired):
+		return NewProblem(CodeTokenExpired, err.Error()), true
+	case errors.Is(err, domain.ErrTokenRevoked):
+		return NewProblem(CodeTokenRevoked, err.Error()), true
+	default:
+		return nil, false
+	}
+}
+
+// ProblemTypesHandler serves the error catalog.
+func ProblemTypesHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"data": problemCatalog})
+}
+
+// ProblemTypeHandler serves the catalog entry a problem type URI points to.
+func ProblemTypeHandler(c *gin.Context) {
+	problemType, ok := problemTypes[ProblemCode(c.Param("code"))]
+	if !ok {
+		AbortWithProblem(c, NewProblem(CodeNotFound, "problem type not found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, problemType)
+}
+
+// RouteNotFoundHandler answers requests that matched no route: with a problem
+// under /api/ and with a plain 404 for browsers everywhere else.
+func RouteNotFoundHandler(c *gin.Context) {
+	if !strings.HasPrefix(c.Request.URL.Path, "/api/") {
+		c.String(http.StatusNotFound, "404 page not found")
+		c.Abort()
+
+		return
+	}
+
+	AbortWithProblem(c, NewProblem(CodeRouteNotFound, "no endpoint matches the requested path"))
+}
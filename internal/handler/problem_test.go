@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/testutil"
+)
+
+// serveError answers a request with HandleError(err) and decodes the problem.
+func serveError(t *testing.T, err error) (*httptest.ResponseRecorder, Problem) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.GET("/", func(c *gin.Context) { NewResponseHandler().HandleError(c, err) })
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var problem Problem
+	testutil.AssertNoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+	return rec, problem
+}
+
+func TestHandleErrorWritesProblems(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   ProblemCode
+		detail string
+	}{
+		{"validation", domain.ErrInvalidField("level", "invalid technology level"), http.StatusBadRequest, CodeValidationFailed, "invalid technology level"},
+		{"not found", domain.ErrNotFound("technology"), http.StatusNotFound, CodeNotFound, "technology not found"},
+		{"conflict", domain.ErrConflict("technology already exists"), http.StatusConflict, CodeConflict, "technology already exists"},
+		{"internal", errors.New("database is locked"), http.StatusInternalServerError, CodeInternal, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, problem := serveError(t, tt.err)
+
+			testutil.AssertEqual(t, tt.status, rec.Code)
+			testutil.AssertEqual(t, ProblemContentType, rec.Header().Get("Content-Type"))
+			testutil.AssertEqual(t, tt.status, problem.Status)
+			testutil.AssertEqual(t, tt.code, problem.Code)
+			testutil.AssertEqual(t, problemTypeBase+string(tt.code), problem.Type)
+			testutil.AssertEqual(t, tt.detail, problem.Detail)
+			testutil.AssertEqual(t, "/", problem.Instance)
+		})
+	}
+}
+
+func TestHandleErrorReportsFieldDetails(t *testing.T) {
+	_, problem := serveError(t, domain.ErrInvalidField("level", "invalid technology level"))
+
+	testutil.AssertEqual(t, 1, len(problem.Details))
+	testutil.AssertEqual(t, FieldError{Field: "level", Code: fieldErrorInvalid, Message: "invalid technology level"}, problem.Details[0])
+}
+
+func TestProblemCatalogMatchesOpenAPI(t *testing.T) {
+	var spec struct {
+		Components struct {
+			Schemas struct {
+				ProblemCode struct {
+					Enum []ProblemCode `json:"enum"`
+				} `json:"ProblemCode"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	testutil.AssertNoError(t, json.Unmarshal(openAPISpec, &spec))
+
+	codes := make([]ProblemCode, len(problemCatalog))
+	for i, problemType := range problemCatalog {
+		codes[i] = problemType.Code
+	}
+
+	testutil.AssertTrue(t, slices.Equal(codes, spec.Components.Schemas.ProblemCode.Enum), "the OpenAPI ProblemCode enum lists the catalog in order")
+}
+
+func TestRouteNotFoundHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.GET("/admin", RequireFeature(func() bool { return false }), func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.NoRoute(RouteNotFoundHandler)
+
+	tests := []struct {
+		path        string
+		contentType string
+	}{
+		{"/api/v1/missing", ProblemContentType},
+		{"/missing/page/", "text/plain; charset=utf-8"},
+		{"/admin", "text/plain; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			testutil.AssertEqual(t, http.StatusNotFound, rec.Code)
+			testutil.AssertEqual(t, tt.contentType, rec.Header().Get("Content-Type"))
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+)
+
+// Query parameters shared by every list endpoint.
+const (
+	limitParam  = "limit"
+	offsetParam = "offset"
+	cursorParam = "cursor"
+)
+
+// Filter parameters of each portfolio list endpoint, besides paging and ordering.
+var (
+	technologyFilters = []string{"category", "level"}
+	experienceFilters = []string{
+		"company_name", "position", "location", "technology", "is_current", "is_remote", "start_after", "end_before",
+	}
+	serviceFilters = []string{"category", "is_active", "technology", "pricing_type", "min_price", "max_price"}
+)
+
+// TechnologyListParams names every query parameter GET /api/v1/technologies reads.
+func TechnologyListParams() []string { return listParams(technologyFilters) }
+
+// ExperienceListParams names every query parameter GET /api/v1/experiences reads.
+func ExperienceListParams() []string { return listParams(experienceFilters) }
+
+// ServiceListParams names every query parameter GET /api/v1/services reads.
+func ServiceListParams() []string { return listParams(serviceFilters) }
+
+func listParams(filters []string) []string {
+	return append(slices.Clone(filters), "order_by", "order_dir", limitParam, offsetParam, cursorParam)
+}
+
+// PageRequest is the window requested by a list call.
+type PageRequest struct {
+	Limit  int
+	Offset int
+}
+
+// ParsePageRequest reads limit and cursor (or offset) from the query string.
+// A cursor is only accepted for the same path and filters it was issued for.
+func ParsePageRequest(c *gin.Context, defaultLimit, maxLimit int) (PageRequest, error) {
+	page := PageRequest{Limit: defaultLimit}
+
+	if raw, ok := c.GetQuery(limitParam); ok {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return page, domain.ErrInvalidField(limitParam, fmt.Sprintf("limit must be an integer between 1 and %d", maxLimit))
+		}
+
+		page.Limit = limit
+	}
+
+	cursor, hasCursor := c.GetQuery(cursorParam)
+	rawOffset, hasOffset := c.GetQuery(offsetParam)
+
+	switch {
+	case hasCursor && hasOffset:
+		return page, domain.ErrInvalidField(cursorParam, "cursor and offset cannot be combined")
+	case hasCursor:
+		offset, err := decodeCursor(cursor, querySignature(c))
+		if err != nil {
+			return page, err
+		}
+
+		page.Offset = offset
+	case hasOffset:
+		offset, err := strconv.Atoi(rawOffset)
+		if err != nil || offset < 0 {
+			return page, domain.ErrInvalidField(offsetParam, "offset must be a non-negative integer")
+		}
+
+		page.Offset = offset
+	}
+
+	return page, nil
+}
+
+// NewListResponse wraps one page of results, adding a cursor when more remain.
+func NewListResponse[T any](c *gin.Context, data []T, offset, total int) ListResponse[T] {
+	response := ListResponse[T]{
+		Data:  data,
+		Count: len(data),
+		Total: total,
+	}
+
+	if next := offset + len(data); len(data) > 0 && next < total {
+		response.NextCursor = encodeCursor(next, querySignature(c))
+	}
+
+	return response
+}
+
+// querySignature fingerprints the path and filter parameters of a list request,
+// ignoring the paging parameters themselves.
+func querySignature(c *gin.Context) string {
+	values := url.Values{}
+
+	for key, vals := range c.Request.URL.Query() {
+		if key != limitParam && key != offsetParam && key != cursorParam {
+			values[key] = vals
+		}
+	}
+
+	sum := sha256.Sum256([]byte(c.Request.URL.Path + "?" + values.Encode()))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// encodeCursor builds the opaque token pointing at offset.
+func encodeCursor(offset int, signature string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + "." + signature))
+}
+
+// decodeCursor recovers the offset from a token issued by encodeCursor.
+func decodeCursor(token, signature string) (int, error) {
+	invalid := domain.ErrInvalidField(cursorParam, "cursor is malformed or was issued for a different query")
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalid
+	}
+
+	offsetPart, sig, found := strings.Cut(string(raw), ".")
+	if !found || sig != signature {
+		return 0, invalid
+	}
+
+	offset, err := strconv.Atoi(offsetPart)
+	if err != nil || offset < 0 {
+		return 0, invalid
+	}
+
+	return offset, nil
+}
+
+// rejectUnknownParams fails on query parameters the endpoint does not understand,
+// so that a misspelt filter is not silently ignored.
+func rejectUnknownParams(c *gin.Context, known ...string) error {
+	keys := slices.Sorted(maps.Keys(c.Request.URL.Query()))
+
+	for _, key := range keys {
+		if key == limitParam || key == offsetParam || key == cursorParam || slices.Contains(known, key) {
+			continue
+		}
+
+		return domain.ErrInvalidField(key, fmt.Sprintf("unknown query parameter %q", key))
+	}
+
+	return nil
+}
+
+// RejectUnknownParams returns middleware answering 400 for query parameters
+// outside known. It goes before a page cache that keys on known alone, which
+// would otherwise answer with the page for the remaining parameters.
+func RejectUnknownParams(known ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := rejectUnknownParams(c, known...); err != nil {
+			NewResponseHandler().HandleError(c, err)
+		}
+	}
+}
+
+// queryString returns the trimmed value of name, or nil when it is absent or blank.
+func queryString(c *gin.Context, name string) *string {
+	value := strings.TrimSpace(c.Query(name))
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+// queryBool parses name as a boolean.
+func queryBool(c *gin.Context, name string) (*bool, error) {
+	raw := queryString(c, name)
+	if raw == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(*raw)
+	if err != nil {
+		return nil, domain.ErrInvalidField(name, name+" must be true or false")
+	}
+
+	return &value, nil
+}
+
+// queryFloat parses name as a non-negative number.
+func queryFloat(c *gin.Context, name string) (*float64, error) {
+	raw := queryString(c, name)
+	if raw == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(*raw, 64)
+	if err != nil || value < 0 {
+		return nil, domain.ErrInvalidField(name, name+" must be a non-negative number")
+	}
+
+	return &value, nil
+}
+
+// queryDate parses name as a YYYY-MM-DD date.
+func queryDate(c *gin.Context, name string) (*time.Time, error) {
+	raw := queryString(c, name)
+	if raw == nil {
+		return nil, nil
+	}
+
+	value, err := time.Parse(time.DateOnly, *raw)
+	if err != nil {
+		return nil, domain.ErrInvalidField(name, name+" must be a date in YYYY-MM-DD format")
+	}
+
+	return &value, nil
+}
+
+// queryOrdering reads order_by and order_dir, checking them against the fields
+// the entity type can be ordered by.
+func queryOrdering(c *gin.Context, entityType string) (orderBy, orderDir *string, err error) {
+	orderBy = queryString(c, "order_by")
+	if orderBy != nil && !service.ValidateOrderBy(entityType, *orderBy) {
+		return nil, nil, domain.ErrInvalidField("order_by",
+			"order_by must be one of "+strings.Join(service.OrderFields(entityType), ", "))
+	}
+
+	orderDir = queryString(c, "order_dir")
+	if orderDir != nil && *orderDir != "asc" && *orderDir != "desc" {
+		return nil, nil, domain.ErrInvalidField("order_dir", "order_dir must be asc or desc")
+	}
+
+	return orderBy, orderDir, nil
+}
+
+// technologyFilterFromQuery builds the technology filter for GET /api/v1/technologies.
+func technologyFilterFromQuery(c *gin.Context) (service.TechnologyFilter, PageRequest, error) {
+	var filter service.TechnologyFilter
+
+	page, err := listBasics(c, "technology", &filter.OrderBy, &filter.OrderDir, technologyFilters...)
+	if err != nil {
+		return filter, page, err
+	}
+
+	filter.Limit, filter.Offset = &page.Limit, &page.Offset
+	filter.Category = queryString(c, "category")
+
+	if raw := queryString(c, "level"); raw != nil {
+		level := domain.Level(*raw)
+		if !level.IsValid() {
+			return filter, page, domain.ErrInvalidField("level", "level must be one of "+joinValues(domain.AllLevels()))
+		}
+
+		filter.Level = &level
+	}
+
+	return filter, page, nil
+}
+
+// experienceFilterFromQuery builds the experience filter for GET /api/v1/experiences.
+func experienceFilterFromQuery(c *gin.Context) (service.ExperienceFilter, PageRequest, error) {
+	var filter service.ExperienceFilter
+
+	page, err := listBasics(c, "experience", &filter.OrderBy, &filter.OrderDir, experienceFilters...)
+	if err != nil {
+		return filter, page, err
+	}
+
+	filter.Limit, filter.Offset = &page.Limit, &page.Offset
+	filter.CompanyName = queryString(c, "company_name")
+	filter.Position = queryString(c, "position")
+	filter.Location = queryString(c, "location")
+	filter.Technology = queryString(c, "technology")
+
+	if filter.IsCurrent, err = queryBool(c, "is_current"); err != nil {
+		return filter, page, err
+	}
+
+	if filter.IsRemote, err = queryBool(c, "is_remote"); err != nil {
+		return filter, page, err
+	}
+
+	if filter.StartAfter, err = queryDate(c, "start_after"); err != nil {
+		return filter, page, err
+	}
+
+	if filter.EndBefore, err = queryDate(c, "end_before"); err != nil {
+		return filter, page, err
+	}
+
+	return filter, page, nil
+}
+
+// serviceFilterFromQuery builds the service filter for GET /api/v1/services.
+func serviceFilterFromQuery(c *gin.Context) (service.ServiceFilter, PageRequest, error) {
+	var filter service.ServiceFilter
+
+	page, err := listBasics(c, "service", &filter.OrderBy, &filter.OrderDir, serviceFilters...)
+	if err != nil {
+		return filter, page, err
+	}
+
+	filter.Limit, filter.Offset = &page.Limit, &page.Offset
+	filter.Technology = queryString(c, "technology")
+
+	if raw := queryString(c, "category"); raw != nil {
+		category := domain.ServiceType(*raw)
+		if !category.IsValid() {
+			return filter, page, domain.ErrInvalidField("category", "category must be one of "+joinValues(domain.AllServiceTypes()))
+		}
+
+		filter.Category = &category
+	}
+
+	if raw := queryString(c, "pricing_type"); raw != nil {
+		pricingType := domain.PricingType(*raw)
+		if !pricingType.IsValid() {
+			return filter, page, domain.ErrInvalidField("pricing_type", "pricing_type must be one of "+joinValues(domain.AllPricingTypes()))
+		}
+
+		filter.PricingType = &pricingType
+	}
+
+	if filter.IsActive, err = queryBool(c, "is_active"); err != nil {
+		return filter, page, err
+	}
+
+	if filter.MinPrice, err = queryFloat(c, "min_price"); err != nil {
+		return filter, page, err
+	}
+
+	if filter.MaxPrice, err = queryFloat(c, "max_price"); err != nil {
+		return filter, page, err
+	}
+
+	return filter, page, nil
+}
+
+// listBasics validates the parameter names and reads paging and ordering,
+// which every portfolio list endpoint shares.
+func listBasics(c *gin.Context, entityType string, orderBy, orderDir **string, filters ...string) (PageRequest, error) {
+	if err := rejectUnknownParams(c, append(slices.Clone(filters), "order_by", "order_dir")...); err != nil {
+		return PageRequest{}, err
+	}
+
+	page, err := ParsePageRequest(c, constants.DefaultPageSize, constants.MaxPageSize)
+	if err != nil {
+		return page, err
+	}
+
+	*orderBy, *orderDir, err = queryOrdering(c, entityType)
+
+	return page, err
+}
+
+// joinValues renders a list of enum values for an error message.
+func joinValues[T ~string](values []T) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = string(v)
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -2,12 +2,22 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/a-h/templ"
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 	"holger-hahn-website/internal/constants"
 	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/metrics"
+	"holger-hahn-website/internal/service"
 )
 
 // ResponseHandler provides standardized response handling for HTTP handlers.
@@ -18,20 +28,87 @@ func NewResponseHandler() *ResponseHandler {
 	return &ResponseHandler{}
 }
 
-// HandleError sends a standardized error response.
+// FieldError describes a validation failure for a single request field. Code
+// names the rule that failed, such as required, min or email, and is
+// "invalid" for rules checked by the domain model.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// fieldErrorInvalid is the code of field errors reported by the domain model.
+const fieldErrorInvalid = "invalid"
+
+// HandleError sends the problem matching err. Unexpected errors are logged
+// with the request's context and reported without their message.
 func (r *ResponseHandler) HandleError(c *gin.Context, err error) {
+	domErr, isDomainErr := domain.AsDomainError(err)
+
 	switch {
 	case domain.IsValidationError(err):
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		problem := NewProblem(CodeValidationFailed, domErr.Message)
+		if domErr.Field != "" {
+			problem.WithDetails(FieldError{Field: domErr.Field, Code: fieldErrorCode(domErr), Message: domErr.Message})
+		}
+
+		AbortWithProblem(c, problem)
 	case domain.IsNotFoundError(err):
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		AbortWithProblem(c, NewProblem(CodeNotFound, domErr.Message))
 	case domain.IsConflictError(err):
-		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		AbortWithProblem(c, NewProblem(CodeConflict, domErr.Message))
 	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		attrs := []any{"error", err}
+		if isDomainErr {
+			attrs = append(attrs, "type", domErr.Type)
+		}
+
+		slog.ErrorContext(c.Request.Context(), "Request failed", attrs...)
+		AbortWithProblem(c, NewProblem(CodeInternal, ""))
 	}
 }
 
+// fieldErrorCode returns the code of a domain field error.
+func fieldErrorCode(domErr *domain.DomainError) string {
+	if domErr.Code != "" {
+		return domErr.Code
+	}
+
+	return fieldErrorInvalid
+}
+
+// HandleBindError sends a 400 response for a request body that failed to bind,
+// listing every field that failed its binding rules.
+func (r *ResponseHandler) HandleBindError(c *gin.Context, err error) {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		AbortWithProblem(c, NewProblem(CodeInvalidBody, "request body field has the wrong type").WithDetails(FieldError{
+			Field:   typeErr.Field,
+			Code:    "type",
+			Message: fmt.Sprintf("%s must be a %s", typeErr.Field, typeErr.Type.Kind()),
+		}))
+
+		return
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		AbortWithProblem(c, NewProblem(CodeInvalidBody, "request body could not be parsed"))
+		return
+	}
+
+	details := make([]FieldError, len(validationErrs))
+	for i, fieldErr := range validationErrs {
+		details[i] = FieldError{
+			Field:   jsonFieldName(fieldErr),
+			Code:    fieldErr.Tag(),
+			Message: bindingMessage(fieldErr),
+		}
+	}
+
+	AbortWithProblem(c, NewProblem(CodeValidationFailed, "request validation failed").WithDetails(details...))
+}
+
 // HandleSuccess sends a standardized success response with data.
 func (r *ResponseHandler) HandleSuccess(c *gin.Context, data interface{}) {
 	c.JSON(http.StatusOK, data)
@@ -47,21 +124,32 @@ func (r *ResponseHandler) HandleNoContent(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
-// HandleTemplateError sends a standardized template error response.
+// HandleTemplateError logs a failed render and sends the matching problem.
 func (r *ResponseHandler) HandleTemplateError(c *gin.Context, err error) {
-	c.JSON(http.StatusInternalServerError, gin.H{"error": domain.ErrRenderTemplate.Error()})
+	slog.ErrorContext(c.Request.Context(), "Failed to render template", "error", err)
+	AbortWithProblem(c, NewProblem(CodeRenderFailed, domain.ErrRenderTemplate.Error()))
 }
 
 // RenderTemplate renders a templ component and handles errors.
 func (r *ResponseHandler) RenderTemplate(c *gin.Context, component templ.Component) {
 	c.Header("Content-Type", "text/html")
 
-	if err := component.Render(c.Request.Context(), c.Writer); err != nil {
+	if err := renderComponent(c, component); err != nil {
 		r.HandleTemplateError(c, err)
 		return
 	}
 }
 
+// renderComponent writes component to the response and records how long
+// rendering took.
+func renderComponent(c *gin.Context, component templ.Component) error {
+	start := time.Now()
+	err := component.Render(c.Request.Context(), c.Writer)
+	metrics.ObserveRender(c.Request.Context(), c.FullPath(), time.Since(start))
+
+	return err
+}
+
 // HealthResponse represents a health check response.
 type HealthResponse struct {
 	Status  string `json:"status"`
@@ -77,9 +165,13 @@ func (r *ResponseHandler) HandleHealthCheck(c *gin.Context) {
 }
 
 // ListResponse provides standardized list response handling.
+// Count is the size of this page, Total the number of matches across all pages,
+// and NextCursor the token for the following page when one exists.
 type ListResponse[T any] struct {
-	Data  []T `json:"data"`
-	Count int `json:"count"`
+	Data       []T    `json:"data"`
+	Count      int    `json:"count"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // HandleListSuccess sends a standardized list response with metadata.
@@ -87,32 +179,32 @@ func HandleListSuccess[T any](c *gin.Context, handler *ResponseHandler, data []T
 	response := ListResponse[T]{
 		Data:  data,
 		Count: len(data),
+		Total: len(data),
 	}
 	handler.HandleSuccess(c, response)
 }
 
 // ServiceOperationContext provides context for service operations.
 type ServiceOperationContext struct {
-	Context context.Context
 	Handler *ResponseHandler
 }
 
 // NewServiceOperationContext creates a new service operation context.
 func NewServiceOperationContext() *ServiceOperationContext {
 	return &ServiceOperationContext{
-		Context: context.Background(),
 		Handler: NewResponseHandler(),
 	}
 }
 
 // ExecuteServiceOperation executes a service operation with standardized error handling.
+// The operation runs with the request context so cancellation reaches the service layer.
 func ExecuteServiceOperation[T any](
 	s *ServiceOperationContext,
 	c *gin.Context,
 	operation func(context.Context) (T, error),
 	successHandler func(*gin.Context, T),
 ) {
-	result, err := operation(s.Context)
+	result, err := operation(c.Request.Context())
 	if err != nil {
 		s.Handler.HandleError(c, err)
 		return
@@ -135,20 +227,30 @@ func NewCommonHandlerPatterns() *CommonHandlerPatterns {
 	}
 }
 
-// HandleListOperation executes a list operation with standardized response.
+// HandleListOperation executes a paged list operation and sends the page with
+// the total number of matches and the cursor of the following page. Invalid
+// list parameters are reported as such and any other failure as loadErr.
 func HandleListOperation[T any](
 	c *CommonHandlerPatterns,
 	ginCtx *gin.Context,
-	operation func(context.Context) ([]T, error),
+	offset int,
+	loadErr error,
+	operation func(context.Context) (*service.Page[T], error),
 ) {
-	ExecuteServiceOperation(
-		c.serviceContext,
-		ginCtx,
-		operation,
-		func(ctx *gin.Context, data []T) {
-			HandleListSuccess(ctx, c.responseHandler, data)
-		},
-	)
+	page, err := operation(ginCtx.Request.Context())
+	if err != nil {
+		if domain.IsValidationError(err) {
+			c.responseHandler.HandleError(ginCtx, err)
+			return
+		}
+
+		slog.ErrorContext(ginCtx.Request.Context(), "Request failed", "error", err)
+		AbortWithProblem(ginCtx, NewProblem(CodeInternal, loadErr.Error()))
+
+		return
+	}
+
+	c.responseHandler.HandleSuccess(ginCtx, NewListResponse(ginCtx, page.Items, offset, page.Total))
 }
 
 // HandleSingleOperation executes a single item operation with standardized response.
@@ -188,7 +290,7 @@ func (c *CommonHandlerPatterns) HandleDeleteOperation(
 	ginCtx *gin.Context,
 	operation func(context.Context) error,
 ) {
-	err := operation(c.serviceContext.Context)
+	err := operation(ginCtx.Request.Context())
 	if err != nil {
 		c.responseHandler.HandleError(ginCtx, err)
 		return
@@ -196,3 +298,43 @@ func (c *CommonHandlerPatterns) HandleDeleteOperation(
 
 	c.responseHandler.HandleNoContent(ginCtx)
 }
+
+// jsonFieldName converts a struct field name such as CompanyName into the
+// snake_case JSON name clients send.
+func jsonFieldName(fieldErr validator.FieldError) string {
+	name := fieldErr.Field()
+
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(rune(name[i-1])) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// bindingMessage turns a binding rule failure into a human readable message.
+func bindingMessage(fieldErr validator.FieldError) string {
+	field := jsonFieldName(fieldErr)
+
+	switch fieldErr.Tag() {
+	case "required":
+		return field + " is required"
+	case "min":
+		return fmt.Sprintf("%s must be at least %s characters long", field, fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("%s must be at most %s characters long", field, fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", field, fieldErr.Param())
+	case "email":
+		return field + " must be a valid email address"
+	default:
+		return fmt.Sprintf("%s failed %s validation", field, fieldErr.Tag())
+	}
+}
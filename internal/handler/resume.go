@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/resume"
+)
+
+// ResumeHandlers serves the portfolio as a downloadable CV.
+type ResumeHandlers struct {
+	generator *resume.Generator
+	responses *ResponseHandler
+}
+
+// NewResumeHandlers creates a new resume handlers instance.
+func NewResumeHandlers(generator *resume.Generator) *ResumeHandlers {
+	return &ResumeHandlers{
+		generator: generator,
+		responses: NewResponseHandler(),
+	}
+}
+
+// JSONResume handles GET /api/v1/resume.json in the jsonresume.org schema.
+func (h *ResumeHandlers) JSONResume(c *gin.Context) {
+	cv, ok := h.load(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, resume.NewJSONResume(cv))
+}
+
+// Europass handles GET /api/v1/resume.xml with the Europass XML CV.
+func (h *ResumeHandlers) Europass(c *gin.Context) {
+	cv, ok := h.load(c)
+	if !ok {
+		return
+	}
+
+	body, err := resume.MarshalEuropass(resume.NewEuropass(cv))
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", body)
+}
+
+// load reads the CV and sets Last-Modified, reporting whether to continue.
+func (h *ResumeHandlers) load(c *gin.Context) (*resume.CV, bool) {
+	cv, err := h.generator.Load(c.Request.Context())
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return nil, false
+	}
+
+	if !cv.LastModified.IsZero() {
+		c.Header("Last-Modified", cv.LastModified.Format(http.TimeFormat))
+	}
+
+	return cv, true
+}
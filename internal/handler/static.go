@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/assets"
+)
+
+// StaticHandlers serves the static assets and lets pages link to them under
+// their fingerprinted names.
+type StaticHandlers struct {
+	server *assets.Server
+}
+
+// NewStaticHandlers creates a new static asset handlers instance.
+func NewStaticHandlers(server *assets.Server) *StaticHandlers {
+	return &StaticHandlers{server: server}
+}
+
+// File handles GET /static/*filepath.
+func (h *StaticHandlers) File(c *gin.Context) {
+	h.server.ServeHTTP(c.Writer, c.Request)
+}
+
+// Manifest is middleware that puts the asset manifest into the request
+// context, where templates resolve asset URLs.
+func (h *StaticHandlers) Manifest() gin.HandlerFunc {
+	manifest := h.server.Manifest()
+
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(assets.WithManifest(c.Request.Context(), manifest))
+		c.Next()
+	}
+}
@@ -0,0 +1,149 @@
+// Package health checks the dependencies the website relies on and combines
+// the results into one report. Critical checks decide whether an instance can
+// take traffic at all; the others only mark it degraded, so that pages keep
+// being served while, for example, the mail server is unreachable.
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Status is the state of a single check or of the whole report.
+type Status string
+
+// Possible statuses, from best to worst.
+const (
+	StatusHealthy   Status = "healthy"
+	StatusDegraded  Status = "degraded"
+	StatusUnhealthy Status = "unhealthy"
+)
+
+// ErrTimeout is reported for checks that did not finish in time.
+var ErrTimeout = errors.New("check timed out")
+
+// CheckFunc checks one dependency, returning an error when it is unavailable.
+// It matches the HealthCheck methods of repositories and services.
+type CheckFunc func(ctx context.Context) error
+
+// Result is the outcome of one check.
+type Result struct {
+	Name      string  `json:"name"`
+	Status    Status  `json:"status"`
+	Error     string  `json:"error,omitempty"`
+	LatencyMS float64 `json:"latency_ms"`
+	Critical  bool    `json:"critical"`
+}
+
+// Report combines the results of all checks.
+type Report struct {
+	Status  Status   `json:"status"`
+	Version string   `json:"version,omitempty"`
+	Checks  []Result `json:"checks"`
+}
+
+// Ready reports whether the instance should receive traffic, which it should
+// unless a critical check failed.
+func (r Report) Ready() bool {
+	return r.Status != StatusUnhealthy
+}
+
+// check is a registered CheckFunc.
+type check struct {
+	fn       CheckFunc
+	name     string
+	critical bool
+}
+
+// Checker runs the registered checks, each within its own timeout.
+type Checker struct {
+	checks  []check
+	timeout time.Duration
+}
+
+// New creates a checker that gives every check up to timeout.
+func New(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// AddCritical registers a check without which the instance cannot serve.
+func (c *Checker) AddCritical(name string, fn CheckFunc) {
+	c.checks = append(c.checks, check{name: name, fn: fn, critical: true})
+}
+
+// Add registers a check whose failure only degrades the instance.
+func (c *Checker) Add(name string, fn CheckFunc) {
+	c.checks = append(c.checks, check{name: name, fn: fn})
+}
+
+// Run runs all checks concurrently and reports their results in registration
+// order.
+func (c *Checker) Run(ctx context.Context) Report {
+	report := Report{Status: StatusHealthy, Checks: make([]Result, len(c.checks))}
+
+	var wg sync.WaitGroup
+
+	for i, chk := range c.checks {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			report.Checks[i] = c.run(ctx, chk)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status == StatusHealthy {
+			continue
+		}
+
+		if result.Critical {
+			report.Status = StatusUnhealthy
+		} else if report.Status == StatusHealthy {
+			report.Status = StatusDegraded
+		}
+	}
+
+	return report
+}
+
+// run runs one check, giving up after the checker's timeout even when the
+// check ignores its context.
+func (c *Checker) run(ctx context.Context, chk check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+
+	go func() {
+		done <- chk.fn(ctx)
+	}()
+
+	var err error
+
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ErrTimeout
+	}
+
+	result := Result{
+		Name:      chk.name,
+		Status:    StatusHealthy,
+		LatencyMS: float64(time.Since(start)) / float64(time.Millisecond),
+		Critical:  chk.critical,
+	}
+
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+func ok(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestRunCombinesResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		critical CheckFunc
+		optional CheckFunc
+		want     Status
+	}{
+		{name: "all healthy", critical: ok, optional: ok, want: StatusHealthy},
+		{name: "optional failing", critical: ok, optional: failing, want: StatusDegraded},
+		{name: "critical failing", critical: failing, optional: ok, want: StatusUnhealthy},
+		{name: "both failing", critical: failing, optional: failing, want: StatusUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(time.Second)
+			c.AddCritical("database", tt.critical)
+			c.Add("smtp", tt.optional)
+
+			report := c.Run(context.Background())
+			testutil.AssertEqual(t, tt.want, report.Status)
+			testutil.AssertEqual(t, tt.want != StatusUnhealthy, report.Ready())
+			testutil.AssertEqual(t, 2, len(report.Checks))
+			testutil.AssertEqual(t, "database", report.Checks[0].Name)
+			testutil.AssertTrue(t, report.Checks[0].Critical, "database is critical")
+			testutil.AssertEqual(t, "smtp", report.Checks[1].Name)
+		})
+	}
+}
+
+func TestRunTimesOutSlowChecks(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	c := New(20 * time.Millisecond)
+	c.AddCritical("database", func(context.Context) error {
+		// Ignores its context, like a driver stuck in a system call.
+		<-release
+		return nil
+	})
+
+	start := time.Now()
+	report := c.Run(context.Background())
+
+	testutil.AssertTrue(t, time.Since(start) < time.Second, "run returns at the timeout")
+	testutil.AssertEqual(t, StatusUnhealthy, report.Status)
+	testutil.AssertEqual(t, ErrTimeout.Error(), report.Checks[0].Error)
+	testutil.AssertTrue(t, report.Checks[0].LatencyMS >= 20, "latency covers the wait")
+}
@@ -0,0 +1,176 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// Email queue errors.
+var (
+	ErrEmailQueueStopped = errors.New("email queue stopped")
+	ErrEmailQueueFull    = errors.New("email queue full")
+)
+
+// queuedEmail is one email of a job.
+type queuedEmail struct {
+	send func(ctx context.Context, contact *domain.Contact) error
+	kind string
+}
+
+// emailJob is the emails about one contact waiting to be sent. done, if set,
+// is called with their errors once all were attempted.
+type emailJob struct {
+	ctx     context.Context
+	contact *domain.Contact
+	done    func(error)
+	emails  []queuedEmail
+}
+
+// EmailQueue implements EmailService by handing emails to a background worker,
+// so that contact form requests do not wait for the mail server. Stop sends
+// the emails still queued before the process exits.
+type EmailQueue struct {
+	next    domain.EmailService
+	logger  domain.LoggingService
+	metrics domain.MetricsService
+	jobs    chan emailJob
+	done    chan struct{}
+	mu      sync.RWMutex
+	stopped bool
+}
+
+// NewEmailQueue starts a worker sending through next, buffering up to size
+// jobs.
+func NewEmailQueue(next domain.EmailService, logger domain.LoggingService, metrics domain.MetricsService, size int) *EmailQueue {
+	q := &EmailQueue{
+		next:    next,
+		logger:  logger,
+		metrics: metrics,
+		jobs:    make(chan emailJob, size),
+		done:    make(chan struct{}),
+	}
+
+	go q.work()
+
+	return q
+}
+
+// SendContactNotification queues the notification about a new contact.
+func (q *EmailQueue) SendContactNotification(ctx context.Context, contact *domain.Contact) error {
+	return q.enqueue(ctx, emailJob{contact: contact, emails: []queuedEmail{q.notification()}})
+}
+
+// SendConfirmationEmail queues the confirmation to the contact.
+func (q *EmailQueue) SendConfirmationEmail(ctx context.Context, contact *domain.Contact) error {
+	return q.enqueue(ctx, emailJob{contact: contact, emails: []queuedEmail{q.confirmation()}})
+}
+
+// SendContactEmails queues the notification and the confirmation about a new
+// contact as one job and reports their errors to done once both were tried.
+func (q *EmailQueue) SendContactEmails(ctx context.Context, contact *domain.Contact, done func(error)) error {
+	return q.enqueue(ctx, emailJob{
+		contact: contact,
+		done:    done,
+		emails:  []queuedEmail{q.notification(), q.confirmation()},
+	})
+}
+
+func (q *EmailQueue) notification() queuedEmail {
+	return queuedEmail{send: q.next.SendContactNotification, kind: "notification"}
+}
+
+func (q *EmailQueue) confirmation() queuedEmail {
+	return queuedEmail{send: q.next.SendConfirmationEmail, kind: "confirmation"}
+}
+
+// enqueue waits for room in the queue unless ctx ends first. The emails keep
+// the values of ctx but not its cancellation, which ends with the request.
+func (q *EmailQueue) enqueue(ctx context.Context, job emailJob) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.stopped {
+		return ErrEmailQueueStopped
+	}
+
+	job.ctx = context.WithoutCancel(ctx)
+
+	select {
+	case q.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *EmailQueue) work() {
+	defer close(q.done)
+
+	for job := range q.jobs {
+		var errs []error
+
+		for _, email := range job.emails {
+			start := time.Now()
+			err := email.send(job.ctx, job.contact)
+			q.metrics.RecordEmail(email.kind, time.Since(start), err)
+
+			if err != nil {
+				q.logger.Error(job.ctx, "Failed to send "+email.kind+" email", err, map[string]interface{}{
+					"contact_id": job.contact.ID,
+					"email":      job.contact.Email,
+				})
+
+				errs = append(errs, err)
+			}
+		}
+
+		if job.done != nil {
+			job.done(errors.Join(errs...))
+		}
+	}
+}
+
+// Len returns the number of jobs waiting to be sent.
+func (q *EmailQueue) Len() int {
+	return len(q.jobs)
+}
+
+// HealthCheck reports whether the queue still accepts emails without waiting.
+// A full queue means the worker is stuck or cannot keep up.
+func (q *EmailQueue) HealthCheck(_ context.Context) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.stopped {
+		return ErrEmailQueueStopped
+	}
+
+	if len(q.jobs) == cap(q.jobs) {
+		return fmt.Errorf("%w: %d jobs waiting", ErrEmailQueueFull, len(q.jobs))
+	}
+
+	return nil
+}
+
+// Stop refuses new emails and waits until the queued ones are sent or ctx
+// ends, reporting how many were left unsent.
+func (q *EmailQueue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.stopped {
+		q.stopped = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %d jobs not sent", ctx.Err(), len(q.jobs))
+	}
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/testutil"
+)
+
+// recordingEmailService records the contacts it was asked to email.
+type recordingEmailService struct {
+	sent  []string
+	delay time.Duration
+	mu    sync.Mutex
+}
+
+func (s *recordingEmailService) record(ctx context.Context, contact *domain.Contact) error {
+	time.Sleep(s.delay)
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, contact.Email)
+
+	return nil
+}
+
+func (s *recordingEmailService) SendContactNotification(ctx context.Context, contact *domain.Contact) error {
+	return s.record(ctx, contact)
+}
+
+func (s *recordingEmailService) SendConfirmationEmail(ctx context.Context, contact *domain.Contact) error {
+	return s.record(ctx, contact)
+}
+
+// recordingMetrics records the emails reported to it.
+type recordingMetrics struct {
+	failures int
+	sent     int
+	mu       sync.Mutex
+}
+
+func (m *recordingMetrics) RecordContactSubmission(domain.SubmissionOutcome) {}
+
+func (m *recordingMetrics) RecordEmail(_ string, _ time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent++
+	if err != nil {
+		m.failures++
+	}
+}
+
+func TestEmailQueueSendsQueuedEmailsOnStop(t *testing.T) {
+	next := &recordingEmailService{delay: 10 * time.Millisecond}
+	m := &recordingMetrics{}
+	q := NewEmailQueue(next, NewSlogLoggingService(slog.New(slog.DiscardHandler)), m, 10)
+
+	// Emails outlive the request that queued them.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		testutil.AssertNoError(t, q.SendConfirmationEmail(ctx, &domain.Contact{Email: email}))
+	}
+
+	cancel()
+
+	testutil.AssertNoError(t, q.Stop(context.Background()))
+	testutil.AssertEqual(t, 3, len(next.sent))
+	testutil.AssertEqual(t, "c@example.com", next.sent[2])
+	testutil.AssertEqual(t, 3, m.sent)
+	testutil.AssertEqual(t, 0, m.failures)
+
+	err := q.SendContactNotification(context.Background(), &domain.Contact{Email: "d@example.com"})
+	testutil.AssertTrue(t, errors.Is(err, ErrEmailQueueStopped), "queue refuses emails after stop")
+}
+
+func TestEmailQueueStopReportsUnsentEmails(t *testing.T) {
+	next := &recordingEmailService{delay: 100 * time.Millisecond}
+	q := NewEmailQueue(next, NewSlogLoggingService(slog.New(slog.DiscardHandler)), &recordingMetrics{}, 10)
+
+	for range 3 {
+		testutil.AssertNoError(t, q.SendConfirmationEmail(context.Background(), &domain.Contact{Email: "a@example.com"}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := q.Stop(ctx)
+	testutil.AssertTrue(t, errors.Is(err, context.DeadlineExceeded), "deadline reported")
+}
+
+func TestEmailQueueHealthCheck(t *testing.T) {
+	next := &recordingEmailService{delay: 50 * time.Millisecond}
+	q := NewEmailQueue(next, NewSlogLoggingService(slog.New(slog.DiscardHandler)), &recordingMetrics{}, 1)
+
+	testutil.AssertNoError(t, q.HealthCheck(context.Background()))
+
+	// One email is being sent and one waits, filling the queue.
+	for range 2 {
+		testutil.AssertNoError(t, q.SendConfirmationEmail(context.Background(), &domain.Contact{Email: "a@example.com"}))
+	}
+
+	testutil.AssertTrue(t, errors.Is(q.HealthCheck(context.Background()), ErrEmailQueueFull), "full queue reported")
+	testutil.AssertEqual(t, 1, q.Len())
+
+	testutil.AssertNoError(t, q.Stop(context.Background()))
+	testutil.AssertTrue(t, errors.Is(q.HealthCheck(context.Background()), ErrEmailQueueStopped), "stopped queue reported")
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"holger-hahn-website/internal/domain"
 )
@@ -164,3 +165,36 @@ func (r *MemoryContactRepository) Count(ctx context.Context, status domain.Conta
 
 	return count, nil
 }
+
+// CountBefore returns the number of contacts submitted before the given time.
+func (r *MemoryContactRepository) CountBefore(ctx context.Context, before time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+
+	for _, contact := range r.contacts {
+		if contact.SubmittedAt.Before(before) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// DeleteBefore removes contacts submitted before the given time.
+func (r *MemoryContactRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+
+	for id, contact := range r.contacts {
+		if contact.SubmittedAt.Before(before) {
+			delete(r.contacts, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,84 @@
+// Package infrastructure provides concrete implementations of external service interfaces.
+// It contains an in-memory admin session repository implementation for development and testing
+// with thread-safe operations.
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// MemorySessionRepository is an in-memory implementation of SessionRepository.
+type MemorySessionRepository struct {
+	sessions map[string]*domain.AdminSession
+	mu       sync.RWMutex
+}
+
+// NewMemorySessionRepository creates a new in-memory session repository.
+func NewMemorySessionRepository() *MemorySessionRepository {
+	return &MemorySessionRepository{
+		sessions: make(map[string]*domain.AdminSession),
+	}
+}
+
+// Save stores a new session.
+func (r *MemorySessionRepository) Save(ctx context.Context, session *domain.AdminSession) error {
+	if session == nil {
+		return fmt.Errorf("%w", domain.ErrSessionNil)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Create a copy to avoid external mutations
+	sessionCopy := *session
+	r.sessions[session.IDHash] = &sessionCopy
+
+	return nil
+}
+
+// FindByHash retrieves a session by the hash of its ID.
+func (r *MemorySessionRepository) FindByHash(ctx context.Context, hash string) (*domain.AdminSession, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, exists := r.sessions[hash]
+	if !exists {
+		return nil, fmt.Errorf("%w", domain.ErrSessionNotFound)
+	}
+
+	sessionCopy := *session
+
+	return &sessionCopy, nil
+}
+
+// Delete removes a session by the hash of its ID.
+func (r *MemorySessionRepository) Delete(ctx context.Context, hash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.sessions, hash)
+
+	return nil
+}
+
+// DeleteExpired removes the sessions that expired at or before now.
+func (r *MemorySessionRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+
+	for hash, session := range r.sessions {
+		if session.IsExpired(now) {
+			delete(r.sessions, hash)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,135 @@
+// Package infrastructure provides concrete implementations of external service interfaces.
+// It contains an in-memory API token repository implementation for development and testing
+// with thread-safe operations.
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// MemoryTokenRepository is an in-memory implementation of TokenRepository.
+type MemoryTokenRepository struct {
+	tokens map[string]*domain.APIToken
+	mu     sync.RWMutex
+	nextID int
+}
+
+// NewMemoryTokenRepository creates a new in-memory token repository.
+func NewMemoryTokenRepository() *MemoryTokenRepository {
+	return &MemoryTokenRepository{
+		tokens: make(map[string]*domain.APIToken),
+	}
+}
+
+// Save stores a newly issued token.
+func (r *MemoryTokenRepository) Save(ctx context.Context, token *domain.APIToken) error {
+	if token == nil {
+		return fmt.Errorf("%w", domain.ErrTokenNil)
+	}
+
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	token.ID = fmt.Sprintf("token_%d", r.nextID)
+
+	// Create a copy to avoid external mutations
+	tokenCopy := *token
+	r.tokens[token.ID] = &tokenCopy
+
+	return nil
+}
+
+// FindByID retrieves a token by ID.
+func (r *MemoryTokenRepository) FindByID(ctx context.Context, id string) (*domain.APIToken, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w", domain.ErrIDEmpty)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, exists := r.tokens[id]
+	if !exists {
+		return nil, fmt.Errorf("%w", domain.ErrTokenNotFound)
+	}
+
+	tokenCopy := *token
+
+	return &tokenCopy, nil
+}
+
+// FindByHash retrieves a token by the hash of its secret.
+func (r *MemoryTokenRepository) FindByHash(ctx context.Context, hash string) (*domain.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, token := range r.tokens {
+		if token.TokenHash == hash {
+			tokenCopy := *token
+			return &tokenCopy, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w", domain.ErrTokenNotFound)
+}
+
+// FindAll retrieves all tokens, newest first.
+func (r *MemoryTokenRepository) FindAll(ctx context.Context) ([]*domain.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tokens := make([]*domain.APIToken, 0, len(r.tokens))
+	for _, token := range r.tokens {
+		tokenCopy := *token
+		tokens = append(tokens, &tokenCopy)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.After(tokens[j].CreatedAt)
+	})
+
+	return tokens, nil
+}
+
+// Revoke marks a token as revoked.
+func (r *MemoryTokenRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, exists := r.tokens[id]
+	if !exists {
+		return fmt.Errorf("%w", domain.ErrTokenNotFound)
+	}
+
+	if token.RevokedAt == nil {
+		token.RevokedAt = &at
+	}
+
+	return nil
+}
+
+// TouchLastUsed records when a token was last used.
+func (r *MemoryTokenRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, exists := r.tokens[id]
+	if !exists {
+		return fmt.Errorf("%w", domain.ErrTokenNotFound)
+	}
+
+	token.LastUsedAt = &at
+
+	return nil
+}
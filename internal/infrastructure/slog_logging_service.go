@@ -0,0 +1,54 @@
+// Package infrastructure provides concrete implementations of external service interfaces.
+// It contains the slog-backed logging service, which turns structured fields into
+// log attributes so that the handler can add request context and redact personal data.
+package infrastructure
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+)
+
+// SlogLoggingService implements LoggingService on a slog logger.
+type SlogLoggingService struct {
+	logger *slog.Logger
+}
+
+// NewSlogLoggingService creates a logging service writing to logger.
+func NewSlogLoggingService(logger *slog.Logger) *SlogLoggingService {
+	return &SlogLoggingService{logger: logger}
+}
+
+// Info logs an info message with structured data.
+func (l *SlogLoggingService) Info(ctx context.Context, message string, fields map[string]interface{}) {
+	l.log(ctx, slog.LevelInfo, message, nil, fields)
+}
+
+// Error logs an error message with structured data.
+func (l *SlogLoggingService) Error(ctx context.Context, message string, err error, fields map[string]interface{}) {
+	l.log(ctx, slog.LevelError, message, err, fields)
+}
+
+// Warn logs a warning message with structured data.
+func (l *SlogLoggingService) Warn(ctx context.Context, message string, fields map[string]interface{}) {
+	l.log(ctx, slog.LevelWarn, message, nil, fields)
+}
+
+// log writes one record with the fields as attributes, in key order.
+func (l *SlogLoggingService) log(ctx context.Context, level slog.Level, message string, err error, fields map[string]interface{}) {
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := make([]slog.Attr, 0, len(fields)+1)
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	l.logger.LogAttrs(ctx, level, message, attrs...)
+}
@@ -6,50 +6,93 @@ package infrastructure
 import (
 	"context"
 	"fmt"
-	"os"
+	"log/slog"
+	"net"
 	"strconv"
+	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/gomail.v2"
+	"holger-hahn-website/internal/config"
 	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/telemetry"
 )
 
 // SMTPEmailService implements EmailService using SMTP.
 type SMTPEmailService struct {
-	dialer   *gomail.Dialer
-	fromAddr string
-	toAddr   string
+	mu          sync.RWMutex
+	dialer      *gomail.Dialer
+	logger      *slog.Logger
+	fromAddr    string
+	toAddr      string
+	development bool
 }
 
-// NewSMTPEmailService creates a new SMTP email service.
-func NewSMTPEmailService() *SMTPEmailService {
-	host := getEnvOrDefault("SMTP_HOST", "localhost")
-	portStr := getEnvOrDefault("SMTP_PORT", "587")
-	username := getEnvOrDefault("SMTP_USERNAME", "")
-	password := getEnvOrDefault("SMTP_PASSWORD", "")
-	fromAddr := getEnvOrDefault("FROM_EMAIL", "hello@holger-hahn.net")
-	toAddr := getEnvOrDefault("TO_EMAIL", "hello@holger-hahn.net")
-
-	port, err := strconv.Atoi(portStr)
-	if err != nil {
-		port = 587 // Default SMTP port
-	}
-
-	dialer := gomail.NewDialer(host, port, username, password)
+// NewSMTPEmailService creates a new SMTP email service. In development mode
+// emails are written to logger instead of being sent.
+func NewSMTPEmailService(cfg config.EmailConfig, logger *slog.Logger) *SMTPEmailService {
+	dialer := gomail.NewDialer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword)
 
 	// For development, you can disable TLS
-	if getEnvOrDefault("SMTP_TLS", "true") == "false" {
+	if !cfg.SMTPTLS {
 		dialer.TLSConfig = nil
 	}
 
 	return &SMTPEmailService{
-		dialer:   dialer,
-		fromAddr: fromAddr,
-		toAddr:   toAddr,
+		dialer:      dialer,
+		logger:      logger,
+		fromAddr:    cfg.From,
+		toAddr:      cfg.To,
+		development: cfg.IsDevelopment(),
+	}
+}
+
+// SetPassword replaces the SMTP password, for secrets rotated while the
+// server runs. Emails being sent keep the old one.
+func (s *SMTPEmailService) SetPassword(password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dialer := *s.dialer
+	dialer.Password = password
+	s.dialer = &dialer
+}
+
+// send delivers m with the current credentials.
+func (s *SMTPEmailService) send(m *gomail.Message) error {
+	s.mu.RLock()
+	dialer := s.dialer
+	s.mu.RUnlock()
+
+	return dialer.DialAndSend(m)
+}
+
+// HealthCheck reports whether the SMTP server accepts connections. Nothing is
+// sent in development mode, so there is nothing to reach.
+func (s *SMTPEmailService) HealthCheck(ctx context.Context) error {
+	if s.development {
+		return nil
 	}
+
+	s.mu.RLock()
+	address := net.JoinHostPort(s.dialer.Host, strconv.Itoa(s.dialer.Port))
+	s.mu.RUnlock()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("SMTP server unreachable: %w", err)
+	}
+
+	return conn.Close()
 }
 
 // SendContactNotification sends a notification email about a new contact.
-func (s *SMTPEmailService) SendContactNotification(ctx context.Context, contact *domain.Contact) error {
+func (s *SMTPEmailService) SendContactNotification(ctx context.Context, contact *domain.Contact) (err error) {
+	ctx, span := telemetry.Start(ctx, "smtp.send", attribute.String("email.kind", "notification"))
+	defer telemetry.End(span, &err)
+
 	m := gomail.NewMessage()
 	m.SetHeader("From", s.fromAddr)
 	m.SetHeader("To", s.toAddr)
@@ -80,21 +123,19 @@ Please respond within 24 hours.
 	m.SetBody("text/plain", body)
 
 	// In development mode, just log instead of sending
-	if getEnvOrDefault("EMAIL_MODE", "development") == "development" {
-		fmt.Printf("\n=== EMAIL NOTIFICATION ===\n")
-		fmt.Printf("To: %s\n", s.toAddr)
-		fmt.Printf("Subject: %s\n", "New Contact Form Submission - "+contact.Name)
-		fmt.Printf("Body:\n%s\n", body)
-		fmt.Printf("=========================\n\n")
-
+	if s.development {
+		s.logEmail(ctx, "notification", s.toAddr, "New Contact Form Submission - "+contact.Name, body)
 		return nil
 	}
 
-	return s.dialer.DialAndSend(m)
+	return s.send(m)
 }
 
 // SendConfirmationEmail sends a confirmation email to the contact.
-func (s *SMTPEmailService) SendConfirmationEmail(ctx context.Context, contact *domain.Contact) error {
+func (s *SMTPEmailService) SendConfirmationEmail(ctx context.Context, contact *domain.Contact) (err error) {
+	ctx, span := telemetry.Start(ctx, "smtp.send", attribute.String("email.kind", "confirmation"))
+	defer telemetry.End(span, &err)
+
 	m := gomail.NewMessage()
 	m.SetHeader("From", s.fromAddr)
 	m.SetHeader("To", contact.Email)
@@ -127,24 +168,18 @@ For urgent matters, contact me directly at hello@holger-hahn.net
 	m.SetBody("text/plain", body)
 
 	// In development mode, just log instead of sending
-	if getEnvOrDefault("EMAIL_MODE", "development") == "development" {
-		fmt.Printf("\n=== CONFIRMATION EMAIL ===\n")
-		fmt.Printf("To: %s\n", contact.Email)
-		fmt.Printf("Subject: %s\n", "Thank you for contacting Holger M. Hahn")
-		fmt.Printf("Body:\n%s\n", body)
-		fmt.Printf("===========================\n\n")
-
+	if s.development {
+		s.logEmail(ctx, "confirmation", contact.Email, "Thank you for contacting Holger M. Hahn", body)
 		return nil
 	}
 
-	return s.dialer.DialAndSend(m)
+	return s.send(m)
 }
 
-// getEnvOrDefault gets an environment variable or returns a default value.
-func getEnvOrDefault(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-
-	return defaultValue
+// logEmail logs an email that development mode does not send. The recipient
+// goes under the email key, which the logger masks; the subject and body hold
+// the contact's name and message and are only logged at debug level.
+func (s *SMTPEmailService) logEmail(ctx context.Context, kind, to, subject, body string) {
+	s.logger.InfoContext(ctx, "Email not sent in development mode", "kind", kind, "email", to)
+	s.logger.DebugContext(ctx, "Unsent email", "kind", kind, "subject", subject, "body", body)
 }
@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/logging"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestSMTPEmailServiceLogsInDevelopment(t *testing.T) {
+	contact := &domain.Contact{ID: "c-1", Name: "Ada", Email: "ada@example.com", Message: "A custody project"}
+
+	send := func(level slog.Level) string {
+		var buf bytes.Buffer
+
+		svc := NewSMTPEmailService(config.EmailConfig{Mode: "development"},
+			slog.New(logging.NewHandler(&buf, "json", level)))
+		testutil.AssertNoError(t, svc.SendConfirmationEmail(context.Background(), contact))
+
+		return buf.String()
+	}
+
+	t.Run("info", func(t *testing.T) {
+		out := send(slog.LevelInfo)
+		testutil.AssertTrue(t, strings.Contains(out, `"email":"a***@example.com"`), "Expected the masked recipient: "+out)
+		testutil.AssertFalse(t, strings.Contains(out, "ada@"), "Expected no address: "+out)
+		testutil.AssertFalse(t, strings.Contains(out, "A custody project"), "Expected no message: "+out)
+	})
+
+	t.Run("debug", func(t *testing.T) {
+		out := send(slog.LevelDebug)
+		testutil.AssertTrue(t, strings.Contains(out, "A custody project"), "Expected the body at debug level: "+out)
+	})
+}
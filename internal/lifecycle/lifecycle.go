@@ -0,0 +1,121 @@
+// Package lifecycle runs the HTTP server and shuts the application down in
+// order when the process is asked to stop: the server drains in-flight
+// requests first, then background workers finish and resources are closed,
+// all within one deadline.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// StopFunc stops one part of the application, giving up when ctx ends.
+type StopFunc func(ctx context.Context) error
+
+// stopper is a StopFunc with a name for the logs.
+type stopper struct {
+	stop StopFunc
+	name string
+}
+
+// Manager owns the HTTP server and everything that must stop after it.
+type Manager struct {
+	server   *http.Server
+	stoppers []stopper
+	timeout  time.Duration
+}
+
+// New creates a manager for server whose shutdown may take up to timeout.
+func New(server *http.Server, timeout time.Duration) *Manager {
+	return &Manager{server: server, timeout: timeout}
+}
+
+// OnStop registers stop to run once the server has drained. Stop functions
+// run in registration order, so register workers before the resources they
+// use.
+func (m *Manager) OnStop(name string, stop StopFunc) {
+	m.stoppers = append(m.stoppers, stopper{name: name, stop: stop})
+}
+
+// Run listens on the server's address and serves until SIGINT or SIGTERM
+// arrives or ctx is canceled, then shuts down.
+func (m *Manager) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", m.server.Addr)
+	if err != nil {
+		// Nothing was started, but the registered resources still need closing.
+		return errors.Join(err, m.stopAll())
+	}
+
+	return m.Serve(ctx, listener)
+}
+
+// Serve is Run on an existing listener.
+func (m *Manager) Serve(ctx context.Context, listener net.Listener) error {
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	served := make(chan error, 1)
+
+	go func() {
+		served <- m.server.Serve(listener)
+	}()
+
+	var err error
+
+	select {
+	case err = <-served:
+		// The server failed on its own; still release everything.
+	case <-ctx.Done():
+		slog.Info("🛑 Shutting down, draining requests", "timeout", m.timeout)
+	}
+
+	return errors.Join(err, m.shutdown())
+}
+
+// shutdown stops accepting connections, waits for in-flight requests and then
+// runs the stop functions, all within the manager's timeout.
+func (m *Manager) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var errs []error
+
+	if err := m.server.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("draining requests: %w", err))
+
+		// Cut off whatever did not finish in time.
+		_ = m.server.Close()
+	}
+
+	for _, s := range m.stoppers {
+		if err := s.stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stopping %s: %w", s.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// stopAll runs the stop functions without a server to drain.
+func (m *Manager) stopAll() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var errs []error
+
+	for _, s := range m.stoppers {
+		if err := s.stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stopping %s: %w", s.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,134 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+// slowServer answers after delay and closes started when a request arrives.
+func slowServer(delay time.Duration, started chan<- struct{}) *http.Server {
+	var once sync.Once
+
+	return &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			once.Do(func() { close(started) })
+			time.Sleep(delay)
+			_, _ = io.WriteString(w, "done")
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+}
+
+func TestShutdownDrainsInFlightRequests(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.AssertNoError(t, err)
+
+	addr := "http://" + listener.Addr().String()
+	started := make(chan struct{})
+	m := New(slowServer(200*time.Millisecond, started), 5*time.Second)
+
+	var order []string
+
+	m.OnStop("worker", func(context.Context) error {
+		order = append(order, "worker")
+		return nil
+	})
+	m.OnStop("database", func(context.Context) error {
+		order = append(order, "database")
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+
+	go func() { served <- m.Serve(ctx, listener) }()
+
+	type result struct {
+		err  error
+		body string
+		code int
+	}
+
+	inFlight := make(chan result, 1)
+
+	go func() {
+		resp, err := http.Get(addr)
+		if err != nil {
+			inFlight <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		inFlight <- result{err: err, body: string(body), code: resp.StatusCode}
+	}()
+
+	<-started
+	cancel()
+
+	res := <-inFlight
+	testutil.AssertNoError(t, res.err)
+	testutil.AssertEqual(t, http.StatusOK, res.code)
+	testutil.AssertEqual(t, "done", res.body)
+
+	testutil.AssertNoError(t, <-served)
+	testutil.AssertEqual(t, 2, len(order))
+	testutil.AssertEqual(t, "worker", order[0])
+	testutil.AssertEqual(t, "database", order[1])
+
+	_, err = http.Get(addr)
+	testutil.AssertError(t, err)
+}
+
+func TestShutdownGivesUpAfterTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.AssertNoError(t, err)
+
+	started := make(chan struct{})
+	m := New(slowServer(2*time.Second, started), 50*time.Millisecond)
+
+	stopped := false
+
+	m.OnStop("database", func(context.Context) error {
+		stopped = true
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+
+	go func() { served <- m.Serve(ctx, listener) }()
+	go func() {
+		if resp, err := http.Get("http://" + listener.Addr().String()); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+	cancel()
+
+	err = <-served
+	testutil.AssertTrue(t, errors.Is(err, context.DeadlineExceeded), "drain deadline reported")
+	testutil.AssertTrue(t, stopped, "stop functions run after a failed drain")
+}
+
+func TestStopErrorsAreJoined(t *testing.T) {
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+
+	m := New(&http.Server{Addr: "127.0.0.1:-1", ReadHeaderTimeout: time.Second}, time.Second)
+	m.OnStop("first", func(context.Context) error { return errFirst })
+	m.OnStop("second", func(context.Context) error { return errSecond })
+
+	err := m.Run(context.Background())
+	testutil.AssertTrue(t, errors.Is(err, errFirst), "first error kept")
+	testutil.AssertTrue(t, errors.Is(err, errSecond), "second error kept")
+}
@@ -0,0 +1,102 @@
+// Package logging builds the application's slog logger from the logging
+// configuration. Every record carries the request and trace IDs of its
+// context, and personal data such as email and IP addresses is masked before
+// it is written.
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+	"holger-hahn-website/internal/config"
+)
+
+// ErrUnknownLevel is returned for log levels slog does not know.
+var ErrUnknownLevel = errors.New("unknown log level")
+
+// Logger is the application logger together with the output it owns.
+type Logger struct {
+	*slog.Logger
+	level  *slog.LevelVar
+	output io.Writer
+}
+
+// New creates the logger described by cfg, tagging records with service.
+func New(cfg config.LoggingConfig, service string) (*Logger, error) {
+	level := new(slog.LevelVar)
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLevel, cfg.Level)
+	}
+
+	output := newOutput(cfg)
+
+	return &Logger{
+		Logger: slog.New(NewHandler(output, cfg.Format, level)).With("service", service),
+		level:  level,
+		output: output,
+	}, nil
+}
+
+// SetLevel changes the minimum level of records written, for configuration
+// reloads.
+func (l *Logger) SetLevel(level string) error {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("%w: %s", ErrUnknownLevel, level)
+	}
+
+	l.level.Set(parsed)
+
+	return nil
+}
+
+// NewHandler creates a JSON or text handler on w that adds context IDs and
+// redacts personal data. Any format other than "text" means JSON.
+func NewHandler(w io.Writer, format string, level slog.Leveler) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redact}
+
+	if format == "text" {
+		return contextHandler{slog.NewTextHandler(w, opts)}
+	}
+
+	return contextHandler{slog.NewJSONHandler(w, opts)}
+}
+
+// newOutput returns the writer for cfg.Output. Files are rotated by size and
+// age, keeping a limited number of compressed backups.
+func newOutput(cfg config.LoggingConfig) io.Writer {
+	switch cfg.Output {
+	case "stderr":
+		return os.Stderr
+	case "file":
+		return &lumberjack.Logger{
+			Filename:   cfg.File,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   true,
+		}
+	default:
+		return os.Stdout
+	}
+}
+
+// Writer returns a writer that logs each line written to it at level, for
+// libraries that only accept an io.Writer.
+func (l *Logger) Writer(level slog.Level) io.Writer {
+	return slog.NewLogLogger(l.Handler(), level).Writer()
+}
+
+// Shutdown closes the log file, if logs go to one. The dependency injection
+// container calls it on shutdown.
+func (l *Logger) Shutdown() error {
+	if closer, ok := l.output.(io.Closer); ok && l.output != os.Stdout && l.output != os.Stderr {
+		return closer.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/testutil"
+)
+
+// decode parses the single JSON record in buf.
+func decode(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+
+	var record map[string]any
+	testutil.AssertNoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	return record
+}
+
+func TestRedactsPersonalData(t *testing.T) {
+	tests := []struct {
+		key, value, want string
+	}{
+		{"email", "jane.doe@example.com", "j***@example.com"},
+		{"email", "not-an-address", redacted},
+		{"client_ip", "203.0.113.42", "203.0.113.0"},
+		{"ip", "2001:db8:1234:5678::1", "2001:db8:1234::"},
+		{"remote_addr", "198.51.100.7:54321", "198.51.100.0"},
+		{"IP", "::ffff:192.0.2.9", "192.0.2.0"},
+		{"ip", "unknown", redacted},
+		{"company", "Example GmbH", "Example GmbH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			slog.New(NewHandler(&buf, "json", slog.LevelInfo)).Info("test", tt.key, tt.value)
+			testutil.AssertEqual[any](t, tt.want, decode(t, &buf)[tt.key])
+		})
+	}
+}
+
+func TestRedactsGroupedAndBoundAttributes(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(NewHandler(&buf, "text", slog.LevelInfo)).With("email", "jane@example.com")
+	logger.Info("test", slog.Group("request", "client_ip", "203.0.113.42"))
+
+	out := buf.String()
+	testutil.AssertTrue(t, strings.Contains(out, "email=j***@example.com"), out)
+	testutil.AssertTrue(t, strings.Contains(out, "request.client_ip=203.0.113.0"), out)
+	testutil.AssertTrue(t, !strings.Contains(out, "jane@"), out)
+}
+
+func TestAddsRequestAndTraceIDs(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(NewHandler(&buf, "json", slog.LevelInfo))
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(WithRequestID(context.Background(), "req-1"), "op")
+	defer span.End()
+
+	logger.InfoContext(ctx, "test")
+
+	record := decode(t, &buf)
+	testutil.AssertEqual[any](t, "req-1", record["request_id"])
+	testutil.AssertEqual[any](t, span.SpanContext().TraceID().String(), record["trace_id"])
+	testutil.AssertEqual[any](t, span.SpanContext().SpanID().String(), record["span_id"])
+}
+
+func TestNewRespectsLevel(t *testing.T) {
+	logger, err := New(config.LoggingConfig{Level: "warn", Format: "json", Output: "stdout"}, "test")
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, !logger.Enabled(context.Background(), slog.LevelInfo), "info is below warn")
+	testutil.AssertTrue(t, logger.Enabled(context.Background(), slog.LevelWarn), "warn is enabled")
+	testutil.AssertNoError(t, logger.Shutdown())
+
+	_, err = New(config.LoggingConfig{Level: "verbose"}, "test")
+	testutil.AssertError(t, err)
+}
+
+func TestSetLevel(t *testing.T) {
+	logger, err := New(config.LoggingConfig{Level: "info", Format: "json", Output: "stdout"}, "test")
+	testutil.AssertNoError(t, err)
+
+	testutil.AssertNoError(t, logger.SetLevel("debug"))
+	testutil.AssertTrue(t, logger.Enabled(context.Background(), slog.LevelDebug), "debug is enabled after SetLevel")
+
+	testutil.AssertError(t, logger.SetLevel("verbose"))
+	testutil.AssertTrue(t, logger.Enabled(context.Background(), slog.LevelDebug), "an unknown level keeps the current one")
+}
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var seen string
+
+	r := gin.New()
+	r.Use(RequestID())
+	r.GET("/", func(c *gin.Context) { seen = RequestIDFromContext(c.Request.Context()) })
+
+	tests := []struct {
+		name, header string
+		reused       bool
+	}{
+		{"generated", "", false},
+		{"reused", "abc-123", true},
+		{"rejected", "bad id\nforged=entry", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			testutil.AssertEqual(t, seen, w.Header().Get(RequestIDHeader))
+			testutil.AssertEqual(t, tt.reused, seen == tt.header)
+			testutil.AssertTrue(t, seen != "", "request ID is set")
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buf bytes.Buffer
+
+	r := gin.New()
+	r.Use(RequestID(), AccessLog(slog.New(NewHandler(&buf, "json", slog.LevelInfo))))
+	r.GET("/items/:id", func(c *gin.Context) { c.String(http.StatusNotFound, "missing") })
+	r.GET("/healthz/live", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/items/7", nil)
+	req.RemoteAddr = "203.0.113.42:1234"
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	record := decode(t, &buf)
+	testutil.AssertEqual[any](t, "WARN", record["level"])
+	testutil.AssertEqual[any](t, "/items/:id", record["route"])
+	testutil.AssertEqual[any](t, float64(http.StatusNotFound), record["status"])
+	testutil.AssertEqual[any](t, "203.0.113.0", record["client_ip"])
+	testutil.AssertTrue(t, record["request_id"] != nil, "access log carries the request ID")
+
+	buf.Reset()
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz/live", nil))
+	testutil.AssertEqual(t, 0, buf.Len())
+}
+
+func TestRecovery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buf bytes.Buffer
+
+	r := gin.New()
+	r.Use(RequestID(), Recovery(slog.New(NewHandler(&buf, "json", slog.LevelInfo))))
+	r.GET("/boom", func(c *gin.Context) { panic("boom") })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/boom", nil))
+	testutil.AssertEqual(t, http.StatusInternalServerError, w.Code)
+
+	record := decode(t, &buf)
+	testutil.AssertEqual[any](t, "ERROR", record["level"])
+	testutil.AssertEqual[any](t, "boom", record["error"])
+	testutil.AssertEqual[any](t, w.Header().Get(RequestIDHeader), record["request_id"])
+	testutil.AssertTrue(t, strings.Contains(record["stack"].(string), "TestRecovery"), "Expected the stack of the panic")
+}
@@ -0,0 +1,65 @@
+package logging
+
+import (
+	"log/slog"
+	"net/netip"
+	"strings"
+)
+
+// redacted replaces values that cannot be masked partially.
+const redacted = "[redacted]"
+
+// sensitiveKeys maps attribute keys holding personal data to their masks.
+var sensitiveKeys = map[string]func(string) string{
+	"email":       maskEmail,
+	"ip":          maskIP,
+	"client_ip":   maskIP,
+	"remote_addr": maskIP,
+}
+
+// redact is the ReplaceAttr hook masking personal data, wherever in the
+// record the attribute appears.
+func redact(_ []string, a slog.Attr) slog.Attr {
+	mask, ok := sensitiveKeys[strings.ToLower(a.Key)]
+	if !ok {
+		return a
+	}
+
+	return slog.String(a.Key, mask(a.Value.Resolve().String()))
+}
+
+// maskEmail keeps the first character of the local part and the domain, so
+// that entries about the same sender can still be told apart.
+func maskEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return redacted
+	}
+
+	return local[:1] + "***@" + domain
+}
+
+// maskIP keeps the network of an address: /24 for IPv4 and /48 for IPv6.
+func maskIP(raw string) string {
+	addr, err := netip.ParseAddr(raw)
+	if err != nil {
+		addrPort, portErr := netip.ParseAddrPort(raw)
+		if portErr != nil {
+			return redacted
+		}
+
+		addr = addrPort.Addr()
+	}
+
+	bits := 48
+	if addr.Unmap().Is4() {
+		addr, bits = addr.Unmap(), 24
+	}
+
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return redacted
+	}
+
+	return prefix.Addr().String()
+}
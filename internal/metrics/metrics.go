@@ -0,0 +1,176 @@
+// Package metrics collects runtime and business metrics and exposes them in
+// the Prometheus exposition format. Every Metrics value has its own registry,
+// so containers created side by side in tests do not collide.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"holger-hahn-website/internal/domain"
+)
+
+// namespace prefixes every metric name.
+const namespace = "website"
+
+// unmatchedRoute labels requests that matched no route, keeping the label
+// set bounded whatever paths clients try.
+const unmatchedRoute = "unmatched"
+
+// otherMethod labels requests with a method outside the standard set, which
+// clients may otherwise make up freely.
+const otherMethod = "other"
+
+// Metrics implements MetricsService and records HTTP, template and queue
+// metrics.
+type Metrics struct {
+	registry           *prometheus.Registry
+	requests           *prometheus.CounterVec
+	requestDuration    *prometheus.HistogramVec
+	renderDuration     *prometheus.HistogramVec
+	contactSubmissions *prometheus.CounterVec
+	emailDuration      *prometheus.HistogramVec
+	emailFailures      *prometheus.CounterVec
+}
+
+// New creates the metrics together with the Go runtime and process
+// collectors.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		renderDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "template_render_duration_seconds",
+			Help:      "Time spent rendering page templates by route.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25},
+		}, []string{"route"}),
+		contactSubmissions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "contact_submissions_total",
+			Help:      "Contact form submissions by outcome.",
+		}, []string{"outcome"}),
+		emailDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "email_send_duration_seconds",
+			Help:      "Time spent sending emails by kind.",
+			Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"kind"}),
+		emailFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "email_send_failures_total",
+			Help:      "Emails that could not be sent by kind.",
+		}, []string{"kind"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestDuration,
+		m.renderDuration,
+		m.contactSubmissions,
+		m.emailDuration,
+		m.emailFailures,
+	)
+
+	return m
+}
+
+// Handler serves the registered metrics.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Middleware records the count and latency of every request, labelled with
+// the route pattern rather than the path. It also makes m available to
+// ObserveRender through the request context.
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), metricsKey{}, m))
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		method := methodLabel(c.Request.Method)
+		status := strconv.Itoa(c.Writer.Status())
+		m.requests.WithLabelValues(method, route, status).Inc()
+		m.requestDuration.WithLabelValues(method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// methodLabel returns method if it is a standard HTTP method and otherMethod
+// otherwise.
+func methodLabel(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return method
+	default:
+		return otherMethod
+	}
+}
+
+// RecordContactSubmission counts a contact form submission by outcome.
+func (m *Metrics) RecordContactSubmission(outcome domain.SubmissionOutcome) {
+	m.contactSubmissions.WithLabelValues(string(outcome)).Inc()
+}
+
+// RecordEmail records the duration of an email send and counts failures.
+func (m *Metrics) RecordEmail(kind string, duration time.Duration, err error) {
+	m.emailDuration.WithLabelValues(kind).Observe(duration.Seconds())
+
+	if err != nil {
+		m.emailFailures.WithLabelValues(kind).Inc()
+	}
+}
+
+// RegisterQueue exposes the current depth of the named queue.
+func (m *Metrics) RegisterQueue(name string, depth func() int) error {
+	return m.registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        "queue_depth",
+		Help:        "Items waiting in background queues.",
+		ConstLabels: prometheus.Labels{"queue": name},
+	}, func() float64 {
+		return float64(depth())
+	}))
+}
+
+// RegisterDBStats exposes the connection pool statistics returned by stats.
+func (m *Metrics) RegisterDBStats(stats func() sql.DBStats) error {
+	return m.registry.Register(newDBStatsCollector(stats))
+}
+
+// metricsKey is the context key of the request's Metrics.
+type metricsKey struct{}
+
+// ObserveRender records how long rendering the page of route took, if the
+// request passed through Middleware.
+func ObserveRender(ctx context.Context, route string, duration time.Duration) {
+	if m, ok := ctx.Value(metricsKey{}).(*Metrics); ok {
+		m.renderDuration.WithLabelValues(route).Observe(duration.Seconds())
+	}
+}
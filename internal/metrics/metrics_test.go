@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/testutil"
+)
+
+// scrape returns the exposition text of m.
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	testutil.AssertEqual(t, http.StatusOK, rec.Code)
+
+	body, err := io.ReadAll(rec.Body)
+	testutil.AssertNoError(t, err)
+
+	return string(body)
+}
+
+func assertContains(t *testing.T, body, line string) {
+	t.Helper()
+	testutil.AssertTrue(t, strings.Contains(body, line), "metrics contain "+line)
+}
+
+func TestMiddlewareRecordsRoutesAndRenders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	m := New()
+	r := gin.New()
+	r.Use(m.Middleware())
+	r.GET("/services/:file/", func(c *gin.Context) {
+		ObserveRender(c.Request.Context(), c.FullPath(), 2*time.Millisecond)
+		c.String(http.StatusOK, "page")
+	})
+
+	for _, path := range []string{"/services/one/", "/services/two/", "/missing"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	for _, method := range []string{"FOO1", "FOO2"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, "/missing", nil))
+	}
+
+	body := scrape(t, m)
+	assertContains(t, body, `website_http_requests_total{method="GET",route="/services/:file/",status="200"} 2`)
+	assertContains(t, body, `website_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assertContains(t, body, `website_http_requests_total{method="other",route="unmatched",status="404"} 2`)
+	assertContains(t, body, `website_http_request_duration_seconds_count{method="GET",route="/services/:file/",status="200"} 2`)
+	assertContains(t, body, `website_template_render_duration_seconds_count{route="/services/:file/"} 2`)
+	assertContains(t, body, "go_goroutines")
+}
+
+func TestBusinessMetrics(t *testing.T) {
+	m := New()
+	m.RecordContactSubmission(domain.SubmissionSaved)
+	m.RecordContactSubmission(domain.SubmissionValidationFailed)
+	m.RecordEmail("confirmation", 200*time.Millisecond, nil)
+	m.RecordEmail("confirmation", time.Second, errors.New("connection refused"))
+
+	depth := 3
+	testutil.AssertNoError(t, m.RegisterQueue("email", func() int { return depth }))
+	testutil.AssertNoError(t, m.RegisterDBStats(func() sql.DBStats {
+		return sql.DBStats{MaxOpenConnections: 10, OpenConnections: 2, InUse: 1, Idle: 1}
+	}))
+
+	// Observing outside a request is a no-op.
+	ObserveRender(context.Background(), "/", time.Millisecond)
+
+	body := scrape(t, m)
+	assertContains(t, body, `website_contact_submissions_total{outcome="saved"} 1`)
+	assertContains(t, body, `website_contact_submissions_total{outcome="validation_failure"} 1`)
+	assertContains(t, body, `website_email_send_duration_seconds_count{kind="confirmation"} 2`)
+	assertContains(t, body, `website_email_send_failures_total{kind="confirmation"} 1`)
+	assertContains(t, body, `website_queue_depth{queue="email"} 3`)
+	assertContains(t, body, "website_db_max_open_connections 10")
+	assertContains(t, body, "website_db_in_use_connections 1")
+	testutil.AssertTrue(t, !strings.Contains(body, "template_render_duration_seconds_count"), "no render outside requests")
+}
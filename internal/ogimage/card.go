@@ -0,0 +1,129 @@
+// Package ogimage renders the 1200×630 PNG cards link previews show for the
+// website's pages, services and experience highlights. Cards are drawn with
+// image/draw and the Go fonts bundled with golang.org/x/image, so rendering
+// needs no external tools and gives the same bytes for the same content.
+package ogimage
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/pdf"
+	"holger-hahn-website/internal/resume"
+)
+
+// Card names. The home card is fixed; service and highlight cards carry the
+// ID of what they show.
+const (
+	HomeCard        = "home"
+	servicePrefix   = "service-"
+	highlightPrefix = "highlight-"
+)
+
+// maxFigures is how many metrics fit side by side on a card.
+const maxFigures = 3
+
+// Card is the content of one preview image.
+type Card struct {
+	Kind     string
+	Title    string
+	Subtitle string
+	Figures  []pdf.Figure
+}
+
+// ServiceCard returns the card name for the service with id.
+func ServiceCard(id string) string {
+	return servicePrefix + id
+}
+
+// HighlightCard returns the card name for the achievement with id.
+func HighlightCard(id string) string {
+	return highlightPrefix + id
+}
+
+// Path returns the URL path the card with name is served at.
+func Path(name string) string {
+	return "/og/" + name + ".png"
+}
+
+// homeCard introduces the site owner with the size of the portfolio.
+func homeCard(profile resume.Profile, experiences []*domain.Experience, services []*domain.Service, now time.Time) Card {
+	card := Card{
+		Kind:     "Portfolio",
+		Title:    profile.Label,
+		Subtitle: profile.Summary,
+	}
+
+	var first time.Time
+
+	for _, exp := range experiences {
+		if first.IsZero() || exp.StartDate.Before(first) {
+			first = exp.StartDate
+		}
+	}
+
+	if !first.IsZero() {
+		card.Figures = append(card.Figures, pdf.Figure{
+			Label: "Years of experience",
+			Value: strconv.Itoa(int(now.Sub(first).Hours()/24/365)) + "+",
+		})
+	}
+
+	card.Figures = append(card.Figures,
+		pdf.Figure{Label: "Positions", Value: strconv.Itoa(len(experiences))},
+		pdf.Figure{Label: "Services", Value: strconv.Itoa(len(services))},
+	)
+
+	return card
+}
+
+// serviceCard shows a service with its price, duration and deliverables.
+func serviceCard(svc *domain.Service) Card {
+	card := Card{
+		Kind:     "Service",
+		Title:    svc.Name,
+		Subtitle: svc.Description,
+	}
+
+	if svc.Pricing != nil {
+		// Priced services show the amount only; the note does not fit a box.
+		pricing := *svc.Pricing
+		if pricing.Type != domain.PricingTypeCustom && pricing.Amount != 0 {
+			pricing.Description = ""
+		}
+
+		card.Figures = append(card.Figures, pdf.Figure{Label: "Pricing", Value: pdf.FormatPricing(&pricing)})
+	}
+
+	if svc.Duration != "" {
+		card.Figures = append(card.Figures, pdf.Figure{Label: "Duration", Value: svc.Duration})
+	}
+
+	if n := len(svc.Deliverables); n > 0 {
+		card.Figures = append(card.Figures, pdf.Figure{Label: "Deliverables", Value: strconv.Itoa(n)})
+	}
+
+	return card
+}
+
+// highlightCard shows an achievement with its headline metrics.
+func highlightCard(exp *domain.Experience, achievement *domain.Achievement) Card {
+	subtitle := achievement.Impact
+	if subtitle == "" {
+		subtitle = achievement.Description
+	}
+
+	figures := pdf.MetricFigures(achievement.Metrics)
+	if len(figures) > maxFigures {
+		figures = figures[:maxFigures]
+	}
+
+	return Card{
+		Kind:     fmt.Sprintf("Highlight · %s", exp.CompanyName),
+		Title:    achievement.Title,
+		Subtitle: subtitle,
+		Figures:  figures,
+	}
+}
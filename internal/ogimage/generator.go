@@ -0,0 +1,161 @@
+package ogimage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/rendercache"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/service"
+)
+
+// Image is a rendered card together with the revision of the content it was
+// rendered from.
+type Image = rendercache.Entry
+
+// Generator renders cards from the portfolio services and keeps the latest
+// rendering of each card until its content revision changes.
+type Generator struct {
+	experienceService *service.ExperienceService
+	portfolioService  *service.PortfolioService
+	profile           resume.Profile
+	brand             Branding
+	cache             *rendercache.Cache
+}
+
+// NewGenerator creates a card generator branded with the site owner's name
+// and the host of publicURL.
+func NewGenerator(publicURL string, experienceService *service.ExperienceService, portfolioService *service.PortfolioService) *Generator {
+	profile := resume.DefaultProfile()
+
+	host := publicURL
+	if u, err := url.Parse(publicURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	return &Generator{
+		experienceService: experienceService,
+		portfolioService:  portfolioService,
+		profile:           profile,
+		brand:             Branding{Name: profile.Name, Host: host},
+		cache:             rendercache.New(),
+	}
+}
+
+// Names lists every card: the home card, one per active service and one per
+// experience highlight.
+func (g *Generator) Names(ctx context.Context) ([]string, error) {
+	experiences, services, err := g.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{HomeCard}
+
+	for _, svc := range services {
+		names = append(names, ServiceCard(svc.ID))
+	}
+
+	for _, exp := range experiences {
+		for _, achievement := range exp.Achievements {
+			names = append(names, HighlightCard(achievement.ID))
+		}
+	}
+
+	return names, nil
+}
+
+// Image returns the card with name. Unknown names, inactive services and
+// missing highlights are reported as not found.
+func (g *Generator) Image(ctx context.Context, name string) (*Image, error) {
+	card, modTime, err := g.card(ctx, name)
+	if err != nil {
+		g.cache.Forget(name)
+		return nil, err
+	}
+
+	return g.cache.Render(name, []any{g.brand, card}, modTime, func(w io.Writer) error {
+		return Render(w, card, g.brand)
+	})
+}
+
+func (g *Generator) card(ctx context.Context, name string) (Card, time.Time, error) {
+	if name == HomeCard {
+		experiences, services, err := g.load(ctx)
+		if err != nil {
+			return Card{}, time.Time{}, err
+		}
+
+		modTime := latest(experiences, services)
+
+		return homeCard(g.profile, experiences, services, time.Now()), modTime, nil
+	}
+
+	if id, ok := strings.CutPrefix(name, servicePrefix); ok {
+		svc, err := g.portfolioService.GetService(ctx, id)
+		if err == nil && !svc.IsActive {
+			err = domain.ErrNotFound("service")
+		}
+
+		if err != nil {
+			return Card{}, time.Time{}, err
+		}
+
+		return serviceCard(svc), svc.UpdatedAt, nil
+	}
+
+	if id, ok := strings.CutPrefix(name, highlightPrefix); ok {
+		experiences, err := g.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+		if err != nil {
+			return Card{}, time.Time{}, err
+		}
+
+		for _, exp := range experiences {
+			for i := range exp.Achievements {
+				if exp.Achievements[i].ID == id {
+					return highlightCard(exp, &exp.Achievements[i]), exp.UpdatedAt, nil
+				}
+			}
+		}
+
+		return Card{}, time.Time{}, domain.ErrNotFound("highlight")
+	}
+
+	return Card{}, time.Time{}, domain.ErrNotFound("image")
+}
+
+func (g *Generator) load(ctx context.Context) ([]*domain.Experience, []*domain.Service, error) {
+	experiences, err := g.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	services, err := g.portfolioService.GetActiveServices(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return experiences, services, nil
+}
+
+func latest(experiences []*domain.Experience, services []*domain.Service) time.Time {
+	var newest time.Time
+
+	for _, exp := range experiences {
+		if exp.UpdatedAt.After(newest) {
+			newest = exp.UpdatedAt
+		}
+	}
+
+	for _, svc := range services {
+		if svc.UpdatedAt.After(newest) {
+			newest = svc.UpdatedAt
+		}
+	}
+
+	return newest
+}
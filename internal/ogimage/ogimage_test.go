@@ -0,0 +1,105 @@
+package ogimage
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestRender_IsDeterministicCardSizedPNG(t *testing.T) {
+	card := highlightCard(testutil.NewExperienceFixtures().ExperienceWithAchievements(), &domain.Achievement{
+		Title:   "Improved Test Coverage across a very long list of regulated custody platforms and services",
+		Impact:  "Reduced bugs in production by 60%",
+		Metrics: &domain.Metrics{TestCoverage: domain.NewTestCoverageMetric(45, 85)},
+	})
+	brand := Branding{Name: "Holger M. Hahn", Host: "holger-hahn.net"}
+
+	var first, second bytes.Buffer
+
+	testutil.AssertNoError(t, Render(&first, card, brand))
+	testutil.AssertNoError(t, Render(&second, card, brand))
+	testutil.AssertTrue(t, bytes.Equal(first.Bytes(), second.Bytes()), "Unchanged content should render identical bytes")
+
+	img, err := png.Decode(&first)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, Width, img.Bounds().Dx())
+	testutil.AssertEqual(t, Height, img.Bounds().Dy())
+}
+
+func TestCards(t *testing.T) {
+	fixtures := testutil.NewExperienceFixtures()
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	home := homeCard(resume.DefaultProfile(), fixtures.ExperiencesList(), nil, now)
+	testutil.AssertEqual(t, "Digital Assets Solutions Architect", home.Title)
+	testutil.AssertLen(t, home.Figures, 3)
+	testutil.AssertEqual(t, "3+", home.Figures[0].Value)
+	testutil.AssertEqual(t, "3", home.Figures[1].Value)
+	testutil.AssertEqual(t, "0", home.Figures[2].Value)
+
+	svc := domain.NewService("Custody Architecture Review", "Independent review", domain.ServiceTypeAuditing)
+	svc.Duration = "2 weeks"
+	testutil.AssertNoError(t, svc.SetPricing(domain.PricingInfo{Type: domain.PricingTypeDaily, Amount: 1400, Currency: "EUR"}))
+
+	card := serviceCard(svc)
+	testutil.AssertLen(t, card.Figures, 2)
+	testutil.AssertEqual(t, "EUR 1,400 per day", card.Figures[0].Value)
+	testutil.AssertEqual(t, "2 weeks", card.Figures[1].Value)
+
+	exp := fixtures.ExperienceWithAchievements()
+	highlight := highlightCard(exp, &exp.Achievements[0])
+	testutil.AssertEqual(t, "Highlight · TechCorp Inc", highlight.Kind)
+	testutil.AssertEqual(t, "Reduced bugs in production by 60%", highlight.Subtitle)
+	testutil.AssertLen(t, highlight.Figures, 3)
+}
+
+func TestGenerator(t *testing.T) {
+	techRepo := testutil.NewMockTechnologyRepository()
+	expRepo := testutil.NewMockExperienceRepository()
+	expRepo.PreloadExperiences([]*domain.Experience{testutil.NewExperienceFixtures().ExperienceWithAchievements()})
+
+	portfolio := service.NewPortfolioService(testutil.NewMockServiceRepository(), techRepo)
+	generator := NewGenerator("https://example.com", service.NewExperienceService(expRepo, techRepo), portfolio)
+	ctx := context.Background()
+
+	testutil.AssertEqual(t, "example.com", generator.brand.Host)
+
+	svc, err := portfolio.CreateService(ctx, service.CreateServiceRequest{
+		Name:        "Custody Architecture Review",
+		Description: "Independent review of digital asset custody setups",
+		Category:    domain.ServiceTypeAuditing,
+	})
+	testutil.AssertNoError(t, err)
+
+	names, err := generator.Names(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, names, 4)
+	testutil.AssertEqual(t, HomeCard, names[0])
+	testutil.AssertEqual(t, ServiceCard(svc.ID), names[1])
+	testutil.AssertEqual(t, HighlightCard("ach-001"), names[2])
+
+	first, err := generator.Image(ctx, ServiceCard(svc.ID))
+	testutil.AssertNoError(t, err)
+
+	again, err := generator.Image(ctx, ServiceCard(svc.ID))
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, first == again, "Unchanged content should be served from the cache")
+
+	testutil.AssertNoError(t, portfolio.DeactivateService(ctx, svc.ID))
+
+	_, err = generator.Image(ctx, ServiceCard(svc.ID))
+	testutil.AssertTrue(t, domain.IsNotFoundError(err), "Inactive services should have no card")
+
+	_, err = generator.Image(ctx, HighlightCard("missing"))
+	testutil.AssertTrue(t, domain.IsNotFoundError(err), "Unknown highlights should be reported as not found")
+
+	_, err = generator.Image(ctx, "unknown")
+	testutil.AssertTrue(t, domain.IsNotFoundError(err), "Unknown cards should be reported as not found")
+}
@@ -0,0 +1,255 @@
+package ogimage
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"holger-hahn-website/internal/pdf"
+)
+
+// Card dimensions recommended by Open Graph and Twitter for large previews.
+const (
+	Width  = 1200
+	Height = 630
+)
+
+// Layout in pixels.
+const (
+	margin        = 72
+	bandHeight    = 96
+	figureHeight  = 128
+	figureGap     = 24
+	figurePadding = 24
+	kindBaseline  = 124
+	titleBaseline = 204
+	titleLeading  = 70
+	subtitleGap   = 58
+	textLeading   = 42
+)
+
+// Font sizes in points at 72 DPI, i.e. pixels.
+const (
+	kindSize     = 26
+	titleSize    = 58
+	subtitleSize = 30
+	brandSize    = 30
+	labelSize    = 22
+)
+
+// figureValueSizes are tried in order until a figure's value fits its box.
+var figureValueSizes = []float64{44, 36, 28}
+
+// Brand colors from the website theme.
+var (
+	primaryBlue     = color.RGBA{R: 0x14, G: 0x1d, B: 0x55, A: 0xff}
+	primaryBlueDark = color.RGBA{R: 0x0f, G: 0x16, B: 0x40, A: 0xff}
+	accentGreen     = color.RGBA{R: 0x16, G: 0xa3, B: 0x4a, A: 0xff}
+	mutedText       = color.RGBA{R: 0xc3, G: 0xc7, B: 0xe0, A: 0xff}
+	white           = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+)
+
+// loadFonts parses the bundled Go fonts once.
+var loadFonts = sync.OnceValues(func() (*typefaces, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse regular font: %w", err)
+	}
+
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bold font: %w", err)
+	}
+
+	return &typefaces{regular: regular, bold: bold}, nil
+})
+
+type typefaces struct {
+	regular *opentype.Font
+	bold    *opentype.Font
+}
+
+// Branding is the footer band every card carries.
+type Branding struct {
+	Name string
+	Host string
+}
+
+// Render draws card as a PNG image.
+func Render(w io.Writer, card Card, brand Branding) error {
+	fonts, err := loadFonts()
+	if err != nil {
+		return err
+	}
+
+	c := &canvas{img: image.NewRGBA(image.Rect(0, 0, Width, Height)), fonts: fonts}
+
+	c.fill(c.img.Bounds(), primaryBlue)
+	c.fill(image.Rect(0, 0, margin/4, Height-bandHeight), accentGreen)
+
+	content := Width - 2*margin
+	maxTitle, maxSubtitle := 3, 2
+
+	if len(card.Figures) > 0 {
+		maxTitle, maxSubtitle = 2, 1
+	}
+
+	kind := c.face(fonts.bold, kindSize)
+	c.text(kind, strings.ToUpper(ellipsize(kind, card.Kind, content)), margin, kindBaseline, accentGreen)
+
+	title := c.face(fonts.bold, titleSize)
+	y := titleBaseline
+
+	for i, line := range wrap(title, card.Title, content, maxTitle) {
+		y = titleBaseline + i*titleLeading
+		c.text(title, line, margin, y, white)
+	}
+
+	subtitle := c.face(fonts.regular, subtitleSize)
+	y += subtitleGap
+
+	for _, line := range wrap(subtitle, card.Subtitle, content, maxSubtitle) {
+		c.text(subtitle, line, margin, y, mutedText)
+		y += textLeading
+	}
+
+	c.figures(card.Figures, content)
+	c.band(brand)
+
+	if err := png.Encode(w, c.img); err != nil {
+		return fmt.Errorf("failed to encode card: %w", err)
+	}
+
+	return nil
+}
+
+type canvas struct {
+	img   *image.RGBA
+	fonts *typefaces
+}
+
+func (c *canvas) face(f *opentype.Font, size float64) font.Face {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		// NewFace only fails on invalid options, which are constant here.
+		panic(err)
+	}
+
+	return face
+}
+
+func (c *canvas) fill(r image.Rectangle, col color.Color) {
+	draw.Draw(c.img, r, image.NewUniform(col), image.Point{}, draw.Src)
+}
+
+func (c *canvas) text(face font.Face, s string, x, y int, col color.Color) {
+	d := &font.Drawer{Dst: c.img, Src: image.NewUniform(col), Face: face, Dot: fixed.P(x, y)}
+	d.DrawString(s)
+}
+
+// figures draws up to maxFigures metric boxes above the footer band.
+func (c *canvas) figures(figures []pdf.Figure, content int) {
+	if len(figures) > maxFigures {
+		figures = figures[:maxFigures]
+	}
+
+	boxWidth := (content - (maxFigures-1)*figureGap) / maxFigures
+	top := Height - bandHeight - margin/2 - figureHeight
+	label := c.face(c.fonts.regular, labelSize)
+
+	for i, figure := range figures {
+		left := margin + i*(boxWidth+figureGap)
+		c.fill(image.Rect(left, top, left+boxWidth, top+figureHeight), primaryBlueDark)
+
+		inner := boxWidth - 2*figurePadding
+		value := c.fittingFace(figure.Value, inner)
+
+		c.text(value, ellipsize(value, figure.Value, inner), left+figurePadding, top+66, white)
+		c.text(label, ellipsize(label, figure.Label, inner), left+figurePadding, top+104, mutedText)
+	}
+}
+
+// band draws the footer with the owner's name and the site's host.
+func (c *canvas) band(brand Branding) {
+	top := Height - bandHeight
+	c.fill(image.Rect(0, top, Width, Height), primaryBlueDark)
+
+	baseline := top + bandHeight/2 + brandSize/3
+	name := c.face(c.fonts.bold, brandSize)
+	host := c.face(c.fonts.regular, brandSize)
+
+	c.text(name, brand.Name, margin, baseline, white)
+	c.text(host, brand.Host, Width-margin-font.MeasureString(host, brand.Host).Round(), baseline, mutedText)
+}
+
+// fittingFace returns the largest figure value face s fits in width with.
+func (c *canvas) fittingFace(s string, width int) font.Face {
+	var face font.Face
+
+	for _, size := range figureValueSizes {
+		face = c.face(c.fonts.bold, size)
+		if font.MeasureString(face, s).Round() <= width {
+			break
+		}
+	}
+
+	return face
+}
+
+// wrap breaks s into at most maxLines lines of width, ellipsizing the last
+// line when the text does not fit.
+func wrap(face font.Face, s string, width, maxLines int) []string {
+	var lines []string
+
+	line := ""
+
+	for _, word := range strings.Fields(s) {
+		candidate := strings.TrimSpace(line + " " + word)
+		if line == "" || font.MeasureString(face, candidate).Round() <= width {
+			line = candidate
+			continue
+		}
+
+		if len(lines) == maxLines-1 {
+			return append(lines, ellipsize(face, line+" "+word+" …", width))
+		}
+
+		lines = append(lines, ellipsize(face, line, width))
+		line = word
+	}
+
+	if line != "" {
+		lines = append(lines, ellipsize(face, line, width))
+	}
+
+	return lines
+}
+
+// ellipsize shortens s to fit width, ending it with an ellipsis.
+func ellipsize(face font.Face, s string, width int) string {
+	if font.MeasureString(face, s).Round() <= width {
+		return s
+	}
+
+	runes := []rune(strings.TrimSuffix(s, " …"))
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+
+		candidate := strings.TrimRight(string(runes), " ,.;:-–—") + "…"
+		if font.MeasureString(face, candidate).Round() <= width {
+			return candidate
+		}
+	}
+
+	return "…"
+}
@@ -0,0 +1,249 @@
+package pagecache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+)
+
+// Page is a successful response together with the content revision it was
+// rendered at.
+type Page struct {
+	ModTime     time.Time
+	ETag        string
+	ContentType string
+	Body        []byte
+	Revision    uint64
+}
+
+// Cache keeps the latest response of each route, by path and the query
+// parameters the route reads, until the content revision changes. Once full it
+// drops the least recently used page.
+type Cache struct {
+	revision     *Revision
+	cacheControl string
+	enabled      bool
+	pages        map[string]*list.Element
+	recent       *list.List
+	mu           sync.Mutex
+}
+
+// entry is a page in the recency list, most recently used first.
+type entry struct {
+	page *Page
+	key  string
+}
+
+// New creates a cache of the responses rendered at revision.
+func New(cfg config.CacheConfig, revision *Revision) *Cache {
+	return &Cache{
+		revision:     revision,
+		cacheControl: cacheControl(cfg.SharedMaxAge, cfg.StaleWhileRevalidate),
+		enabled:      cfg.Enabled,
+		pages:        map[string]*list.Element{},
+		recent:       list.New(),
+	}
+}
+
+// cacheControl lets browsers keep a page but revalidate it on every use, which
+// the ETag makes cheap, and lets a CDN serve it for sharedMaxAge and then,
+// while it revalidates in the background, for staleWhileRevalidate more.
+func cacheControl(sharedMaxAge, staleWhileRevalidate time.Duration) string {
+	directives := []string{"public", "max-age=0"}
+
+	if sharedMaxAge > 0 {
+		directives = append(directives, "s-maxage="+seconds(sharedMaxAge))
+	}
+
+	if staleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+seconds(staleWhileRevalidate))
+	}
+
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
+
+// Middleware answers GET and HEAD requests from the cache while the content
+// is unchanged. Otherwise the handlers run and a 200 response without cookies
+// is kept for the next request. Either way the response carries a strong
+// ETag, Last-Modified and Cache-Control, and conditional requests are
+// answered with 304 Not Modified. params names the query parameters the
+// route reads; any others do not make a new page.
+func (pc *Cache) Middleware(params ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !pc.enabled || (c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead) {
+			c.Next()
+			return
+		}
+
+		key := cacheKey(c, params)
+
+		// The revision is read before rendering: a change made meanwhile
+		// leaves the page stale and so renders it again next time
+		revision, modTime := pc.revision.Current(c.Request.Context())
+
+		if page := pc.lookup(key, revision); page != nil {
+			pc.serve(c, page)
+			c.Abort()
+
+			return
+		}
+
+		rec := &recorder{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = rec
+		c.Next()
+		c.Writer = rec.ResponseWriter
+
+		if rec.status != http.StatusOK || rec.Header().Get("Set-Cookie") != "" {
+			rec.flush()
+			return
+		}
+
+		page := newPage(revision, modTime, rec.Header().Get("Content-Type"), rec.body.Bytes())
+		pc.store(key, page)
+		pc.serve(c, page)
+	}
+}
+
+// cacheKey identifies a response by its route, its path parameters and the
+// given query parameters.
+func cacheKey(c *gin.Context, params []string) string {
+	values := url.Values{}
+
+	for _, param := range c.Params {
+		values[":"+param.Key] = []string{param.Value}
+	}
+
+	query := c.Request.URL.Query()
+	for _, param := range params {
+		if vals, ok := query[param]; ok {
+			values[param] = vals
+		}
+	}
+
+	return c.FullPath() + "?" + values.Encode()
+}
+
+// newPage fingerprints body as a strong ETag.
+func newPage(revision uint64, modTime time.Time, contentType string, body []byte) *Page {
+	sum := sha256.Sum256(body)
+
+	return &Page{
+		ModTime:     modTime,
+		ETag:        `"` + hex.EncodeToString(sum[:8]) + `"`,
+		ContentType: contentType,
+		Body:        body,
+		Revision:    revision,
+	}
+}
+
+// serve writes page, or 304 Not Modified when the client already has it.
+func (pc *Cache) serve(c *gin.Context, page *Page) {
+	c.Header("ETag", page.ETag)
+	c.Header("Cache-Control", pc.cacheControl)
+	c.Header("Content-Type", page.ContentType)
+	http.ServeContent(c.Writer, c.Request, "", page.ModTime, bytes.NewReader(page.Body))
+}
+
+// lookup returns the page cached for key when it was rendered at revision.
+func (pc *Cache) lookup(key string, revision uint64) *Page {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	elem := pc.pages[key]
+	if elem == nil {
+		return nil
+	}
+
+	page := elem.Value.(*entry).page
+	if page.Revision != revision {
+		return nil
+	}
+
+	pc.recent.MoveToFront(elem)
+
+	return page
+}
+
+// store keeps page for key, dropping the least recently used page once the
+// cache is full.
+func (pc *Cache) store(key string, page *Page) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if elem := pc.pages[key]; elem != nil {
+		elem.Value.(*entry).page = page
+		pc.recent.MoveToFront(elem)
+
+		return
+	}
+
+	pc.pages[key] = pc.recent.PushFront(&entry{page: page, key: key})
+
+	if pc.recent.Len() > constants.PageCacheMaxEntries {
+		oldest := pc.recent.Back()
+		pc.recent.Remove(oldest)
+		delete(pc.pages, oldest.Value.(*entry).key)
+	}
+}
+
+// recorder holds back a response so that it can be fingerprinted before
+// anything is sent.
+type recorder struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (r *recorder) WriteHeader(code int) {
+	r.status = code
+}
+
+func (r *recorder) WriteHeaderNow() {}
+
+func (r *recorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *recorder) WriteString(s string) (int, error) {
+	return r.body.WriteString(s)
+}
+
+// Flush keeps holding back: templ flushes once a component is rendered.
+func (r *recorder) Flush() {}
+
+func (r *recorder) Status() int {
+	return r.status
+}
+
+func (r *recorder) Size() int {
+	return r.body.Len()
+}
+
+func (r *recorder) Written() bool {
+	return r.body.Len() > 0
+}
+
+// flush sends the held back response unchanged.
+func (r *recorder) flush() {
+	r.ResponseWriter.WriteHeader(r.status)
+	r.ResponseWriter.WriteHeaderNow()
+
+	if r.body.Len() > 0 {
+		_, _ = r.ResponseWriter.Write(r.body.Bytes())
+	}
+}
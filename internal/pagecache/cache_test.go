@@ -0,0 +1,244 @@
+package pagecache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/testutil"
+)
+
+var testConfig = config.CacheConfig{
+	Enabled:              true,
+	SharedMaxAge:         5 * time.Minute,
+	StaleWhileRevalidate: 24 * time.Hour,
+}
+
+// newTestRouter serves a page that counts how often it is rendered and reads
+// the lang query parameter.
+func newTestRouter(cache *Cache, renders *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.GET("/page", cache.Middleware("lang"), func(c *gin.Context) {
+		*renders++
+		c.String(http.StatusOK, "rendered %d", *renders)
+	})
+	r.GET("/items/:id", cache.Middleware(), func(c *gin.Context) {
+		*renders++
+		c.String(http.StatusOK, "%s rendered %d", c.Param("id"), *renders)
+	})
+	r.GET("/missing", cache.Middleware(), func(c *gin.Context) {
+		*renders++
+		c.String(http.StatusNotFound, "not found")
+	})
+
+	return r
+}
+
+func get(r http.Handler, target string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestMiddleware(t *testing.T) {
+	revision := NewRevision()
+	renders := 0
+	r := newTestRouter(New(testConfig, revision), &renders)
+
+	first := get(r, "/page", nil)
+	testutil.AssertEqual(t, http.StatusOK, first.Code)
+	testutil.AssertEqual(t, "rendered 1", first.Body.String())
+	testutil.AssertEqual(t, "public, max-age=0, s-maxage=300, stale-while-revalidate=86400", first.Header().Get("Cache-Control"))
+	testutil.AssertTrue(t, first.Header().Get("Last-Modified") != "", "Expected Last-Modified")
+
+	etag := first.Header().Get("ETag")
+	testutil.AssertTrue(t, len(etag) > 2 && etag[0] == '"', "Expected a strong ETag, got "+etag)
+
+	t.Run("hit", func(t *testing.T) {
+		w := get(r, "/page", nil)
+		testutil.AssertEqual(t, "rendered 1", w.Body.String())
+		testutil.AssertEqual(t, etag, w.Header().Get("ETag"))
+		testutil.AssertEqual(t, 1, renders)
+	})
+
+	t.Run("if-none-match", func(t *testing.T) {
+		w := get(r, "/page", http.Header{"If-None-Match": {etag}})
+		testutil.AssertEqual(t, http.StatusNotModified, w.Code)
+		testutil.AssertEqual(t, 0, w.Body.Len())
+	})
+
+	t.Run("if-modified-since", func(t *testing.T) {
+		w := get(r, "/page", http.Header{"If-Modified-Since": {first.Header().Get("Last-Modified")}})
+		testutil.AssertEqual(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("read query parameters make a new page", func(t *testing.T) {
+		w := get(r, "/page?lang=de", nil)
+		testutil.AssertEqual(t, "rendered 2", w.Body.String())
+	})
+
+	t.Run("other query parameters share the page", func(t *testing.T) {
+		for _, target := range []string{"/page?a=1", "/page?a=2", "/page?lang=de&a=3"} {
+			get(r, target, nil)
+		}
+
+		testutil.AssertEqual(t, 2, renders)
+	})
+
+	t.Run("path parameters make a new page", func(t *testing.T) {
+		testutil.AssertEqual(t, "a rendered 3", get(r, "/items/a", nil).Body.String())
+		testutil.AssertEqual(t, "b rendered 4", get(r, "/items/b", nil).Body.String())
+		testutil.AssertEqual(t, "a rendered 3", get(r, "/items/a?x=1", nil).Body.String())
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		before := renders
+
+		w := get(r, "/missing", nil)
+		testutil.AssertEqual(t, http.StatusNotFound, w.Code)
+		testutil.AssertEqual(t, "not found", w.Body.String())
+		testutil.AssertEqual(t, "", w.Header().Get("ETag"))
+
+		get(r, "/missing", nil)
+		testutil.AssertEqual(t, before+2, renders)
+	})
+
+	t.Run("content change", func(t *testing.T) {
+		revision.Bump()
+
+		w := get(r, "/page", http.Header{"If-None-Match": {etag}})
+		testutil.AssertEqual(t, http.StatusOK, w.Code)
+		testutil.AssertTrue(t, w.Header().Get("ETag") != etag, "Expected a new ETag after a content change")
+	})
+}
+
+func TestMiddleware_Disabled(t *testing.T) {
+	renders := 0
+	r := newTestRouter(New(config.CacheConfig{}, NewRevision()), &renders)
+
+	get(r, "/page", nil)
+	w := get(r, "/page", nil)
+
+	testutil.AssertEqual(t, "rendered 2", w.Body.String())
+	testutil.AssertEqual(t, "", w.Header().Get("ETag"))
+}
+
+func TestCacheControl(t *testing.T) {
+	testutil.AssertEqual(t, "public, max-age=0", cacheControl(0, 0))
+	testutil.AssertEqual(t, "public, max-age=0, s-maxage=60", cacheControl(time.Minute, 0))
+}
+
+func TestStore_Bounded(t *testing.T) {
+	cache := New(testConfig, NewRevision())
+
+	cache.store("/", &Page{Revision: 1})
+
+	for i := range constants.PageCacheMaxEntries - 1 {
+		cache.store(fmt.Sprintf("/page?offset=%d", i), &Page{Revision: 1})
+	}
+
+	// Using the home page keeps it while the oldest other page makes room
+	testutil.AssertTrue(t, cache.lookup("/", 1) != nil, "Expected the home page to be cached")
+
+	cache.store("/page?offset=new", &Page{Revision: 1})
+
+	testutil.AssertEqual(t, constants.PageCacheMaxEntries, len(cache.pages))
+	testutil.AssertTrue(t, cache.lookup("/", 1) != nil, "Expected the home page to be kept")
+	testutil.AssertTrue(t, cache.lookup("/page?offset=0", 1) == nil, "Expected the least recently used page to be dropped")
+	testutil.AssertTrue(t, cache.lookup("/page?offset=new", 1) != nil, "Expected the new page to be kept")
+}
+
+func TestWatch(t *testing.T) {
+	ctx := context.Background()
+	revision := NewRevision()
+	repo := WatchTechnologies(&stubTechnologies{}, revision)
+
+	n, _ := revision.Current(ctx)
+
+	testutil.AssertNoError(t, repo.Create(ctx, &domain.Technology{ID: "go"}))
+	testutil.AssertNoError(t, repo.Update(ctx, &domain.Technology{ID: "go"}))
+	testutil.AssertError(t, repo.Delete(ctx, "missing"))
+
+	_, err := repo.List(ctx, repository.TechnologyFilter{})
+	testutil.AssertNoError(t, err)
+
+	current, _ := revision.Current(ctx)
+	testutil.AssertEqual(t, n+2, current)
+}
+
+func TestStoredRevision(t *testing.T) {
+	ctx := context.Background()
+
+	stored := struct {
+		err     error
+		modTime time.Time
+		n       uint64
+		reads   int
+	}{n: 7, modTime: time.Now().Add(-time.Hour)}
+
+	revision := NewStoredRevision(func(context.Context) (uint64, time.Time, error) {
+		stored.reads++
+		return stored.n, stored.modTime, stored.err
+	})
+
+	n, modTime := revision.Current(ctx)
+	testutil.AssertEqual(t, uint64(7), n)
+	testutil.AssertFalse(t, modTime.Before(revision.started), "Expected pages no older than the process")
+
+	// Another process writes; it is seen after the next check
+	stored.n, stored.modTime = 8, time.Now().Add(time.Hour)
+	n, _ = revision.Current(ctx)
+	testutil.AssertEqual(t, uint64(7), n)
+	testutil.AssertEqual(t, 1, stored.reads)
+
+	// A write through this process is seen right away
+	revision.Bump()
+	n, modTime = revision.Current(ctx)
+	testutil.AssertEqual(t, uint64(8), n)
+	testutil.AssertEqual(t, lastModified(stored.modTime), modTime)
+
+	// Failed reads keep the last revision
+	stored.err = errors.New("database is locked")
+	revision.Bump()
+	n, _ = revision.Current(ctx)
+	testutil.AssertEqual(t, uint64(8), n)
+	testutil.AssertEqual(t, 3, stored.reads)
+}
+
+// stubTechnologies accepts every write except deleting "missing".
+type stubTechnologies struct {
+	repository.TechnologyRepository
+}
+
+func (s *stubTechnologies) Create(context.Context, *domain.Technology) error { return nil }
+
+func (s *stubTechnologies) Update(context.Context, *domain.Technology) error { return nil }
+
+func (s *stubTechnologies) Delete(_ context.Context, id string) error {
+	if id == "missing" {
+		return domain.ErrNotFound("technology")
+	}
+
+	return nil
+}
+
+func (s *stubTechnologies) List(context.Context, repository.TechnologyFilter) ([]*domain.Technology, error) {
+	return nil, nil
+}
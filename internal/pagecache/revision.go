@@ -0,0 +1,179 @@
+// Package pagecache keeps rendered pages and API reads in memory until the
+// portfolio content they were rendered from changes, and serves them with the
+// validators and caching headers a CDN in front of the site needs.
+package pagecache
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/repository"
+)
+
+// RevisionSource reads the content revision stored with the content and when
+// it last changed.
+type RevisionSource func(ctx context.Context) (uint64, time.Time, error)
+
+// Revision counts changes to the portfolio content: technologies, experiences
+// and services. Every cached page records the revision it was rendered at and
+// is rendered again once the revision moves on.
+type Revision struct {
+	started time.Time
+	checked time.Time
+	modTime time.Time
+	source  RevisionSource
+	n       uint64
+	mu      sync.Mutex
+}
+
+// NewRevision counts the changes made through this process, starting at the
+// current time, when the content was loaded.
+func NewRevision() *Revision {
+	now := lastModified(time.Now())
+	return &Revision{n: 1, modTime: now, started: now}
+}
+
+// NewStoredRevision follows the revision source reads, so that changes made by
+// other processes, such as the seed command or another instance, are noticed
+// within constants.ContentRevisionCheckInterval. Changes made through this
+// process are noticed right away.
+func NewStoredRevision(source RevisionSource) *Revision {
+	r := NewRevision()
+	r.source = source
+
+	return r
+}
+
+// Current returns the revision and when the content last changed. Pages are
+// never older than the process, which may render them differently.
+func (r *Revision) Current(ctx context.Context) (uint64, time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.source != nil && time.Since(r.checked) >= constants.ContentRevisionCheckInterval {
+		r.refresh(ctx)
+	}
+
+	return r.n, r.modTime
+}
+
+// refresh reads the stored revision. When that fails the last one read is
+// kept until the next check.
+func (r *Revision) refresh(ctx context.Context) {
+	r.checked = time.Now()
+
+	n, modTime, err := r.source(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to read the content revision", "error", err)
+		return
+	}
+
+	r.n = n
+	r.modTime = lastModified(modTime)
+
+	if r.modTime.Before(r.started) {
+		r.modTime = r.started
+	}
+}
+
+// Bump records a change to the content.
+func (r *Revision) Bump() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The stored revision has moved on already; read it next time
+	if r.source != nil {
+		r.checked = time.Time{}
+		return
+	}
+
+	r.n++
+	r.modTime = lastModified(time.Now())
+}
+
+// after bumps the revision when a write succeeded.
+func (r *Revision) after(err error) error {
+	if err == nil {
+		r.Bump()
+	}
+
+	return err
+}
+
+// lastModified truncates t to the precision of the Last-Modified header.
+func lastModified(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Second)
+}
+
+// WatchTechnologies returns repo, bumping revision after every change made
+// through it.
+func WatchTechnologies(repo repository.TechnologyRepository, revision *Revision) repository.TechnologyRepository {
+	return &technologies{TechnologyRepository: repo, revision: revision}
+}
+
+// WatchExperiences returns repo, bumping revision after every change made
+// through it.
+func WatchExperiences(repo repository.ExperienceRepository, revision *Revision) repository.ExperienceRepository {
+	return &experiences{ExperienceRepository: repo, revision: revision}
+}
+
+// WatchServices returns repo, bumping revision after every change made
+// through it.
+func WatchServices(repo repository.ServiceRepository, revision *Revision) repository.ServiceRepository {
+	return &services{ServiceRepository: repo, revision: revision}
+}
+
+type technologies struct {
+	repository.TechnologyRepository
+	revision *Revision
+}
+
+func (r *technologies) Create(ctx context.Context, tech *domain.Technology) error {
+	return r.revision.after(r.TechnologyRepository.Create(ctx, tech))
+}
+
+func (r *technologies) Update(ctx context.Context, tech *domain.Technology) error {
+	return r.revision.after(r.TechnologyRepository.Update(ctx, tech))
+}
+
+func (r *technologies) Delete(ctx context.Context, id string) error {
+	return r.revision.after(r.TechnologyRepository.Delete(ctx, id))
+}
+
+type experiences struct {
+	repository.ExperienceRepository
+	revision *Revision
+}
+
+func (r *experiences) Create(ctx context.Context, exp *domain.Experience) error {
+	return r.revision.after(r.ExperienceRepository.Create(ctx, exp))
+}
+
+func (r *experiences) Update(ctx context.Context, exp *domain.Experience) error {
+	return r.revision.after(r.ExperienceRepository.Update(ctx, exp))
+}
+
+func (r *experiences) Delete(ctx context.Context, id string) error {
+	return r.revision.after(r.ExperienceRepository.Delete(ctx, id))
+}
+
+type services struct {
+	repository.ServiceRepository
+	revision *Revision
+}
+
+func (r *services) Create(ctx context.Context, svc *domain.Service) error {
+	return r.revision.after(r.ServiceRepository.Create(ctx, svc))
+}
+
+func (r *services) Update(ctx context.Context, svc *domain.Service) error {
+	return r.revision.after(r.ServiceRepository.Update(ctx, svc))
+}
+
+func (r *services) Delete(ctx context.Context, id string) error {
+	return r.revision.after(r.ServiceRepository.Delete(ctx, id))
+}
@@ -0,0 +1,86 @@
+package pdf
+
+import (
+	"io"
+	"strings"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/resume"
+)
+
+// RenderCV writes the CV as a PDF: the profile header followed by every
+// position, newest first, with its achievements and their metric figures.
+func RenderCV(w io.Writer, cv *resume.CV) error {
+	d := newDocument(cv.Profile.Name+" - CV", cv.LastModified)
+
+	d.header(cv.Profile.Name, cv.Profile.Label, contactLine(cv.Profile))
+	d.paragraph(cv.Profile.Summary)
+
+	d.section("Professional Experience")
+
+	for i, exp := range cv.Experiences {
+		if i > 0 {
+			d.pdf.Ln(2)
+		}
+
+		writeExperience(d, exp)
+	}
+
+	return d.output(w)
+}
+
+func writeExperience(d *document, exp *domain.Experience) {
+	d.heading(exp.Position+" at "+exp.CompanyName, period(exp))
+
+	details := []string{}
+	if location := resume.ExperienceLocation(exp); location != "" {
+		details = append(details, location)
+	}
+
+	if len(exp.Technologies) > 0 {
+		names := make([]string, 0, len(exp.Technologies))
+		for _, tech := range exp.Technologies {
+			names = append(names, tech.Name)
+		}
+
+		details = append(details, strings.Join(names, ", "))
+	}
+
+	if len(details) > 0 {
+		d.subheading(strings.Join(details, " | "))
+	}
+
+	d.paragraph(exp.Description)
+
+	for _, achievement := range exp.Achievements {
+		text := achievement.Title
+		if achievement.Description != "" {
+			text += ": " + achievement.Description
+		}
+
+		d.bullet(text, achievement.Impact)
+		d.figures(MetricFigures(achievement.Metrics))
+	}
+}
+
+// period renders the date range of a position, e.g. "Mar 2021 – Present".
+func period(exp *domain.Experience) string {
+	end := "Present"
+	if exp.EndDate != nil {
+		end = exp.EndDate.Format("Jan 2006")
+	}
+
+	return exp.StartDate.Format("Jan 2006") + " – " + end
+}
+
+func contactLine(profile resume.Profile) string {
+	parts := []string{}
+
+	for _, part := range []string{profile.Email, strings.TrimPrefix(profile.LinkedInURL, "https://")} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, " | ")
+}
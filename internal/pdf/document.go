@@ -0,0 +1,236 @@
+// Package pdf renders the CV and per-service one-pagers as PDF documents in
+// pure Go, without a headless browser. Documents are cached by a revision
+// derived from their content, so a PDF is only rendered again after the
+// underlying experiences or service change.
+package pdf
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"holger-hahn-website/internal/constants"
+)
+
+// Page layout in millimetres.
+const (
+	pageMargin   = 18.0
+	lineHeight   = 5.0
+	figureHeight = 17.0
+	figureGap    = 3.0
+	figuresInRow = 3
+)
+
+// Colours matching the site palette.
+var (
+	colorText    = rgb{31, 41, 55}
+	colorMuted   = rgb{107, 114, 128}
+	colorPrimary = rgb{37, 99, 235}
+	colorGreen   = rgb{21, 128, 61}
+	colorCard    = rgb{243, 244, 246}
+	colorRule    = rgb{209, 213, 219}
+)
+
+type rgb struct{ r, g, b int }
+
+// document wraps fpdf with the typography shared by every generated PDF.
+// Core fonts only cover Windows-1252, so all text goes through tr.
+type document struct {
+	pdf   *fpdf.Fpdf
+	tr    func(string) string
+	width float64
+}
+
+// newDocument starts an A4 document. modTime becomes the creation and
+// modification date so that unchanged content renders to identical bytes.
+func newDocument(title string, modTime time.Time) *document {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetCatalogSort(true)
+	pdf.SetCreationDate(modTime)
+	pdf.SetModificationDate(modTime)
+	pdf.SetTitle(title, true)
+	pdf.SetAuthor(constants.OwnerName, true)
+	pdf.SetCreator("holger-hahn-website "+constants.AppVersion, true)
+	pdf.AliasNbPages("")
+
+	d := &document{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+
+	pageWidth, _ := pdf.GetPageSize()
+	d.width = pageWidth - 2*pageMargin
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pageMargin + 4)
+		d.font("", 8, colorMuted)
+		pdf.CellFormat(d.width/2, lineHeight, d.tr(constants.OwnerName+" | "+constants.OwnerEmail), "", 0, "L", false, 0, "")
+		pdf.CellFormat(d.width/2, lineHeight, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+
+	pdf.AddPage()
+
+	return d
+}
+
+func (d *document) font(style string, size float64, color rgb) {
+	d.pdf.SetFont("Helvetica", style, size)
+	d.pdf.SetTextColor(color.r, color.g, color.b)
+}
+
+// header writes the large name and headline at the top of the first page.
+func (d *document) header(title, subtitle, contact string) {
+	d.font("B", 22, colorText)
+	d.pdf.CellFormat(d.width, 10, d.tr(title), "", 1, "L", false, 0, "")
+
+	d.font("", 12, colorPrimary)
+	d.pdf.CellFormat(d.width, 7, d.tr(subtitle), "", 1, "L", false, 0, "")
+
+	if contact != "" {
+		d.font("", 9, colorMuted)
+		d.pdf.CellFormat(d.width, lineHeight, d.tr(contact), "", 1, "L", false, 0, "")
+	}
+
+	d.rule()
+}
+
+// section starts a titled section.
+func (d *document) section(title string) {
+	d.pdf.Ln(3)
+	d.font("B", 13, colorPrimary)
+	d.pdf.CellFormat(d.width, 8, d.tr(title), "", 1, "L", false, 0, "")
+}
+
+// heading writes an entry title with an optional right-aligned note.
+func (d *document) heading(title, note string) {
+	d.keepTogether(3 * lineHeight)
+
+	d.font("B", 11, colorText)
+
+	noteWidth := 0.0
+	if note != "" {
+		noteWidth = 55
+	}
+
+	d.pdf.CellFormat(d.width-noteWidth, 6, d.tr(title), "", 0, "L", false, 0, "")
+
+	if note != "" {
+		d.font("", 9, colorMuted)
+		d.pdf.CellFormat(noteWidth, 6, d.tr(note), "", 0, "R", false, 0, "")
+	}
+
+	d.pdf.Ln(6)
+}
+
+// subheading writes a muted line below a heading.
+func (d *document) subheading(text string) {
+	d.font("I", 9.5, colorMuted)
+	d.pdf.MultiCell(d.width, lineHeight, d.tr(text), "", "L", false)
+}
+
+// paragraph writes wrapped body text.
+func (d *document) paragraph(text string) {
+	if text == "" {
+		return
+	}
+
+	d.font("", 10, colorText)
+	d.pdf.MultiCell(d.width, lineHeight, d.tr(text), "", "L", false)
+	d.pdf.Ln(1)
+}
+
+// bullet writes one list item with an optional green impact line.
+func (d *document) bullet(text, impact string) {
+	const indent = 5.0
+
+	d.keepTogether(2 * lineHeight)
+
+	left := d.pdf.GetX()
+
+	d.font("B", 10, colorPrimary)
+	d.pdf.CellFormat(indent, lineHeight, d.tr("•"), "", 0, "L", false, 0, "")
+
+	d.font("", 10, colorText)
+	d.pdf.MultiCell(d.width-indent, lineHeight, d.tr(text), "", "L", false)
+
+	if impact != "" {
+		d.pdf.SetX(left + indent)
+		d.font("B", 9.5, colorGreen)
+		d.pdf.MultiCell(d.width-indent, lineHeight, d.tr(impact), "", "L", false)
+	}
+
+	d.pdf.SetX(left)
+}
+
+// figures draws metric cards in rows of figuresInRow.
+func (d *document) figures(figures []Figure) {
+	if len(figures) == 0 {
+		return
+	}
+
+	cardWidth := (d.width - figureGap*(figuresInRow-1)) / figuresInRow
+	left := d.pdf.GetX()
+
+	for i := 0; i < len(figures); i += figuresInRow {
+		d.keepTogether(figureHeight + figureGap)
+
+		top := d.pdf.GetY() + 1
+
+		for j, figure := range figures[i:min(i+figuresInRow, len(figures))] {
+			x := left + float64(j)*(cardWidth+figureGap)
+
+			d.pdf.SetFillColor(colorCard.r, colorCard.g, colorCard.b)
+			d.pdf.Rect(x, top, cardWidth, figureHeight, "F")
+
+			d.pdf.SetXY(x+2, top+1.5)
+			d.font("", 8, colorMuted)
+			d.pdf.CellFormat(cardWidth-4, 4, d.tr(figure.Label), "", 2, "L", false, 0, "")
+
+			d.font("B", 14, colorGreen)
+			d.pdf.CellFormat(cardWidth-4, 6.5, d.tr(figure.Value), "", 2, "L", false, 0, "")
+
+			d.font("", 7, colorMuted)
+			d.pdf.CellFormat(cardWidth-4, 4, d.tr(figure.Detail), "", 0, "L", false, 0, "")
+		}
+
+		d.pdf.SetXY(left, top+figureHeight+figureGap)
+	}
+}
+
+// keyValue writes a bold label followed by its value on one line.
+func (d *document) keyValue(label, value string) {
+	const labelWidth = 35.0
+
+	d.font("B", 10, colorText)
+	d.pdf.CellFormat(labelWidth, lineHeight+1, d.tr(label), "", 0, "L", false, 0, "")
+
+	d.font("", 10, colorText)
+	d.pdf.MultiCell(d.width-labelWidth, lineHeight+1, d.tr(value), "", "L", false)
+}
+
+// rule draws a thin horizontal separator.
+func (d *document) rule() {
+	y := d.pdf.GetY() + 2
+
+	d.pdf.SetDrawColor(colorRule.r, colorRule.g, colorRule.b)
+	d.pdf.SetLineWidth(0.3)
+	d.pdf.Line(pageMargin, y, pageMargin+d.width, y)
+	d.pdf.SetY(y + 3)
+}
+
+// keepTogether starts a new page when fewer than height millimetres remain.
+func (d *document) keepTogether(height float64) {
+	_, pageHeight := d.pdf.GetPageSize()
+	if d.pdf.GetY()+height > pageHeight-pageMargin {
+		d.pdf.AddPage()
+	}
+}
+
+// output writes the finished document to w.
+func (d *document) output(w io.Writer) error {
+	if err := d.pdf.Output(w); err != nil {
+		return fmt.Errorf("failed to render PDF: %w", err)
+	}
+
+	return nil
+}
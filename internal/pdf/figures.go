@@ -0,0 +1,87 @@
+package pdf
+
+import (
+	"fmt"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// Figure is one headline number from an achievement's metrics, laid out like
+// a card of the website's MetricsGrid: a label, the value, and a detail line
+// with the baseline or supporting numbers.
+type Figure struct {
+	Label  string
+	Value  string
+	Detail string
+}
+
+// MetricFigures summarizes metrics as figures in MetricsGrid order. It returns
+// nil when metrics is nil.
+func MetricFigures(metrics *domain.Metrics) []Figure {
+	if metrics == nil {
+		return nil
+	}
+
+	var figures []Figure
+
+	if m := metrics.TestCoverage; m != nil {
+		figures = append(figures, Figure{
+			Label:  "Test Coverage",
+			Value:  fmt.Sprintf("%.0f%s", m.After, m.Unit),
+			Detail: fmt.Sprintf("from %.0f%s, +%.1f%s", m.Before, m.Unit, m.After-m.Before, m.Unit),
+		})
+	}
+
+	if m := metrics.DeploymentTime; m != nil {
+		detail := "from " + formatDuration(m.Before, m.Unit)
+		if m.Before > 0 {
+			detail += fmt.Sprintf(", %.0f%% faster", float64(m.Before-m.After)/float64(m.Before)*100)
+		}
+
+		figures = append(figures, Figure{
+			Label:  "Deployment Time",
+			Value:  formatDuration(m.After, m.Unit),
+			Detail: detail,
+		})
+	}
+
+	if m := metrics.SystemReliability; m != nil {
+		figures = append(figures, Figure{
+			Label:  "Uptime",
+			Value:  fmt.Sprintf("%.1f%%", m.Uptime),
+			Detail: fmt.Sprintf("MTBF %dh, MTTR %dm, %d incidents/month", m.MTBF, m.MTTR, m.Incidents),
+		})
+	}
+
+	if m := metrics.Productivity; m != nil {
+		figures = append(figures, Figure{
+			Label:  "Efficiency",
+			Value:  fmt.Sprintf("+%.0f%%", m.Efficiency),
+			Detail: fmt.Sprintf("%d deployments/week, lead time %dh", m.DeploymentFrequency, m.LeadTime),
+		})
+	}
+
+	if m := metrics.CostSavings; m != nil {
+		figures = append(figures, Figure{
+			Label:  "Annual Savings",
+			Value:  fmt.Sprintf("$%.1fM", m.AnnualSavings/1000000),
+			Detail: fmt.Sprintf("ROI %.0f%%, payback %d mo", m.ROI, m.PaybackPeriod),
+		})
+	}
+
+	return figures
+}
+
+// formatDuration renders a time metric the way TimeMetricCard does, switching
+// to hours from 60 minutes up.
+func formatDuration(value int, unit string) string {
+	if unit == "minutes" {
+		if value >= 60 {
+			return fmt.Sprintf("%.1fh", float64(value)/60)
+		}
+
+		return fmt.Sprintf("%dmin", value)
+	}
+
+	return fmt.Sprintf("%d %s", value, unit)
+}
@@ -0,0 +1,68 @@
+package pdf
+
+import (
+	"context"
+	"io"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/rendercache"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/service"
+)
+
+// Document is a rendered PDF together with the revision of the content it
+// was rendered from.
+type Document = rendercache.Entry
+
+// Generator renders PDFs from the portfolio services and keeps the latest
+// rendering of each document until its content revision changes.
+type Generator struct {
+	experienceService *service.ExperienceService
+	portfolioService  *service.PortfolioService
+	profile           resume.Profile
+	cache             *rendercache.Cache
+}
+
+// NewGenerator creates a PDF generator for the site owner's profile.
+func NewGenerator(experienceService *service.ExperienceService, portfolioService *service.PortfolioService) *Generator {
+	return &Generator{
+		experienceService: experienceService,
+		portfolioService:  portfolioService,
+		profile:           resume.DefaultProfile(),
+		cache:             rendercache.New(),
+	}
+}
+
+// CV returns the CV rendered from every experience.
+func (g *Generator) CV(ctx context.Context) (*Document, error) {
+	experiences, err := g.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	cv := resume.NewCV(g.profile, experiences, nil)
+
+	return g.cache.Render("cv", []any{g.profile, cv}, cv.LastModified, func(w io.Writer) error {
+		return RenderCV(w, cv)
+	})
+}
+
+// Service returns the one-pager for the service with id. Inactive services
+// are reported as not found, like on the website.
+func (g *Generator) Service(ctx context.Context, id string) (*Document, error) {
+	key := "service:" + id
+
+	svc, err := g.portfolioService.GetService(ctx, id)
+	if err == nil && !svc.IsActive {
+		err = domain.ErrNotFound("service")
+	}
+
+	if err != nil {
+		g.cache.Forget(key)
+		return nil, err
+	}
+
+	return g.cache.Render(key, []any{g.profile, svc}, svc.UpdatedAt, func(w io.Writer) error {
+		return RenderService(w, g.profile, svc)
+	})
+}
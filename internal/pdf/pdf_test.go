@@ -0,0 +1,117 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestMetricFigures(t *testing.T) {
+	testutil.AssertLen(t, MetricFigures(nil), 0)
+
+	figures := MetricFigures(&domain.Metrics{
+		TestCoverage:   domain.NewTestCoverageMetric(45, 85),
+		DeploymentTime: domain.NewDeploymentTimeMetric(120, 15),
+		CostSavings:    domain.NewCostMetric(5000, 1500000, 250, 6),
+	})
+
+	testutil.AssertLen(t, figures, 3)
+	testutil.AssertEqual(t, Figure{Label: "Test Coverage", Value: "85%", Detail: "from 45%, +40.0%"}, figures[0])
+	testutil.AssertEqual(t, Figure{Label: "Deployment Time", Value: "15min", Detail: "from 2.0h, 88% faster"}, figures[1])
+	testutil.AssertEqual(t, "$1.5M", figures[2].Value)
+}
+
+func TestFormatPricing(t *testing.T) {
+	tests := []struct {
+		pricing domain.PricingInfo
+		want    string
+	}{
+		{domain.PricingInfo{Type: domain.PricingTypeDaily, Amount: 1200, Currency: "EUR"}, "EUR 1,200 per day"},
+		{domain.PricingInfo{Type: domain.PricingTypeProject, Amount: 25000.5, Currency: "USD"}, "USD 25000.50 per project"},
+		{domain.PricingInfo{Type: domain.PricingTypeHourly, Amount: 150, Description: "remote only"}, "150 per hour (remote only)"},
+		{domain.PricingInfo{Type: domain.PricingTypeCustom, Description: "Scoped per engagement"}, "Scoped per engagement"},
+		{domain.PricingInfo{Type: domain.PricingTypeCustom}, "On request"},
+	}
+
+	for _, tt := range tests {
+		testutil.AssertEqual(t, tt.want, FormatPricing(&tt.pricing))
+	}
+}
+
+func TestRenderCV_IsDeterministic(t *testing.T) {
+	fixtures := testutil.NewExperienceFixtures()
+	cv := resume.NewCV(resume.DefaultProfile(), []*domain.Experience{
+		fixtures.ExperienceWithAchievements(),
+		fixtures.CurrentExperience(),
+	}, nil)
+
+	var first, second bytes.Buffer
+
+	testutil.AssertNoError(t, RenderCV(&first, cv))
+	testutil.AssertNoError(t, RenderCV(&second, cv))
+
+	testutil.AssertTrue(t, bytes.HasPrefix(first.Bytes(), []byte("%PDF-")), "CV should be a PDF document")
+	testutil.AssertTrue(t, bytes.Equal(first.Bytes(), second.Bytes()), "Unchanged content should render identical bytes")
+}
+
+type generatorFixture struct {
+	generator *Generator
+	portfolio *service.PortfolioService
+}
+
+func newGeneratorFixture(t *testing.T) *generatorFixture {
+	t.Helper()
+
+	techRepo := testutil.NewMockTechnologyRepository()
+	expRepo := testutil.NewMockExperienceRepository()
+	serviceRepo := testutil.NewMockServiceRepository()
+
+	expRepo.PreloadExperiences([]*domain.Experience{testutil.NewExperienceFixtures().ExperienceWithAchievements()})
+
+	portfolio := service.NewPortfolioService(serviceRepo, techRepo)
+
+	return &generatorFixture{
+		generator: NewGenerator(service.NewExperienceService(expRepo, techRepo), portfolio),
+		portfolio: portfolio,
+	}
+}
+
+func TestGenerator_CachesByRevision(t *testing.T) {
+	f := newGeneratorFixture(t)
+	ctx := context.Background()
+
+	first, err := f.generator.CV(ctx)
+	testutil.AssertNoError(t, err)
+
+	again, err := f.generator.CV(ctx)
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, first == again, "Unchanged content should be served from the cache")
+
+	svc, err := f.portfolio.CreateService(ctx, service.CreateServiceRequest{
+		Name:        "Custody Architecture Review",
+		Description: "Independent review of digital asset custody setups",
+		Category:    domain.ServiceTypeAuditing,
+	})
+	testutil.AssertNoError(t, err)
+
+	sheet, err := f.generator.Service(ctx, svc.ID)
+	testutil.AssertNoError(t, err)
+
+	testutil.AssertNoError(t, f.portfolio.UpdateServicePricing(ctx, svc.ID, domain.PricingInfo{
+		Type: domain.PricingTypeDaily, Amount: 1400, Currency: "EUR",
+	}))
+
+	updated, err := f.generator.Service(ctx, svc.ID)
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, sheet.Revision != updated.Revision, "Changed content should get a new revision")
+
+	testutil.AssertNoError(t, f.portfolio.DeactivateService(ctx, svc.ID))
+
+	_, err = f.generator.Service(ctx, svc.ID)
+	testutil.AssertTrue(t, domain.IsNotFoundError(err), "Inactive services should not be rendered")
+}
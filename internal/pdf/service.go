@@ -0,0 +1,124 @@
+package pdf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/resume"
+)
+
+// RenderService writes a one-page sheet for svc: description, pricing,
+// deliverables and the technologies involved.
+func RenderService(w io.Writer, profile resume.Profile, svc *domain.Service) error {
+	d := newDocument(svc.Name+" - "+profile.Name, svc.UpdatedAt)
+
+	d.header(svc.Name, displayName(string(svc.Category))+" service by "+profile.Name, contactLine(profile))
+	d.paragraph(svc.Description)
+
+	d.section("Engagement")
+
+	if svc.Pricing != nil {
+		d.keyValue("Pricing", FormatPricing(svc.Pricing))
+	}
+
+	if svc.Duration != "" {
+		d.keyValue("Duration", svc.Duration)
+	}
+
+	if len(svc.Technologies) > 0 {
+		names := make([]string, 0, len(svc.Technologies))
+		for _, tech := range svc.Technologies {
+			names = append(names, tech.Name)
+		}
+
+		d.keyValue("Technologies", strings.Join(names, ", "))
+	}
+
+	if len(svc.Deliverables) > 0 {
+		d.section("Deliverables")
+
+		for _, deliverable := range svc.Deliverables {
+			text := deliverable.Name
+			if deliverable.Description != "" {
+				text += ": " + deliverable.Description
+			}
+
+			timeline := ""
+			if deliverable.Timeline != "" {
+				timeline = "Timeline: " + deliverable.Timeline
+			}
+
+			d.bullet(text, timeline)
+		}
+	}
+
+	d.section("Next Steps")
+	d.paragraph("Get in touch at " + profile.Email + " to discuss scope, timeline and how this service fits your organization.")
+
+	return d.output(w)
+}
+
+// FormatPricing describes a price, e.g. "EUR 1,200 per day".
+func FormatPricing(pricing *domain.PricingInfo) string {
+	if pricing.Type == domain.PricingTypeCustom || pricing.Amount == 0 {
+		if pricing.Description != "" {
+			return pricing.Description
+		}
+
+		return "On request"
+	}
+
+	amount := formatAmount(pricing.Amount)
+	if pricing.Currency != "" {
+		amount = pricing.Currency + " " + amount
+	}
+
+	switch pricing.Type {
+	case domain.PricingTypeHourly:
+		amount += " per hour"
+	case domain.PricingTypeDaily:
+		amount += " per day"
+	case domain.PricingTypeRetainer:
+		amount += " per month"
+	case domain.PricingTypeProject:
+		amount += " per project"
+	}
+
+	if pricing.Description != "" {
+		amount += " (" + pricing.Description + ")"
+	}
+
+	return amount
+}
+
+// formatAmount renders whole amounts with thousands separators.
+func formatAmount(amount float64) string {
+	if amount != float64(int64(amount)) {
+		return fmt.Sprintf("%.2f", amount)
+	}
+
+	digits := fmt.Sprintf("%d", int64(amount))
+
+	var b strings.Builder
+
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteRune(digit)
+	}
+
+	return b.String()
+}
+
+// displayName capitalizes an enum value such as "consulting".
+func displayName(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return strings.ToUpper(value[:1]) + value[1:]
+}
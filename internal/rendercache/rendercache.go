@@ -0,0 +1,85 @@
+// Package rendercache keeps the latest rendering of each generated document
+// or image until the content it was rendered from changes.
+package rendercache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// Entry is a rendering together with the revision of the content it was
+// rendered from.
+type Entry struct {
+	ModTime  time.Time
+	Revision string
+	Body     []byte
+}
+
+// Cache holds one entry per key.
+type Cache struct {
+	entries map[string]*Entry
+	mu      sync.Mutex
+}
+
+// New creates an empty cache.
+func New() *Cache {
+	return &Cache{entries: map[string]*Entry{}}
+}
+
+// Render returns the cached entry for key when content is unchanged and calls
+// write for a new rendering otherwise. content must hold everything the
+// rendering depends on, since only its revision is compared.
+func (c *Cache) Render(key string, content any, modTime time.Time, write func(io.Writer) error) (*Entry, error) {
+	revision, err := Revision(content)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cached := c.entries[key]
+	c.mu.Unlock()
+
+	if cached != nil && cached.Revision == revision {
+		return cached, nil
+	}
+
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		return nil, domain.ErrInternal(err.Error())
+	}
+
+	entry := &Entry{ModTime: modTime.UTC(), Revision: revision, Body: buf.Bytes()}
+
+	c.mu.Lock()
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	return entry, nil
+}
+
+// Forget drops the entry for key, for content that no longer exists.
+func (c *Cache) Forget(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
+
+// Revision hashes the JSON encoding of content.
+func Revision(content any) (string, error) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute content revision: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:8]), nil
+}
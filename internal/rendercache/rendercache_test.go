@@ -0,0 +1,38 @@
+package rendercache
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestCache_Render(t *testing.T) {
+	cache := New()
+	renders := 0
+
+	render := func(content string) *Entry {
+		entry, err := cache.Render("card", content, time.Now(), func(w io.Writer) error {
+			renders++
+			_, err := io.WriteString(w, content)
+
+			return err
+		})
+		testutil.AssertNoError(t, err)
+
+		return entry
+	}
+
+	first := render("v1")
+	testutil.AssertTrue(t, first == render("v1"), "Unchanged content should be served from the cache")
+	testutil.AssertEqual(t, 1, renders)
+
+	second := render("v2")
+	testutil.AssertTrue(t, first.Revision != second.Revision, "Changed content should get a new revision")
+	testutil.AssertEqual(t, "v2", string(second.Body))
+
+	cache.Forget("card")
+	render("v2")
+	testutil.AssertEqual(t, 3, renders)
+}
@@ -0,0 +1,234 @@
+package resume
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/constants"
+)
+
+// EuropassNamespace is the XML namespace of the Europass CV (XSD V3.4).
+const EuropassNamespace = "http://europass.cedefop.europa.eu/Europass"
+
+// Europass is a CV in the Europass SkillsPassport XML format. Activities and
+// skill descriptions carry escaped XHTML, as the Europass editor expects.
+type Europass struct {
+	XMLName      xml.Name             `xml:"SkillsPassport"`
+	Namespace    string               `xml:"xmlns,attr"`
+	Locale       string               `xml:"locale,attr"`
+	DocumentInfo EuropassDocumentInfo `xml:"DocumentInfo"`
+	LearnerInfo  EuropassLearnerInfo  `xml:"LearnerInfo"`
+}
+
+// EuropassDocumentInfo describes the document itself.
+type EuropassDocumentInfo struct {
+	DocumentType string `xml:"DocumentType"`
+	CreationDate string `xml:"CreationDate,omitempty"`
+	XSDVersion   string `xml:"XSDVersion"`
+	Generator    string `xml:"Generator"`
+}
+
+// EuropassLearnerInfo is the CV content.
+type EuropassLearnerInfo struct {
+	Identification     EuropassIdentification   `xml:"Identification"`
+	Headline           EuropassHeadline         `xml:"Headline"`
+	WorkExperienceList []EuropassWorkExperience `xml:"WorkExperienceList>WorkExperience"`
+	Skills             EuropassSkills           `xml:"Skills"`
+}
+
+// EuropassIdentification holds name and contact details.
+type EuropassIdentification struct {
+	FirstName string                  `xml:"PersonName>FirstName"`
+	Surname   string                  `xml:"PersonName>Surname"`
+	Email     string                  `xml:"ContactInfo>Email>Contact,omitempty"`
+	Websites  []EuropassContactMethod `xml:"ContactInfo>WebsiteList>Website,omitempty"`
+}
+
+// EuropassContactMethod is a labelled contact such as a website.
+type EuropassContactMethod struct {
+	Contact string        `xml:"Contact"`
+	Use     EuropassLabel `xml:"Use"`
+}
+
+// EuropassLabel is a coded or free-text label.
+type EuropassLabel struct {
+	Code  string `xml:"Code,omitempty"`
+	Label string `xml:"Label,omitempty"`
+}
+
+// EuropassHeadline is the position or profile the CV is written for.
+type EuropassHeadline struct {
+	Type        EuropassLabel `xml:"Type"`
+	Description EuropassLabel `xml:"Description"`
+}
+
+// EuropassWorkExperience is one position.
+type EuropassWorkExperience struct {
+	Period     EuropassPeriod   `xml:"Period"`
+	Position   EuropassLabel    `xml:"Position"`
+	Activities string           `xml:"Activities,omitempty"`
+	Employer   EuropassEmployer `xml:"Employer"`
+}
+
+// EuropassPeriod is a date range using the XSD gYear and gMonth attributes.
+type EuropassPeriod struct {
+	From    EuropassDate  `xml:"From"`
+	To      *EuropassDate `xml:"To,omitempty"`
+	Current bool          `xml:"Current"`
+}
+
+// EuropassDate is a partial date.
+type EuropassDate struct {
+	Year  string `xml:"year,attr"`
+	Month string `xml:"month,attr,omitempty"`
+}
+
+// EuropassEmployer is the organisation a position was held at.
+type EuropassEmployer struct {
+	Name         string `xml:"Name"`
+	Municipality string `xml:"ContactInfo>Address>Contact>Municipality,omitempty"`
+}
+
+// EuropassSkills lists computer skills grouped by category.
+type EuropassSkills struct {
+	Computer EuropassDescription `xml:"Computer"`
+}
+
+// EuropassDescription is a free-text description.
+type EuropassDescription struct {
+	Description string `xml:"Description"`
+}
+
+// NewEuropass converts cv to the Europass format.
+func NewEuropass(cv *CV) *Europass {
+	firstName, surname := splitName(cv.Profile.Name)
+
+	doc := &Europass{
+		Namespace: EuropassNamespace,
+		Locale:    "en",
+		DocumentInfo: EuropassDocumentInfo{
+			DocumentType: "ECV",
+			XSDVersion:   "V3.4",
+			Generator:    "holger-hahn-website " + constants.AppVersion,
+		},
+		LearnerInfo: EuropassLearnerInfo{
+			Identification: EuropassIdentification{
+				FirstName: firstName,
+				Surname:   surname,
+				Email:     cv.Profile.Email,
+			},
+			Headline: EuropassHeadline{
+				Type:        EuropassLabel{Code: "position", Label: "Position"},
+				Description: EuropassLabel{Label: cv.Profile.Label},
+			},
+			WorkExperienceList: make([]EuropassWorkExperience, 0, len(cv.Experiences)),
+		},
+	}
+
+	if !cv.LastModified.IsZero() {
+		doc.DocumentInfo.CreationDate = cv.LastModified.Format(time.RFC3339)
+	}
+
+	if cv.Profile.LinkedInURL != "" {
+		doc.LearnerInfo.Identification.Websites = append(doc.LearnerInfo.Identification.Websites, EuropassContactMethod{
+			Contact: cv.Profile.LinkedInURL,
+			Use:     EuropassLabel{Code: "business", Label: "LinkedIn"},
+		})
+	}
+
+	for _, exp := range cv.Experiences {
+		work := EuropassWorkExperience{
+			Period:   EuropassPeriod{From: europassDate(exp.StartDate), Current: exp.IsCurrent()},
+			Position: EuropassLabel{Label: exp.Position},
+			Employer: EuropassEmployer{Name: exp.CompanyName, Municipality: ExperienceLocation(exp)},
+		}
+
+		if exp.EndDate != nil {
+			to := europassDate(*exp.EndDate)
+			work.Period.To = &to
+		}
+
+		highlights := make([]string, 0, len(exp.Achievements))
+		for _, achievement := range exp.Achievements {
+			highlights = append(highlights, highlight(achievement))
+		}
+
+		work.Activities = xhtml(exp.Description, highlights)
+
+		doc.LearnerInfo.WorkExperienceList = append(doc.LearnerInfo.WorkExperienceList, work)
+	}
+
+	skills := make([]string, 0)
+
+	for _, group := range cv.skillGroups() {
+		names := make([]string, 0, len(group.Technologies))
+		for _, tech := range group.Technologies {
+			names = append(names, tech.Name)
+		}
+
+		line := group.Category + ": " + strings.Join(names, ", ")
+		if group.Level != "" {
+			line += " (" + displayLevel(group.Level) + ")"
+		}
+
+		skills = append(skills, line)
+	}
+
+	doc.LearnerInfo.Skills.Computer.Description = xhtml("", skills)
+
+	return doc
+}
+
+// MarshalEuropass encodes doc as an indented XML document with declaration.
+func MarshalEuropass(doc *Europass) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Europass CV: %w", err)
+	}
+
+	return append([]byte(xml.Header), append(body, '\n')...), nil
+}
+
+// europassDate converts t to the gYear and gMonth ("--01") attributes.
+func europassDate(t time.Time) EuropassDate {
+	return EuropassDate{
+		Year:  t.Format("2006"),
+		Month: t.Format("--01"),
+	}
+}
+
+// xhtml renders an optional paragraph followed by a bullet list.
+func xhtml(paragraph string, items []string) string {
+	var b strings.Builder
+
+	if paragraph != "" {
+		b.WriteString("<p>" + html.EscapeString(paragraph) + "</p>")
+	}
+
+	if len(items) > 0 {
+		b.WriteString("<ul>")
+
+		for _, item := range items {
+			b.WriteString("<li>" + html.EscapeString(item) + "</li>")
+		}
+
+		b.WriteString("</ul>")
+	}
+
+	return b.String()
+}
+
+// splitName splits a full name into given names and surname at the last space.
+func splitName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+
+	i := strings.LastIndex(name, " ")
+	if i < 0 {
+		return "", name
+	}
+
+	return name[:i], name[i+1:]
+}
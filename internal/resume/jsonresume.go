@@ -0,0 +1,144 @@
+package resume
+
+import (
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// JSONResumeSchema is the schema the JSON Resume document declares.
+const JSONResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// jsonResumeDate is the date layout used throughout the JSON Resume schema.
+const jsonResumeDate = "2006-01-02"
+
+// JSONResume is a CV in the jsonresume.org schema. Only the sections the
+// portfolio has data for are populated.
+type JSONResume struct {
+	Schema string         `json:"$schema"`
+	Basics JSONBasics     `json:"basics"`
+	Work   []JSONWork     `json:"work"`
+	Skills []JSONSkill    `json:"skills"`
+	Meta   JSONResumeMeta `json:"meta"`
+}
+
+// JSONBasics is the basics section of a JSON Resume.
+type JSONBasics struct {
+	Name     string        `json:"name"`
+	Label    string        `json:"label,omitempty"`
+	Email    string        `json:"email,omitempty"`
+	Summary  string        `json:"summary,omitempty"`
+	Profiles []JSONProfile `json:"profiles"`
+}
+
+// JSONProfile is a social network profile.
+type JSONProfile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// JSONWork is one position in the work section.
+type JSONWork struct {
+	Name       string   `json:"name"`
+	Position   string   `json:"position"`
+	Location   string   `json:"location,omitempty"`
+	StartDate  string   `json:"startDate"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights"`
+}
+
+// JSONSkill is one category of skills.
+type JSONSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords"`
+}
+
+// JSONResumeMeta records where the document came from.
+type JSONResumeMeta struct {
+	Version      string `json:"version"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// NewJSONResume converts cv to the JSON Resume schema. Positions become work
+// entries with achievements as highlights; technologies become one skill per
+// category.
+func NewJSONResume(cv *CV) *JSONResume {
+	doc := &JSONResume{
+		Schema: JSONResumeSchema,
+		Basics: JSONBasics{
+			Name:     cv.Profile.Name,
+			Label:    cv.Profile.Label,
+			Email:    cv.Profile.Email,
+			Summary:  cv.Profile.Summary,
+			Profiles: []JSONProfile{},
+		},
+		Work:   make([]JSONWork, 0, len(cv.Experiences)),
+		Skills: []JSONSkill{},
+		Meta:   JSONResumeMeta{Version: "v1.0.0"},
+	}
+
+	if cv.Profile.LinkedInURL != "" {
+		doc.Basics.Profiles = append(doc.Basics.Profiles, JSONProfile{
+			Network:  "LinkedIn",
+			Username: cv.Profile.LinkedInUsername,
+			URL:      cv.Profile.LinkedInURL,
+		})
+	}
+
+	if !cv.LastModified.IsZero() {
+		doc.Meta.LastModified = cv.LastModified.Format(time.RFC3339)
+	}
+
+	for _, exp := range cv.Experiences {
+		work := JSONWork{
+			Name:       exp.CompanyName,
+			Position:   exp.Position,
+			Location:   ExperienceLocation(exp),
+			StartDate:  exp.StartDate.Format(jsonResumeDate),
+			Summary:    exp.Description,
+			Highlights: make([]string, 0, len(exp.Achievements)),
+		}
+
+		if exp.EndDate != nil {
+			work.EndDate = exp.EndDate.Format(jsonResumeDate)
+		}
+
+		for _, achievement := range exp.Achievements {
+			work.Highlights = append(work.Highlights, highlight(achievement))
+		}
+
+		doc.Work = append(doc.Work, work)
+	}
+
+	for _, group := range cv.skillGroups() {
+		skill := JSONSkill{
+			Name:     group.Category,
+			Level:    displayLevel(group.Level),
+			Keywords: make([]string, 0, len(group.Technologies)),
+		}
+
+		for _, tech := range group.Technologies {
+			skill.Keywords = append(skill.Keywords, tech.Name)
+		}
+
+		doc.Skills = append(doc.Skills, skill)
+	}
+
+	return doc
+}
+
+// ExperienceLocation describes where a position was held, marking remote work.
+func ExperienceLocation(exp *domain.Experience) string {
+	switch {
+	case !exp.IsRemote:
+		return exp.Location
+	case exp.Location == "" || strings.EqualFold(exp.Location, "remote"):
+		return "Remote"
+	default:
+		return exp.Location + " (Remote)"
+	}
+}
@@ -0,0 +1,181 @@
+// Package resume renders the portfolio as a CV in the JSON Resume
+// (jsonresume.org) and Europass formats. Both documents are built from the
+// same experiences and technologies the website shows, so the CV sent to
+// clients cannot drift from the site.
+package resume
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+)
+
+// Profile holds the personal details that are not part of the portfolio data.
+type Profile struct {
+	Name             string
+	Label            string
+	Summary          string
+	Email            string
+	LinkedInUsername string
+	LinkedInURL      string
+}
+
+// DefaultProfile returns the site owner's profile.
+func DefaultProfile() Profile {
+	return Profile{
+		Name:             constants.OwnerName,
+		Label:            constants.OwnerLabel,
+		Summary:          constants.OwnerSummary,
+		Email:            constants.OwnerEmail,
+		LinkedInUsername: constants.OwnerLinkedInUsername,
+		LinkedInURL:      constants.OwnerLinkedInURL,
+	}
+}
+
+// CV is the portfolio content that goes into a resume.
+type CV struct {
+	LastModified time.Time
+	Profile      Profile
+	Experiences  []*domain.Experience
+	Technologies []*domain.Technology
+}
+
+// NewCV orders experiences newest first and technologies by name, and dates
+// the CV by its most recently updated entry so that rebuilding unchanged
+// content yields identical documents.
+func NewCV(profile Profile, experiences []*domain.Experience, technologies []*domain.Technology) *CV {
+	cv := &CV{
+		Profile:      profile,
+		Experiences:  slices.Clone(experiences),
+		Technologies: slices.Clone(technologies),
+	}
+
+	slices.SortStableFunc(cv.Experiences, func(a, b *domain.Experience) int {
+		return cmp.Or(b.StartDate.Compare(a.StartDate), cmp.Compare(a.ID, b.ID))
+	})
+
+	slices.SortStableFunc(cv.Technologies, func(a, b *domain.Technology) int {
+		return cmp.Or(strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)), cmp.Compare(a.ID, b.ID))
+	})
+
+	for _, exp := range cv.Experiences {
+		cv.touch(exp.UpdatedAt)
+	}
+
+	for _, tech := range cv.Technologies {
+		cv.touch(tech.UpdatedAt)
+	}
+
+	return cv
+}
+
+func (cv *CV) touch(t time.Time) {
+	if t.After(cv.LastModified) {
+		cv.LastModified = t.UTC()
+	}
+}
+
+// Generator loads a CV from the portfolio services.
+type Generator struct {
+	experienceService *service.ExperienceService
+	technologyService *service.TechnologyService
+	profile           Profile
+}
+
+// NewGenerator creates a generator for the site owner's profile.
+func NewGenerator(experienceService *service.ExperienceService, technologyService *service.TechnologyService) *Generator {
+	return &Generator{
+		experienceService: experienceService,
+		technologyService: technologyService,
+		profile:           DefaultProfile(),
+	}
+}
+
+// Load reads every experience and technology from the live repositories.
+func (g *Generator) Load(ctx context.Context) (*CV, error) {
+	experiences, err := g.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	technologies, err := g.technologyService.ListTechnologies(ctx, service.TechnologyFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCV(g.profile, experiences, technologies), nil
+}
+
+// skillGroup is the technologies of one category, strongest first.
+type skillGroup struct {
+	Category     string
+	Level        domain.Level
+	Technologies []*domain.Technology
+}
+
+// skillGroups groups technologies by category. Groups are ordered by name and
+// take the highest level among their technologies.
+func (cv *CV) skillGroups() []skillGroup {
+	byCategory := map[string]*skillGroup{}
+
+	var groups []*skillGroup
+
+	for _, tech := range cv.Technologies {
+		group, ok := byCategory[tech.Category]
+		if !ok {
+			group = &skillGroup{Category: tech.Category}
+			byCategory[tech.Category] = group
+			groups = append(groups, group)
+		}
+
+		group.Technologies = append(group.Technologies, tech)
+		if levelRank(tech.Level) > levelRank(group.Level) {
+			group.Level = tech.Level
+		}
+	}
+
+	slices.SortFunc(groups, func(a, b *skillGroup) int { return strings.Compare(a.Category, b.Category) })
+
+	result := make([]skillGroup, 0, len(groups))
+
+	for _, group := range groups {
+		slices.SortStableFunc(group.Technologies, func(a, b *domain.Technology) int {
+			return levelRank(b.Level) - levelRank(a.Level)
+		})
+
+		result = append(result, *group)
+	}
+
+	return result
+}
+
+// levelRank orders proficiency levels, with unknown levels lowest.
+func levelRank(level domain.Level) int {
+	return slices.Index(domain.AllLevels(), level)
+}
+
+// highlight renders an achievement as one CV line.
+func highlight(achievement domain.Achievement) string {
+	if achievement.Impact == "" {
+		return achievement.Title
+	}
+
+	return achievement.Title + " — " + achievement.Impact
+}
+
+// displayLevel capitalizes a proficiency level for display.
+func displayLevel(level domain.Level) string {
+	if level == "" {
+		return ""
+	}
+
+	s := string(level)
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,122 @@
+package resume
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/testutil"
+)
+
+func newTestCV() *CV {
+	fixtures := testutil.NewExperienceFixtures()
+	technologies := testutil.NewTechnologyFixtures().TechnologiesList()
+
+	achieved := fixtures.ExperienceWithAchievements()
+	achieved.UpdatedAt = testutil.TestTimeNow.Add(time.Hour)
+
+	experiences := []*domain.Experience{fixtures.CompletedExperience(), achieved, fixtures.CurrentExperience()}
+
+	return NewCV(DefaultProfile(), experiences, technologies)
+}
+
+func TestNewCV_OrdersContentAndDatesRevision(t *testing.T) {
+	cv := newTestCV()
+
+	testutil.AssertEqual(t, "exp-002", cv.Experiences[0].ID)
+	testutil.AssertEqual(t, "exp-003", cv.Experiences[2].ID)
+	testutil.AssertEqual(t, testutil.TestTimeNow.Add(time.Hour).UTC(), cv.LastModified)
+
+	for i := 1; i < len(cv.Technologies); i++ {
+		testutil.AssertTrue(t, strings.ToLower(cv.Technologies[i-1].Name) <= strings.ToLower(cv.Technologies[i].Name),
+			"Technologies should be ordered by name")
+	}
+}
+
+func TestNewJSONResume(t *testing.T) {
+	cv := newTestCV()
+	doc := NewJSONResume(cv)
+
+	testutil.AssertEqual(t, "Holger M. Hahn", doc.Basics.Name)
+	testutil.AssertLen(t, doc.Basics.Profiles, 1)
+	testutil.AssertLen(t, doc.Work, 3)
+
+	current := doc.Work[0]
+	testutil.AssertEqual(t, "InnovateLab", current.Name)
+	testutil.AssertEqual(t, "Remote", current.Location)
+	testutil.AssertEqual(t, "", current.EndDate)
+
+	achieved := doc.Work[1]
+	testutil.AssertLen(t, achieved.Highlights, 2)
+	testutil.AssertEqual(t, "Improved Test Coverage — Reduced bugs in production by 60%", achieved.Highlights[0])
+
+	completed := doc.Work[2]
+	testutil.AssertEqual(t, testutil.TestTimeNow.AddDate(-2, 0, 0).Format("2006-01-02"), completed.EndDate)
+
+	for _, skill := range doc.Skills {
+		if skill.Name == "Backend" {
+			testutil.AssertEqual(t, "Expert", skill.Level)
+			testutil.AssertEqual(t, "Go", skill.Keywords[0])
+		}
+	}
+
+	raw, err := json.Marshal(doc)
+	testutil.AssertNoError(t, err)
+
+	var decoded map[string]any
+	testutil.AssertNoError(t, json.Unmarshal(raw, &decoded))
+	testutil.AssertEqual[any](t, JSONResumeSchema, decoded["$schema"])
+	testutil.AssertNotNil(t, decoded["basics"])
+}
+
+func TestNewEuropass(t *testing.T) {
+	raw, err := MarshalEuropass(NewEuropass(newTestCV()))
+	testutil.AssertNoError(t, err)
+
+	body := string(raw)
+	testutil.AssertTrue(t, strings.HasPrefix(body, xml.Header), "Europass CV should start with an XML declaration")
+
+	var decoded Europass
+	testutil.AssertNoError(t, xml.Unmarshal(raw, &decoded))
+
+	testutil.AssertEqual(t, "Holger M.", decoded.LearnerInfo.Identification.FirstName)
+	testutil.AssertEqual(t, "Hahn", decoded.LearnerInfo.Identification.Surname)
+	testutil.AssertLen(t, decoded.LearnerInfo.WorkExperienceList, 3)
+
+	current := decoded.LearnerInfo.WorkExperienceList[0]
+	testutil.AssertTrue(t, current.Period.Current, "Open positions should be current")
+	testutil.AssertNil(t, current.Period.To)
+
+	completed := decoded.LearnerInfo.WorkExperienceList[2]
+	testutil.AssertNotNil(t, completed.Period.To)
+	testutil.AssertEqual(t, testutil.TestTimeNow.AddDate(-2, 0, 0).Format("--01"), completed.Period.To.Month)
+
+	achieved := decoded.LearnerInfo.WorkExperienceList[1]
+	testutil.AssertTrue(t, strings.Contains(achieved.Activities, "<li>Improved Test Coverage — Reduced bugs in production by 60%</li>"),
+		"Activities should list achievements: "+achieved.Activities)
+	testutil.AssertTrue(t, strings.Contains(body, "&lt;ul&gt;"), "Activities XHTML should be escaped in the document")
+}
+
+func TestGenerator_Load(t *testing.T) {
+	techRepo := testutil.NewMockTechnologyRepository()
+	expRepo := testutil.NewMockExperienceRepository()
+
+	techRepo.PreloadTechnologies(testutil.NewTechnologyFixtures().TechnologiesList())
+	expRepo.PreloadExperiences(testutil.NewExperienceFixtures().ExperiencesList())
+
+	generator := NewGenerator(service.NewExperienceService(expRepo, techRepo), service.NewTechnologyService(techRepo))
+
+	cv, err := generator.Load(testutil.TestContext(t))
+	testutil.AssertNoError(t, err)
+	testutil.AssertLen(t, cv.Experiences, 3)
+	testutil.AssertLen(t, cv.Technologies, len(testutil.NewTechnologyFixtures().TechnologiesList()))
+
+	expRepo.SetErrorMode(true, "database unavailable")
+
+	_, err = generator.Load(testutil.TestContext(t))
+	testutil.AssertError(t, err)
+}
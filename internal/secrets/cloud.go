@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// ErrUnsupportedCloud is returned for secret manager URLs whose scheme has no
+// registered client.
+var ErrUnsupportedCloud = errors.New("unsupported secret manager")
+
+// CloudClient reads secrets from a cloud secret manager such as Google Secret
+// Manager or AWS Secrets Manager. It returns ErrNotFound for unknown names.
+type CloudClient interface {
+	AccessSecret(ctx context.Context, name string) (string, error)
+}
+
+// CloudClientFactory connects to the secret manager at a URL such as
+// "gcpsm://projects/holger-hahn".
+type CloudClientFactory func(ctx context.Context, u *url.URL) (CloudClient, error)
+
+var (
+	cloudMu      sync.RWMutex
+	cloudClients = map[string]CloudClientFactory{}
+)
+
+// RegisterCloud makes the secret manager client built by factory available
+// for URLs with scheme. The website ships without clients so that it does
+// not depend on any cloud SDK; deployments that need one register it from
+// their own build.
+func RegisterCloud(scheme string, factory CloudClientFactory) {
+	cloudMu.Lock()
+	defer cloudMu.Unlock()
+
+	cloudClients[scheme] = factory
+}
+
+// Cloud looks secrets up in a cloud secret manager.
+type Cloud struct {
+	client CloudClient
+}
+
+// NewCloud connects to the secret manager at rawURL through the client
+// registered for its scheme.
+func NewCloud(ctx context.Context, rawURL string) (*Cloud, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCloud, rawURL)
+	}
+
+	cloudMu.RLock()
+	factory, ok := cloudClients[u.Scheme]
+	cloudMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: no client registered for %q", ErrUnsupportedCloud, u.Scheme)
+	}
+
+	client, err := factory(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cloud{client: client}, nil
+}
+
+// Lookup implements Provider.
+func (c *Cloud) Lookup(ctx context.Context, name string) (string, error) {
+	return c.client.AccessSecret(ctx, name)
+}
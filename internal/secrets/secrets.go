@@ -0,0 +1,81 @@
+// Package secrets looks up secret values such as the SMTP password outside
+// of the configuration itself: in files named by *_FILE environment variables,
+// as mounted by Docker and Kubernetes, in a local file encrypted with NaCl
+// secretbox, or in a cloud secret manager. Configuration values of the form
+// "secret:<name>" are references resolved through these providers.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReferencePrefix marks configuration values that name a secret instead of
+// holding it.
+const ReferencePrefix = "secret:"
+
+// ErrNotFound is returned by providers that do not hold the requested secret.
+var ErrNotFound = errors.New("secret not found")
+
+// Provider looks up secrets by name.
+type Provider interface {
+	Lookup(ctx context.Context, name string) (string, error)
+}
+
+// Chain asks each provider in turn and returns the first secret found.
+type Chain []Provider
+
+// Lookup implements Provider.
+func (c Chain) Lookup(ctx context.Context, name string) (string, error) {
+	for _, provider := range c {
+		value, err := provider.Lookup(ctx, name)
+		if !errors.Is(err, ErrNotFound) {
+			return value, err
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Resolve returns value, or the secret it refers to when it has the form
+// "secret:<name>".
+func Resolve(ctx context.Context, provider Provider, value string) (string, error) {
+	name, isRef := strings.CutPrefix(value, ReferencePrefix)
+	if !isRef {
+		return value, nil
+	}
+
+	return provider.Lookup(ctx, name)
+}
+
+// FileEnv reads the secret <NAME> from the file named by the environment
+// variable <NAME>_FILE, the convention of Docker and Kubernetes secrets.
+// Names are upper-cased, so "smtp_password" is read from $SMTP_PASSWORD_FILE.
+type FileEnv struct{}
+
+// Lookup implements Provider. A single trailing newline is dropped, as most
+// tools writing secret files add one.
+func (FileEnv) Lookup(_ context.Context, name string) (string, error) {
+	path := FileEnvPath(name)
+	if path == "" {
+		return "", ErrNotFound
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read secret %s: %w", name, err)
+	}
+
+	value := strings.TrimSuffix(string(data), "\n")
+
+	return strings.TrimSuffix(value, "\r"), nil
+}
+
+// FileEnvPath returns the file FileEnv reads the secret name from, or "" when
+// its *_FILE variable is not set.
+func FileEnvPath(name string) string {
+	return os.Getenv(strings.ToUpper(name) + "_FILE")
+}
@@ -0,0 +1,170 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+// mapProvider serves secrets from a map.
+type mapProvider map[string]string
+
+func (m mapProvider) Lookup(_ context.Context, name string) (string, error) {
+	value, ok := m[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return value, nil
+}
+
+func (m mapProvider) AccessSecret(ctx context.Context, name string) (string, error) {
+	return m.Lookup(ctx, name)
+}
+
+func TestFileEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "smtp_password")
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("hunter2\n"), 0o600))
+
+	testutil.WithEnv(t, map[string]string{"SMTP_PASSWORD_FILE": path, "MISSING_FILE": ""}, func() {
+		value, err := FileEnv{}.Lookup(context.Background(), "smtp_password")
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "hunter2", value)
+
+		_, err = FileEnv{}.Lookup(context.Background(), "missing")
+		testutil.AssertTrue(t, errors.Is(err, ErrNotFound), "Expected not found without a _FILE variable")
+	})
+}
+
+func TestChainAndResolve(t *testing.T) {
+	chain := Chain{mapProvider{"a": "first"}, mapProvider{"a": "second", "b": "only"}}
+	ctx := context.Background()
+
+	value, err := Resolve(ctx, chain, "secret:a")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "first", value)
+
+	value, err = Resolve(ctx, chain, "secret:b")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "only", value)
+
+	value, err = Resolve(ctx, chain, "plain value")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "plain value", value)
+
+	_, err = Resolve(ctx, chain, "secret:c")
+	testutil.AssertTrue(t, errors.Is(err, ErrNotFound), "Expected not found for unknown secret")
+}
+
+func TestStore(t *testing.T) {
+	encoded, err := GenerateKey()
+	testutil.AssertNoError(t, err)
+
+	key, err := ParseKey(encoded)
+	testutil.AssertNoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "secrets.json")
+
+	store, err := OpenStore(path, key)
+	testutil.AssertNoError(t, err)
+	store.Set("smtp_password", "hunter2")
+	store.Set("api_key", "abc")
+	testutil.AssertNoError(t, store.Save())
+
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertFalse(t, bytes.Contains(data, []byte("hunter2")), "secret must not be stored in the clear")
+
+	t.Run("reopens with the key", func(t *testing.T) {
+		reopened, err := OpenStore(path, key)
+		testutil.AssertNoError(t, err)
+
+		value, err := reopened.Lookup(context.Background(), "smtp_password")
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "hunter2", value)
+		testutil.AssertLen(t, reopened.Names(), 2)
+	})
+
+	t.Run("rejects another key", func(t *testing.T) {
+		other, err := GenerateKey()
+		testutil.AssertNoError(t, err)
+
+		otherKey, err := ParseKey(other)
+		testutil.AssertNoError(t, err)
+
+		_, err = OpenStore(path, otherKey)
+		testutil.AssertTrue(t, errors.Is(err, ErrDecrypt), "Expected decrypt error")
+	})
+
+	t.Run("rejects values moved between names", func(t *testing.T) {
+		var file storeFile
+		testutil.AssertNoError(t, json.Unmarshal(data, &file))
+
+		file.Secrets["api_key"], file.Secrets["smtp_password"] = file.Secrets["smtp_password"], file.Secrets["api_key"]
+
+		swapped, err := json.Marshal(file)
+		testutil.AssertNoError(t, err)
+
+		swappedPath := filepath.Join(t.TempDir(), "secrets.json")
+		testutil.AssertNoError(t, os.WriteFile(swappedPath, swapped, 0o600))
+
+		_, err = OpenStore(swappedPath, key)
+		testutil.AssertTrue(t, errors.Is(err, ErrDecrypt), "Expected decrypt error")
+	})
+
+	t.Run("reads and upgrades legacy files", func(t *testing.T) {
+		sealed, err := seal(key, "hunter2")
+		testutil.AssertNoError(t, err)
+
+		legacy, err := json.Marshal(storeFile{Version: legacyStoreVersion, Secrets: map[string]string{"smtp_password": sealed}})
+		testutil.AssertNoError(t, err)
+
+		legacyPath := filepath.Join(t.TempDir(), "secrets.json")
+		testutil.AssertNoError(t, os.WriteFile(legacyPath, legacy, 0o600))
+
+		legacyStore, err := OpenStore(legacyPath, key)
+		testutil.AssertNoError(t, err)
+		testutil.AssertNoError(t, legacyStore.Save())
+
+		upgraded, err := OpenStore(legacyPath, key)
+		testutil.AssertNoError(t, err)
+
+		value, err := upgraded.Lookup(context.Background(), "smtp_password")
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "hunter2", value)
+
+		raw, err := os.ReadFile(legacyPath)
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, bytes.Contains(raw, []byte(`"version": 2`)), "Expected the file to be upgraded")
+	})
+
+	t.Run("rejects malformed keys", func(t *testing.T) {
+		_, err := ParseKey("c2hvcnQ=")
+		testutil.AssertTrue(t, errors.Is(err, ErrInvalidKey), "Expected invalid key error")
+	})
+}
+
+func TestCloud(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewCloud(ctx, "unknown://vault")
+	testutil.AssertTrue(t, errors.Is(err, ErrUnsupportedCloud), "Expected unsupported cloud error")
+
+	RegisterCloud("fake", func(_ context.Context, u *url.URL) (CloudClient, error) {
+		return mapProvider{u.Host + "/token": "from-cloud"}, nil
+	})
+
+	cloud, err := NewCloud(ctx, "fake://project")
+	testutil.AssertNoError(t, err)
+
+	value, err := cloud.Lookup(ctx, "project/token")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "from-cloud", value)
+}
@@ -0,0 +1,211 @@
+package secrets
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// KeySize is the length of store keys in bytes.
+const KeySize = 32
+
+// nonceSize is the length of the nonce stored in front of each sealed value.
+const nonceSize = 24
+
+// storeVersion is the format version written to store files. Each value is
+// sealed under a key derived from its name, so that values cannot be moved
+// between names without the key.
+const storeVersion = 2
+
+// legacyStoreVersion files sealed every value under the store key itself.
+// They are still read and are upgraded by the next Save.
+const legacyStoreVersion = 1
+
+// Errors returned for unusable keys and store files.
+var (
+	ErrInvalidKey   = errors.New("invalid secrets key")
+	ErrDecrypt      = errors.New("secret cannot be decrypted with this key")
+	ErrStoreVersion = errors.New("unsupported secrets file version")
+)
+
+// storeFile is the on-disk format: secret names in the clear, each value
+// sealed with its own nonce, so that files can be diffed and listed without
+// the key.
+type storeFile struct {
+	Version int               `json:"version"`
+	Secrets map[string]string `json:"secrets"`
+}
+
+// Store is a local secrets file encrypted with NaCl secretbox
+// (XSalsa20-Poly1305) under a 32 byte key.
+type Store struct {
+	key     *[KeySize]byte
+	path    string
+	secrets map[string]string
+}
+
+// GenerateKey returns a new random store key, base64 encoded.
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ParseKey decodes a base64 encoded store key.
+func ParseKey(encoded string) (*[KeySize]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(raw) != KeySize {
+		return nil, fmt.Errorf("%w: want %d base64 encoded bytes", ErrInvalidKey, KeySize)
+	}
+
+	key := new([KeySize]byte)
+	copy(key[:], raw)
+
+	return key, nil
+}
+
+// OpenStore reads the store at path. A missing file is an empty store, which
+// Save creates.
+func OpenStore(path string, key *[KeySize]byte) (*Store, error) {
+	s := &Store{key: key, path: path, secrets: map[string]string{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var file storeFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse secrets file %s: %w", path, err)
+	}
+
+	if file.Version != storeVersion && file.Version != legacyStoreVersion {
+		return nil, fmt.Errorf("%w: %d", ErrStoreVersion, file.Version)
+	}
+
+	for name, sealed := range file.Secrets {
+		key := s.nameKey(name)
+		if file.Version == legacyStoreVersion {
+			key = s.key
+		}
+
+		value, err := open(key, sealed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, name)
+		}
+
+		s.secrets[name] = value
+	}
+
+	return s, nil
+}
+
+// Lookup implements Provider.
+func (s *Store) Lookup(_ context.Context, name string) (string, error) {
+	value, ok := s.secrets[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return value, nil
+}
+
+// Names returns the names of the stored secrets in order.
+func (s *Store) Names() []string {
+	return slices.Sorted(maps.Keys(s.secrets))
+}
+
+// Set stores value under name. Save writes the change.
+func (s *Store) Set(name, value string) {
+	s.secrets[name] = value
+}
+
+// Delete removes the secret name. Save writes the change.
+func (s *Store) Delete(name string) {
+	delete(s.secrets, name)
+}
+
+// Save encrypts the secrets and replaces the file atomically, so that
+// watchers never see it half written.
+func (s *Store) Save() error {
+	file := storeFile{Version: storeVersion, Secrets: make(map[string]string, len(s.secrets))}
+
+	for name, value := range s.secrets {
+		sealed, err := seal(s.nameKey(name), value)
+		if err != nil {
+			return err
+		}
+
+		file.Secrets[name] = sealed
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+// nameKey derives the key that seals the secret name from the store key.
+func (s *Store) nameKey(name string) *[KeySize]byte {
+	mac := hmac.New(sha256.New, s.key[:])
+	mac.Write([]byte(name))
+
+	key := new([KeySize]byte)
+	copy(key[:], mac.Sum(nil))
+
+	return key
+}
+
+// seal encrypts value under key and a fresh nonce.
+func seal(key *[KeySize]byte, value string) (string, error) {
+	var nonce [nonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", err
+	}
+
+	sealed := secretbox.Seal(nonce[:], []byte(value), &nonce, key)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts a value written by seal with the same key.
+func open(key *[KeySize]byte, sealed string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(raw) < nonceSize+secretbox.Overhead {
+		return "", ErrDecrypt
+	}
+
+	var nonce [nonceSize]byte
+	copy(nonce[:], raw[:nonceSize])
+
+	value, ok := secretbox.Open(nil, raw[nonceSize:], &nonce, key)
+	if !ok {
+		return "", ErrDecrypt
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,257 @@
+package seo
+
+import (
+	"slices"
+	"strings"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// schemaContext is the JSON-LD vocabulary used for all structured data.
+const schemaContext = "https://schema.org"
+
+// jsonLDDate is the ISO 8601 date layout schema.org expects.
+const jsonLDDate = "2006-01-02"
+
+// unitCodes maps pricing types to UN/CEFACT unit codes.
+var unitCodes = map[domain.PricingType]string{
+	domain.PricingTypeHourly:   "HUR",
+	domain.PricingTypeDaily:    "DAY",
+	domain.PricingTypeRetainer: "MON",
+}
+
+// Graph is a JSON-LD document holding several linked nodes.
+type Graph struct {
+	Context string `json:"@context"`
+	Nodes   []any  `json:"@graph"`
+}
+
+// Reference points at a node elsewhere in the graph.
+type Reference struct {
+	ID string `json:"@id"`
+}
+
+// Person is the schema.org Person node for the site owner.
+type Person struct {
+	Type        string             `json:"@type"`
+	ID          string             `json:"@id"`
+	Name        string             `json:"name"`
+	JobTitle    string             `json:"jobTitle,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Email       string             `json:"email,omitempty"`
+	URL         string             `json:"url"`
+	Image       string             `json:"image,omitempty"`
+	SameAs      []string           `json:"sameAs,omitempty"`
+	KnowsAbout  []string           `json:"knowsAbout,omitempty"`
+	WorksFor    []OrganizationRole `json:"worksFor,omitempty"`
+	AlumniOf    []OrganizationRole `json:"alumniOf,omitempty"`
+}
+
+// OrganizationRole qualifies a person's relation to an organization with the
+// role held and its dates. Following the schema.org role pattern, it repeats
+// the property it qualifies: worksFor for current positions, alumniOf for
+// past ones.
+type OrganizationRole struct {
+	Type      string        `json:"@type"`
+	RoleName  string        `json:"roleName"`
+	StartDate string        `json:"startDate"`
+	EndDate   string        `json:"endDate,omitempty"`
+	WorksFor  *Organization `json:"worksFor,omitempty"`
+	AlumniOf  *Organization `json:"alumniOf,omitempty"`
+}
+
+// Organization is an employer or client.
+type Organization struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// ProfessionalService is the consulting business offering the services.
+type ProfessionalService struct {
+	Type            string       `json:"@type"`
+	ID              string       `json:"@id"`
+	Name            string       `json:"name"`
+	Description     string       `json:"description,omitempty"`
+	URL             string       `json:"url"`
+	Email           string       `json:"email,omitempty"`
+	Image           string       `json:"image,omitempty"`
+	Founder         Reference    `json:"founder"`
+	HasOfferCatalog OfferCatalog `json:"hasOfferCatalog"`
+}
+
+// OfferCatalog lists the offers of a business.
+type OfferCatalog struct {
+	Type            string  `json:"@type"`
+	Name            string  `json:"name"`
+	ItemListElement []Offer `json:"itemListElement"`
+}
+
+// Offer is one service with its price.
+type Offer struct {
+	Type               string              `json:"@type"`
+	Name               string              `json:"name"`
+	URL                string              `json:"url,omitempty"`
+	Description        string              `json:"description,omitempty"`
+	Price              float64             `json:"price,omitempty"`
+	PriceCurrency      string              `json:"priceCurrency,omitempty"`
+	PriceSpecification *PriceSpecification `json:"priceSpecification,omitempty"`
+	ItemOffered        Service             `json:"itemOffered"`
+}
+
+// PriceSpecification states the unit a price is charged per.
+type PriceSpecification struct {
+	Type          string  `json:"@type"`
+	Price         float64 `json:"price"`
+	PriceCurrency string  `json:"priceCurrency,omitempty"`
+	UnitCode      string  `json:"unitCode,omitempty"`
+	UnitText      string  `json:"unitText,omitempty"`
+}
+
+// Service is the schema.org Service an offer refers to.
+type Service struct {
+	Type        string    `json:"@type"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	ServiceType string    `json:"serviceType,omitempty"`
+	Provider    Reference `json:"provider"`
+}
+
+// StructuredData builds the JSON-LD graph for the site owner and the
+// business offering services.
+func (s *Site) StructuredData(content Content) *Graph {
+	return &Graph{
+		Context: schemaContext,
+		Nodes:   []any{s.person(content.Experiences), s.business(content.Services)},
+	}
+}
+
+func (s *Site) personID() string {
+	return s.URL("/") + "#person"
+}
+
+func (s *Site) businessID() string {
+	return s.URL("/") + "#business"
+}
+
+func (s *Site) person(experiences []*domain.Experience) Person {
+	person := Person{
+		Type:        "Person",
+		ID:          s.personID(),
+		Name:        s.profile.Name,
+		JobTitle:    s.profile.Label,
+		Description: s.profile.Summary,
+		URL:         s.URL("/"),
+		Image:       s.URL(profileImage),
+	}
+
+	if s.profile.Email != "" {
+		person.Email = "mailto:" + s.profile.Email
+	}
+
+	if s.profile.LinkedInURL != "" {
+		person.SameAs = []string{s.profile.LinkedInURL}
+	}
+
+	for _, exp := range experiences {
+		role := OrganizationRole{
+			Type:      "OrganizationRole",
+			RoleName:  exp.Position,
+			StartDate: exp.StartDate.Format(jsonLDDate),
+		}
+
+		organization := &Organization{Type: "Organization", Name: exp.CompanyName}
+
+		if exp.EndDate == nil {
+			role.WorksFor = organization
+			person.WorksFor = append(person.WorksFor, role)
+		} else {
+			role.EndDate = exp.EndDate.Format(jsonLDDate)
+			role.AlumniOf = organization
+			person.AlumniOf = append(person.AlumniOf, role)
+		}
+
+		for _, tech := range exp.Technologies {
+			if !slices.Contains(person.KnowsAbout, tech.Name) {
+				person.KnowsAbout = append(person.KnowsAbout, tech.Name)
+			}
+		}
+	}
+
+	slices.SortFunc(person.KnowsAbout, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	return person
+}
+
+func (s *Site) business(services []*domain.Service) ProfessionalService {
+	business := ProfessionalService{
+		Type:        "ProfessionalService",
+		ID:          s.businessID(),
+		Name:        s.profile.Name + " - " + s.profile.Label,
+		Description: homeDescription,
+		URL:         s.URL("/"),
+		Image:       s.URL(profileImage),
+		Founder:     Reference{ID: s.personID()},
+		HasOfferCatalog: OfferCatalog{
+			Type:            "OfferCatalog",
+			Name:            "Services",
+			ItemListElement: make([]Offer, 0, len(services)),
+		},
+	}
+
+	if s.profile.Email != "" {
+		business.Email = "mailto:" + s.profile.Email
+	}
+
+	for _, svc := range services {
+		if svc.IsActive {
+			business.HasOfferCatalog.ItemListElement = append(business.HasOfferCatalog.ItemListElement, s.offer(svc))
+		}
+	}
+
+	return business
+}
+
+// offer converts a service and its PricingInfo into an Offer. Custom pricing
+// has no amount and is described in words only.
+func (s *Site) offer(svc *domain.Service) Offer {
+	offer := Offer{
+		Type: "Offer",
+		Name: svc.Name,
+		URL:  s.URL(ServicePath(svc.ID)),
+		ItemOffered: Service{
+			Type:        "Service",
+			Name:        svc.Name,
+			Description: svc.Description,
+			ServiceType: string(svc.Category),
+			Provider:    Reference{ID: s.businessID()},
+		},
+	}
+
+	pricing := svc.Pricing
+	if pricing == nil {
+		return offer
+	}
+
+	offer.Description = pricing.Description
+
+	if pricing.Type == domain.PricingTypeCustom || pricing.Amount == 0 {
+		return offer
+	}
+
+	offer.Price = pricing.Amount
+	offer.PriceCurrency = pricing.Currency
+
+	if unit, ok := unitCodes[pricing.Type]; ok {
+		offer.PriceSpecification = &PriceSpecification{
+			Type:          "UnitPriceSpecification",
+			Price:         pricing.Amount,
+			PriceCurrency: pricing.Currency,
+			UnitCode:      unit,
+			UnitText:      string(pricing.Type),
+		}
+	}
+
+	return offer
+}
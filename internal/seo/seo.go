@@ -0,0 +1,177 @@
+// Package seo builds the metadata search engines and link previews read:
+// schema.org JSON-LD, Open Graph and Twitter tags, sitemap.xml and
+// robots.txt. Everything is derived from the portfolio domain data so that
+// the Gin server and the static build publish the same information.
+package seo
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/ogimage"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/service"
+)
+
+// Page defaults for the home page.
+const (
+	homeTitle       = "Holger M. Hahn - Digital Assets & Blockchain Solutions Architect"
+	homeDescription = "Enterprise blockchain solutions, digital asset custody, and regulatory compliance expert. Trusted by banks and fintech companies."
+	profileImage    = "/static/images/Holger-Hahn.webp"
+)
+
+// Site knows the public address of the website, whose website it is and
+// where its content comes from.
+type Site struct {
+	experienceService *service.ExperienceService
+	portfolioService  *service.PortfolioService
+	baseURL           string
+	profile           resume.Profile
+	analytics         Analytics
+}
+
+// Analytics is the PostHog project the pages report to. An empty Key
+// disables analytics.
+type Analytics struct {
+	Key  string `json:"key"`
+	Host string `json:"api_host"`
+}
+
+// NewSite creates a site served from baseURL, e.g. "https://holger-hahn.net".
+func NewSite(baseURL string, experienceService *service.ExperienceService, portfolioService *service.PortfolioService) *Site {
+	return &Site{
+		experienceService: experienceService,
+		portfolioService:  portfolioService,
+		baseURL:           strings.TrimRight(baseURL, "/"),
+		profile:           resume.DefaultProfile(),
+	}
+}
+
+// WithAnalytics makes the pages report to the PostHog project a and returns s.
+func (s *Site) WithAnalytics(a Analytics) *Site {
+	s.analytics = a
+	return s
+}
+
+// Content is the domain data the metadata is built from.
+type Content struct {
+	Experiences []*domain.Experience
+	Services    []*domain.Service
+}
+
+// Load returns every experience, newest first, and the active services
+// ordered by ID, so that output built from it is stable.
+func (s *Site) Load(ctx context.Context) (Content, error) {
+	experiences, err := s.experienceService.ListExperiences(ctx, service.ExperienceFilter{})
+	if err != nil {
+		return Content{}, err
+	}
+
+	services, err := s.portfolioService.GetActiveServices(ctx)
+	if err != nil {
+		return Content{}, err
+	}
+
+	slices.SortStableFunc(experiences, func(a, b *domain.Experience) int {
+		return b.StartDate.Compare(a.StartDate)
+	})
+
+	slices.SortFunc(services, func(a, b *domain.Service) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return Content{Experiences: experiences, Services: services}, nil
+}
+
+// URL returns the absolute URL of path.
+func (s *Site) URL(path string) string {
+	return s.baseURL + "/" + strings.TrimLeft(path, "/")
+}
+
+// Head is everything a page's <head> needs beyond styles and scripts.
+type Head struct {
+	StructuredData *Graph
+	Title          string
+	Description    string
+	Canonical      string
+	Image          string
+	ImageAlt       string
+	ImageWidth     int
+	ImageHeight    int
+	Type           string
+	SiteName       string
+	Analytics      Analytics
+}
+
+// HomeHead describes the home page, whose structured data covers the
+// positions and services shown on it.
+func (s *Site) HomeHead(content Content) Head {
+	return Head{
+		Title:          homeTitle,
+		Description:    homeDescription,
+		Canonical:      s.URL("/"),
+		Image:          s.URL(ogimage.Path(ogimage.HomeCard)),
+		ImageAlt:       s.profile.Name + ", " + s.profile.Label,
+		ImageWidth:     ogimage.Width,
+		ImageHeight:    ogimage.Height,
+		Type:           "profile",
+		SiteName:       s.profile.Name,
+		Analytics:      s.analytics,
+		StructuredData: s.StructuredData(content),
+	}
+}
+
+// ServicePath returns the path of the detail page for the service with id.
+func ServicePath(id string) string {
+	return "/services/" + id + "/"
+}
+
+// ExperiencePath returns the path of the detail page for the experience with id.
+func ExperiencePath(id string) string {
+	return "/experiences/" + id + "/"
+}
+
+// ServiceHead describes a service's detail page. Its structured data is the
+// service's offer, linked to the business described on the home page.
+func (s *Site) ServiceHead(svc *domain.Service) Head {
+	return Head{
+		Title:       svc.Name + " - " + s.profile.Name,
+		Description: svc.Description,
+		Canonical:   s.URL(ServicePath(svc.ID)),
+		Image:       s.URL(ogimage.Path(ogimage.ServiceCard(svc.ID))),
+		ImageAlt:    svc.Name,
+		ImageWidth:  ogimage.Width,
+		ImageHeight: ogimage.Height,
+		Type:        "website",
+		SiteName:    s.profile.Name,
+		Analytics:   s.analytics,
+		StructuredData: &Graph{
+			Context: schemaContext,
+			Nodes:   []any{s.offer(svc)},
+		},
+	}
+}
+
+// ExperienceHead describes an experience's detail page. Its preview shows
+// the first highlight, or the home card when there is none.
+func (s *Site) ExperienceHead(exp *domain.Experience) Head {
+	card := ogimage.HomeCard
+	if len(exp.Achievements) > 0 {
+		card = ogimage.HighlightCard(exp.Achievements[0].ID)
+	}
+
+	return Head{
+		Title:       exp.Position + " at " + exp.CompanyName + " - " + s.profile.Name,
+		Description: exp.Description,
+		Canonical:   s.URL(ExperiencePath(exp.ID)),
+		Image:       s.URL(ogimage.Path(card)),
+		ImageAlt:    exp.Position + " at " + exp.CompanyName,
+		ImageWidth:  ogimage.Width,
+		ImageHeight: ogimage.Height,
+		Type:        "article",
+		SiteName:    s.profile.Name,
+		Analytics:   s.analytics,
+	}
+}
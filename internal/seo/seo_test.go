@@ -0,0 +1,157 @@
+package seo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/testutil"
+)
+
+func newContent(t *testing.T) Content {
+	t.Helper()
+
+	fixtures := testutil.NewExperienceFixtures()
+
+	daily := domain.NewService("Custody Architecture Review", "Independent review of custody setups", domain.ServiceTypeAuditing)
+	daily.ID = "svc-001"
+	testutil.AssertNoError(t, daily.SetPricing(domain.PricingInfo{Type: domain.PricingTypeDaily, Amount: 1400, Currency: "EUR"}))
+	daily.UpdatedAt = testutil.TestTimeNow
+
+	custom := domain.NewService("Tokenization Program", "End-to-end tokenization delivery", domain.ServiceTypeConsulting)
+	custom.ID = "svc-002"
+	testutil.AssertNoError(t, custom.SetPricing(domain.PricingInfo{Type: domain.PricingTypeCustom, Description: "Scoped per engagement"}))
+	custom.UpdatedAt = testutil.TestTimeNow.AddDate(0, 0, 3)
+
+	inactive := domain.NewService("Legacy Audit", "No longer offered", domain.ServiceTypeAuditing)
+	inactive.ID = "svc-003"
+	inactive.IsActive = false
+
+	withTechnologies := fixtures.ExperienceWithTechnologies()
+	withTechnologies.UpdatedAt = testutil.TestTimeNow
+
+	return Content{
+		Experiences: []*domain.Experience{
+			withTechnologies,
+			fixtures.CurrentExperience(),
+			fixtures.CompletedExperience(),
+		},
+		Services: []*domain.Service{daily, custom, inactive},
+	}
+}
+
+func TestSite_URL(t *testing.T) {
+	site := NewSite("https://example.com/", nil, nil)
+
+	testutil.AssertEqual(t, "https://example.com/", site.URL("/"))
+	testutil.AssertEqual(t, "https://example.com/cv.pdf", site.URL("/cv.pdf"))
+	testutil.AssertEqual(t, "https://example.com/sitemap.xml", site.URL("sitemap.xml"))
+}
+
+func TestStructuredData(t *testing.T) {
+	site := NewSite("https://example.com", nil, nil)
+	graph := site.StructuredData(newContent(t))
+
+	testutil.AssertEqual(t, "https://schema.org", graph.Context)
+	testutil.AssertLen(t, graph.Nodes, 2)
+
+	person, ok := graph.Nodes[0].(Person)
+	testutil.AssertTrue(t, ok, "First node should be the Person")
+	testutil.AssertEqual(t, "https://example.com/#person", person.ID)
+	testutil.AssertEqual(t, "mailto:hello@holger-hahn.net", person.Email)
+	testutil.AssertLen(t, person.WorksFor, 2)
+	testutil.AssertLen(t, person.AlumniOf, 1)
+	testutil.AssertEqual(t, "StartupXYZ", person.AlumniOf[0].AlumniOf.Name)
+	testutil.AssertEqual(t, "2021-01-15", person.AlumniOf[0].StartDate)
+	testutil.AssertEqual(t, "2022-01-15", person.AlumniOf[0].EndDate)
+	testutil.AssertEqual(t, "Docker, Go, TypeScript", strings.Join(person.KnowsAbout, ", "))
+
+	business, ok := graph.Nodes[1].(ProfessionalService)
+	testutil.AssertTrue(t, ok, "Second node should be the ProfessionalService")
+	testutil.AssertEqual(t, person.ID, business.Founder.ID)
+
+	offers := business.HasOfferCatalog.ItemListElement
+	testutil.AssertLen(t, offers, 2)
+
+	testutil.AssertEqual(t, 1400.0, offers[0].Price)
+	testutil.AssertEqual(t, "EUR", offers[0].PriceCurrency)
+	testutil.AssertEqual(t, "DAY", offers[0].PriceSpecification.UnitCode)
+	testutil.AssertEqual(t, "https://example.com/services/svc-001/", offers[0].URL)
+	testutil.AssertEqual(t, business.ID, offers[0].ItemOffered.Provider.ID)
+
+	testutil.AssertEqual(t, 0.0, offers[1].Price)
+	testutil.AssertTrue(t, offers[1].PriceSpecification == nil, "Custom pricing should have no price specification")
+	testutil.AssertEqual(t, "Scoped per engagement", offers[1].Description)
+
+	raw, err := json.Marshal(graph)
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, strings.Contains(string(raw), `"@type":"OrganizationRole"`), "JSON-LD should contain organization roles")
+	testutil.AssertTrue(t, !strings.Contains(string(raw), `"price":0`), "Custom offers should not claim a zero price")
+}
+
+func TestHomeHead(t *testing.T) {
+	head := NewSite("https://example.com", nil, nil).HomeHead(Content{})
+
+	testutil.AssertEqual(t, homeTitle, head.Title)
+	testutil.AssertEqual(t, "https://example.com/", head.Canonical)
+	testutil.AssertEqual(t, "https://example.com/og/home.png", head.Image)
+	testutil.AssertTrue(t, head.StructuredData != nil, "Home page should carry structured data")
+}
+
+func TestDetailHeads(t *testing.T) {
+	site := NewSite("https://example.com", nil, nil)
+	content := newContent(t)
+
+	service := site.ServiceHead(content.Services[0])
+	testutil.AssertEqual(t, "https://example.com/services/svc-001/", service.Canonical)
+	testutil.AssertEqual(t, "https://example.com/og/service-svc-001.png", service.Image)
+	testutil.AssertLen(t, service.StructuredData.Nodes, 1)
+
+	experience := site.ExperienceHead(testutil.NewExperienceFixtures().ExperienceWithAchievements())
+	testutil.AssertEqual(t, "Senior Software Engineer at TechCorp Inc - Holger M. Hahn", experience.Title)
+	testutil.AssertEqual(t, "https://example.com/og/highlight-ach-001.png", experience.Image)
+
+	withoutHighlights := site.ExperienceHead(content.Experiences[1])
+	testutil.AssertEqual(t, "https://example.com/og/home.png", withoutHighlights.Image)
+}
+
+func TestSitemap(t *testing.T) {
+	site := NewSite("https://example.com", nil, nil)
+
+	raw, err := MarshalSitemap(site.Sitemap(newContent(t)))
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, strings.HasPrefix(string(raw), "<?xml"), "Sitemap should start with an XML declaration")
+
+	var set URLSet
+	testutil.AssertNoError(t, xml.Unmarshal(raw, &set))
+
+	testutil.AssertEqual(t, sitemapNamespace, set.Xmlns)
+
+	want := []SitemapURL{
+		{Loc: "https://example.com/", LastMod: "2024-01-18"},
+		{Loc: "https://example.com/cv.pdf", LastMod: "2024-01-15"},
+		{Loc: "https://example.com/experiences/exp-001/", LastMod: "2024-01-15"},
+		{Loc: "https://example.com/experiences/exp-002/", LastMod: "2024-01-15"},
+		{Loc: "https://example.com/experiences/exp-003/", LastMod: "2024-01-15"},
+		{Loc: "https://example.com/services/svc-001/", LastMod: "2024-01-15"},
+		{Loc: "https://example.com/services/svc-001.pdf", LastMod: "2024-01-15"},
+		{Loc: "https://example.com/services/svc-002/", LastMod: "2024-01-18"},
+		{Loc: "https://example.com/services/svc-002.pdf", LastMod: "2024-01-18"},
+	}
+
+	testutil.AssertLen(t, set.URLs, len(want))
+
+	for i, url := range want {
+		testutil.AssertEqual(t, url, set.URLs[i])
+	}
+}
+
+func TestRobotsTXT(t *testing.T) {
+	robots := NewSite("https://example.com", nil, nil).RobotsTXT()
+
+	testutil.AssertTrue(t, strings.Contains(robots, "Disallow: /admin\n"), "Admin area should be disallowed")
+	testutil.AssertTrue(t, strings.Contains(robots, "Disallow: /api/\n"), "API should be disallowed")
+	testutil.AssertTrue(t, strings.HasSuffix(robots, "Sitemap: https://example.com/sitemap.xml\n"), "Robots should point at the sitemap")
+}
@@ -0,0 +1,111 @@
+package seo
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// sitemapNamespace is the XML namespace of the sitemaps.org protocol.
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URLSet is the root element of sitemap.xml.
+type URLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []SitemapURL `xml:"url"`
+}
+
+// SitemapURL is one page listed in the sitemap.
+type SitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Sitemap lists the public pages: the home page, the CV, the detail page of
+// every experience and the detail page and one-pager of every active service.
+// Each entry is dated by the newest content it shows.
+func (s *Site) Sitemap(content Content) *URLSet {
+	cvModified := latest(content.Experiences, func(exp *domain.Experience) time.Time { return exp.UpdatedAt })
+	homeModified := cvModified
+
+	var pageURLs []SitemapURL
+
+	for _, exp := range content.Experiences {
+		pageURLs = append(pageURLs, SitemapURL{
+			Loc:     s.URL(ExperiencePath(exp.ID)),
+			LastMod: lastMod(exp.UpdatedAt),
+		})
+	}
+
+	for _, svc := range content.Services {
+		if !svc.IsActive {
+			continue
+		}
+
+		if svc.UpdatedAt.After(homeModified) {
+			homeModified = svc.UpdatedAt
+		}
+
+		pageURLs = append(pageURLs,
+			SitemapURL{Loc: s.URL(ServicePath(svc.ID)), LastMod: lastMod(svc.UpdatedAt)},
+			SitemapURL{Loc: s.URL("/services/" + svc.ID + ".pdf"), LastMod: lastMod(svc.UpdatedAt)},
+		)
+	}
+
+	return &URLSet{
+		Xmlns: sitemapNamespace,
+		URLs: append([]SitemapURL{
+			{Loc: s.URL("/"), LastMod: lastMod(homeModified)},
+			{Loc: s.URL("/cv.pdf"), LastMod: lastMod(cvModified)},
+		}, pageURLs...),
+	}
+}
+
+// MarshalSitemap encodes set as an XML document.
+func MarshalSitemap(set *URLSet) ([]byte, error) {
+	raw, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode sitemap: %w", err)
+	}
+
+	return append([]byte(xml.Header), append(raw, '\n')...), nil
+}
+
+// RobotsTXT allows crawling of the public pages, keeps crawlers out of the
+// admin area and the API, and points them at the sitemap.
+func (s *Site) RobotsTXT() string {
+	var b strings.Builder
+
+	b.WriteString("User-agent: *\n")
+	b.WriteString("Allow: /\n")
+	b.WriteString("Disallow: /admin\n")
+	b.WriteString("Disallow: /api/\n")
+	b.WriteString("\n")
+	b.WriteString("Sitemap: " + s.URL("/sitemap.xml") + "\n")
+
+	return b.String()
+}
+
+func latest[T any](items []T, modified func(T) time.Time) time.Time {
+	var newest time.Time
+
+	for _, item := range items {
+		if t := modified(item); t.After(newest) {
+			newest = t
+		}
+	}
+
+	return newest
+}
+
+func lastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(jsonLDDate)
+}
@@ -4,12 +4,14 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"time"
 
 	"holger-hahn-website/internal/domain"
 	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/telemetry"
 )
 
 // ExperienceService handles business logic for experiences.
@@ -39,26 +41,29 @@ func NewExperienceService(repo repository.ExperienceRepository, techRepo reposit
 }
 
 // CreateExperience creates a new experience with validation.
-func (s *ExperienceService) CreateExperience(ctx context.Context, req CreateExperienceRequest) (*domain.Experience, error) {
+func (s *ExperienceService) CreateExperience(ctx context.Context, req CreateExperienceRequest) (_ *domain.Experience, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.CreateExperience")
+	defer telemetry.End(span, &err)
+
 	// Validate and normalize company name
-	normalizedCompanyName, err := NewStringField("company name", req.CompanyName).Required().MinLength(2).MaxLength(100).Validate()
+	normalizedCompanyName, err := NewStringField("company name", req.CompanyName).Field("company_name").Required().MinLength(2).MaxLength(100).Validate()
 	if err != nil {
 		return nil, err
 	}
 
 	// Validate and normalize position
-	normalizedPosition, err := NewStringField("position", req.Position).Required().MinLength(2).MaxLength(100).Validate()
+	normalizedPosition, err := NewStringField("position", req.Position).Field("position").Required().MinLength(2).MaxLength(100).Validate()
 	if err != nil {
 		return nil, err
 	}
 
 	// Validate and normalize optional fields
-	normalizedLocation, err := NewStringField("location", req.Location).MaxLength(100).Validate()
+	normalizedLocation, err := NewStringField("location", req.Location).Field("location").MaxLength(100).Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	normalizedDescription, err := NewStringField("description", req.Description).MaxLength(2000).Validate()
+	normalizedDescription, err := NewStringField("description", req.Description).Field("description").MaxLength(2000).Validate()
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +103,10 @@ func (s *ExperienceService) CreateExperience(ctx context.Context, req CreateExpe
 }
 
 // GetExperience retrieves an experience by ID.
-func (s *ExperienceService) GetExperience(ctx context.Context, id string) (*domain.Experience, error) {
+func (s *ExperienceService) GetExperience(ctx context.Context, id string) (_ *domain.Experience, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.GetExperience")
+	defer telemetry.End(span, &err)
+
 	if err := s.validator.CommonRequestValidator.ValidateID(id, "experience"); err != nil {
 		return nil, err
 	}
@@ -112,7 +120,10 @@ func (s *ExperienceService) GetExperience(ctx context.Context, id string) (*doma
 }
 
 // ListExperiences retrieves experiences with filtering and pagination.
-func (s *ExperienceService) ListExperiences(ctx context.Context, filter ExperienceFilter) ([]*domain.Experience, error) {
+func (s *ExperienceService) ListExperiences(ctx context.Context, filter ExperienceFilter) (_ []*domain.Experience, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.ListExperiences")
+	defer telemetry.End(span, &err)
+
 	repoFilter := repository.ExperienceFilter{
 		CompanyName: filter.CompanyName,
 		Position:    filter.Position,
@@ -136,8 +147,42 @@ func (s *ExperienceService) ListExperiences(ctx context.Context, filter Experien
 	return experiences, nil
 }
 
+// QueryExperiences returns one ordered page of the experiences matching filter
+// together with the total number of matches. Experiences default to newest first.
+func (s *ExperienceService) QueryExperiences(ctx context.Context, filter ExperienceFilter) (_ *Page[*domain.Experience], err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.QueryExperiences")
+	defer telemetry.End(span, &err)
+
+	if err := s.validator.ValidateListRequest(filter.Limit, filter.Offset, filter.OrderBy, filter.OrderDir, "experience"); err != nil {
+		return nil, err
+	}
+
+	experiences, err := s.repo.List(ctx, repository.ExperienceFilter{
+		CompanyName: filter.CompanyName,
+		Position:    filter.Position,
+		IsRemote:    filter.IsRemote,
+		IsCurrent:   filter.IsCurrent,
+		StartAfter:  filter.StartAfter,
+		EndBefore:   filter.EndBefore,
+		Technology:  filter.Technology,
+		Location:    filter.Location,
+	})
+	if err != nil {
+		return nil, domain.ErrInternal(fmt.Sprintf("failed to list experiences: %v", err))
+	}
+
+	experiences = filterItems(experiences, filter.matches)
+	sortItems(experiences, filter.OrderBy, filter.OrderDir, "start_date", "desc", experienceOrder,
+		func(a, b *domain.Experience) int { return cmp.Compare(a.ID, b.ID) })
+
+	return paginate(experiences, filter.Limit, filter.Offset), nil
+}
+
 // GetCurrentExperiences retrieves all current experiences.
-func (s *ExperienceService) GetCurrentExperiences(ctx context.Context) ([]*domain.Experience, error) {
+func (s *ExperienceService) GetCurrentExperiences(ctx context.Context) (_ []*domain.Experience, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.GetCurrentExperiences")
+	defer telemetry.End(span, &err)
+
 	experiences, err := s.repo.GetCurrent(ctx)
 	if err != nil {
 		return nil, domain.ErrInternal(fmt.Sprintf("failed to get current experiences: %v", err))
@@ -147,7 +192,10 @@ func (s *ExperienceService) GetCurrentExperiences(ctx context.Context) ([]*domai
 }
 
 // AddTechnologyToExperience adds a technology to an experience.
-func (s *ExperienceService) AddTechnologyToExperience(ctx context.Context, experienceID, technologyID string) error {
+func (s *ExperienceService) AddTechnologyToExperience(ctx context.Context, experienceID, technologyID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.AddTechnologyToExperience")
+	defer telemetry.End(span, &err)
+
 	// Get experience
 	experience, err := s.GetExperience(ctx, experienceID)
 	if err != nil {
@@ -178,13 +226,23 @@ func (s *ExperienceService) AddTechnologyToExperience(ctx context.Context, exper
 }
 
 // AddAchievementToExperience adds an achievement to an experience.
-func (s *ExperienceService) AddAchievementToExperience(ctx context.Context, experienceID string, achievement AchievementRequest) error {
+func (s *ExperienceService) AddAchievementToExperience(ctx context.Context, experienceID string, achievement AchievementRequest) (err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.AddAchievementToExperience")
+	defer telemetry.End(span, &err)
+
 	// Get experience
 	experience, err := s.GetExperience(ctx, experienceID)
 	if err != nil {
 		return err
 	}
 
+	title, err := NewStringField("achievement title", achievement.Title).Field("title").Required().MaxLength(200).Validate()
+	if err != nil {
+		return err
+	}
+
+	achievement.Title = title
+
 	// Create achievement
 	var ach *domain.Achievement
 	if achievement.Metrics != nil {
@@ -205,8 +263,32 @@ func (s *ExperienceService) AddAchievementToExperience(ctx context.Context, expe
 	return nil
 }
 
+// RemoveAchievementFromExperience removes an achievement from an experience.
+func (s *ExperienceService) RemoveAchievementFromExperience(ctx context.Context, experienceID, achievementID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.RemoveAchievementFromExperience")
+	defer telemetry.End(span, &err)
+
+	experience, err := s.GetExperience(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+
+	if !experience.RemoveAchievement(achievementID) {
+		return domain.ErrNotFound("achievement")
+	}
+
+	if err := s.repo.Update(ctx, experience); err != nil {
+		return domain.ErrInternal(fmt.Sprintf("failed to remove achievement from experience: %v", err))
+	}
+
+	return nil
+}
+
 // EndExperience sets the end date for an experience.
-func (s *ExperienceService) EndExperience(ctx context.Context, id string, endDate time.Time) error {
+func (s *ExperienceService) EndExperience(ctx context.Context, id string, endDate time.Time) (err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.EndExperience")
+	defer telemetry.End(span, &err)
+
 	experience, err := s.GetExperience(ctx, id)
 	if err != nil {
 		return err
@@ -223,8 +305,109 @@ func (s *ExperienceService) EndExperience(ctx context.Context, id string, endDat
 	return nil
 }
 
+// UpdateExperience updates an existing experience.
+func (s *ExperienceService) UpdateExperience(ctx context.Context, id string, updates ExperienceUpdate) (_ *domain.Experience, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.UpdateExperience")
+	defer telemetry.End(span, &err)
+
+	experience, err := s.GetExperience(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply updates
+	if updates.CompanyName != nil {
+		normalized, err := NewStringField("company name", *updates.CompanyName).Field("company_name").Required().MinLength(2).MaxLength(100).Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		experience.CompanyName = normalized
+	}
+
+	if updates.Position != nil {
+		normalized, err := NewStringField("position", *updates.Position).Field("position").Required().MinLength(2).MaxLength(100).Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		experience.Position = normalized
+	}
+
+	if updates.Description != nil {
+		normalized, err := NewStringField("description", *updates.Description).Field("description").MaxLength(2000).Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		experience.Description = normalized
+	}
+
+	if updates.Location != nil {
+		normalized, err := NewStringField("location", *updates.Location).Field("location").MaxLength(100).Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		experience.Location = normalized
+	}
+
+	if updates.IsRemote != nil {
+		experience.IsRemote = *updates.IsRemote
+	}
+
+	if updates.StartDate != nil {
+		experience.StartDate = *updates.StartDate
+	}
+
+	switch {
+	case updates.Current && updates.EndDate != nil:
+		return nil, domain.ErrInvalidField("end_date", "cannot be set for a current position")
+	case updates.Current:
+		experience.EndDate = nil
+	case updates.EndDate != nil:
+		experience.EndDate = updates.EndDate
+	}
+
+	if err := s.validator.ValidateStartEndDates(experience.StartDate, experience.EndDate); err != nil {
+		return nil, err
+	}
+
+	experience.UpdatedAt = time.Now()
+
+	if err := experience.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, experience); err != nil {
+		return nil, s.errorHandler.Repository.HandleUpdateError("experience", err)
+	}
+
+	return experience, nil
+}
+
+// DeleteExperience removes an experience.
+func (s *ExperienceService) DeleteExperience(ctx context.Context, id string) (err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.DeleteExperience")
+	defer telemetry.End(span, &err)
+
+	// Check if experience exists
+	if _, err := s.GetExperience(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return s.errorHandler.Repository.HandleDeleteError("experience", err)
+	}
+
+	return nil
+}
+
 // GetExperiencesByCompany retrieves experiences for a specific company.
-func (s *ExperienceService) GetExperiencesByCompany(ctx context.Context, companyName string) ([]*domain.Experience, error) {
+func (s *ExperienceService) GetExperiencesByCompany(ctx context.Context, companyName string) (_ []*domain.Experience, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.GetExperiencesByCompany")
+	defer telemetry.End(span, &err)
+
 	if companyName == "" {
 		return nil, domain.ErrInvalidInput("company name cannot be empty")
 	}
@@ -238,7 +421,10 @@ func (s *ExperienceService) GetExperiencesByCompany(ctx context.Context, company
 }
 
 // GetTotalExperience calculates total professional experience duration.
-func (s *ExperienceService) GetTotalExperience(ctx context.Context) (time.Duration, error) {
+func (s *ExperienceService) GetTotalExperience(ctx context.Context) (_ time.Duration, err error) {
+	ctx, span := telemetry.Start(ctx, "ExperienceService.GetTotalExperience")
+	defer telemetry.End(span, &err)
+
 	experiences, err := s.repo.List(ctx, repository.ExperienceFilter{})
 	if err != nil {
 		return 0, domain.ErrInternal(fmt.Sprintf("failed to calculate total experience: %v", err))
@@ -263,6 +449,19 @@ type CreateExperienceRequest struct {
 	IsRemote    bool       `json:"is_remote"`
 }
 
+// ExperienceUpdate represents fields that can be updated. Current clears the
+// end date, making the experience a current position again.
+type ExperienceUpdate struct {
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	CompanyName *string    `json:"company_name,omitempty"`
+	Position    *string    `json:"position,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Location    *string    `json:"location,omitempty"`
+	IsRemote    *bool      `json:"is_remote,omitempty"`
+	Current     bool       `json:"current,omitempty"`
+}
+
 // ExperienceFilter represents filtering options for the service layer.
 type ExperienceFilter struct {
 	CompanyName *string
@@ -278,6 +278,64 @@ func TestExperienceService_ListExperiences(t *testing.T) {
 	})
 }
 
+func TestExperienceService_QueryExperiences(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockExperienceRepo := testutil.NewMockExperienceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewExperienceService(mockExperienceRepo, mockTechRepo)
+	fixtures := testutil.NewExperienceFixtures()
+
+	withTech := fixtures.ExperienceWithTechnologies()
+	mockExperienceRepo.PreloadExperiences([]*domain.Experience{
+		fixtures.CurrentExperience(), withTech, fixtures.CompletedExperience(),
+	})
+
+	t.Run("newest first by default", func(t *testing.T) {
+		page, err := service.QueryExperiences(ctx, ExperienceFilter{})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 3, page.Total)
+		testutil.AssertEqual(t, "exp-002", page.Items[0].ID)
+		testutil.AssertEqual(t, "exp-001", page.Items[1].ID)
+		testutil.AssertEqual(t, "exp-003", page.Items[2].ID)
+	})
+
+	t.Run("filters by technology case-insensitively", func(t *testing.T) {
+		tech := "docker"
+		page, err := service.QueryExperiences(ctx, ExperienceFilter{Technology: &tech})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 1, page.Total)
+		testutil.AssertEqual(t, withTech.ID, page.Items[0].ID)
+	})
+
+	t.Run("filters by date range", func(t *testing.T) {
+		startAfter := testutil.TestTimeNow.AddDate(-4, 0, 0)
+		endBefore := testutil.TestTimeNow.AddDate(-1, 0, 0)
+		page, err := service.QueryExperiences(ctx, ExperienceFilter{StartAfter: &startAfter, EndBefore: &endBefore})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 1, page.Total)
+		testutil.AssertEqual(t, "exp-003", page.Items[0].ID)
+	})
+
+	t.Run("filters by remote and current status", func(t *testing.T) {
+		remote, current := true, true
+		page, err := service.QueryExperiences(ctx, ExperienceFilter{IsRemote: &remote, IsCurrent: &current})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 1, page.Total)
+		testutil.AssertEqual(t, "exp-002", page.Items[0].ID)
+	})
+
+	t.Run("rejects invalid order direction", func(t *testing.T) {
+		orderDir := "sideways"
+		_, err := service.QueryExperiences(ctx, ExperienceFilter{OrderDir: &orderDir})
+
+		testutil.AssertValidationError(t, err)
+	})
+}
+
 func TestExperienceService_GetCurrentExperiences(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	mockExperienceRepo := testutil.NewMockExperienceRepository()
@@ -477,6 +535,153 @@ func TestExperienceService_EndExperience(t *testing.T) {
 	})
 }
 
+func TestExperienceService_UpdateExperience(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockExperienceRepo := testutil.NewMockExperienceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewExperienceService(mockExperienceRepo, mockTechRepo)
+	fixtures := testutil.NewExperienceFixtures()
+
+	t.Run("successful update", func(t *testing.T) {
+		experience := fixtures.ValidExperience()
+		mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+		position := "  Principal Engineer  "
+		remote := true
+		updated, err := service.UpdateExperience(ctx, experience.ID, ExperienceUpdate{
+			Position: &position,
+			IsRemote: &remote,
+		})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "Principal Engineer", updated.Position)
+		testutil.AssertTrue(t, updated.IsRemote, "Expected remote flag to be updated")
+	})
+
+	t.Run("empty position reports field", func(t *testing.T) {
+		experience := fixtures.ValidExperience()
+		mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+		position := "   "
+		_, err := service.UpdateExperience(ctx, experience.ID, ExperienceUpdate{Position: &position})
+
+		testutil.AssertValidationError(t, err)
+
+		domErr, ok := domain.AsDomainError(err)
+		testutil.AssertTrue(t, ok, "Expected domain error")
+		testutil.AssertEqual(t, "position", domErr.Field)
+	})
+
+	t.Run("end date before start date", func(t *testing.T) {
+		experience := fixtures.ValidExperience()
+		mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+		endDate := experience.StartDate.AddDate(-1, 0, 0)
+		_, err := service.UpdateExperience(ctx, experience.ID, ExperienceUpdate{EndDate: &endDate})
+
+		testutil.AssertValidationError(t, err)
+	})
+
+	t.Run("current clears end date", func(t *testing.T) {
+		experience := fixtures.ValidExperience()
+		endDate := experience.StartDate.AddDate(1, 0, 0)
+		experience.EndDate = &endDate
+		mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+		updated, err := service.UpdateExperience(ctx, experience.ID, ExperienceUpdate{Current: true})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertTrue(t, updated.IsCurrent(), "Expected the experience to be current again")
+	})
+
+	t.Run("current with end date", func(t *testing.T) {
+		experience := fixtures.ValidExperience()
+		mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+		endDate := experience.StartDate.AddDate(1, 0, 0)
+		_, err := service.UpdateExperience(ctx, experience.ID, ExperienceUpdate{EndDate: &endDate, Current: true})
+
+		testutil.AssertValidationError(t, err)
+	})
+
+	t.Run("experience not found", func(t *testing.T) {
+		_, err := service.UpdateExperience(ctx, "non-existent-id", ExperienceUpdate{})
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
+func TestExperienceService_RemoveAchievementFromExperience(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockExperienceRepo := testutil.NewMockExperienceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewExperienceService(mockExperienceRepo, mockTechRepo)
+	fixtures := testutil.NewExperienceFixtures()
+
+	experience := fixtures.ValidExperience()
+	mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+	err := service.AddAchievementToExperience(ctx, experience.ID, AchievementRequest{Title: "Improved Performance"})
+	testutil.AssertNoError(t, err)
+
+	stored, err := service.GetExperience(ctx, experience.ID)
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, len(stored.Achievements) > 0, "Expected an achievement to remove")
+
+	achievementID := stored.Achievements[len(stored.Achievements)-1].ID
+
+	t.Run("successful removal", func(t *testing.T) {
+		err := service.RemoveAchievementFromExperience(ctx, experience.ID, achievementID)
+
+		testutil.AssertNoError(t, err)
+
+		updated, err := service.GetExperience(ctx, experience.ID)
+		testutil.AssertNoError(t, err)
+
+		for _, achievement := range updated.Achievements {
+			testutil.AssertNotEqual(t, achievementID, achievement.ID)
+		}
+	})
+
+	t.Run("achievement not found", func(t *testing.T) {
+		err := service.RemoveAchievementFromExperience(ctx, experience.ID, achievementID)
+
+		testutil.AssertNotFoundError(t, err)
+	})
+
+	t.Run("experience not found", func(t *testing.T) {
+		err := service.RemoveAchievementFromExperience(ctx, "non-existent-id", achievementID)
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
+func TestExperienceService_DeleteExperience(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockExperienceRepo := testutil.NewMockExperienceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewExperienceService(mockExperienceRepo, mockTechRepo)
+	fixtures := testutil.NewExperienceFixtures()
+
+	t.Run("successful delete", func(t *testing.T) {
+		experience := fixtures.ValidExperience()
+		mockExperienceRepo.PreloadExperiences([]*domain.Experience{experience})
+
+		err := service.DeleteExperience(ctx, experience.ID)
+
+		testutil.AssertNoError(t, err)
+
+		_, err = service.GetExperience(ctx, experience.ID)
+		testutil.AssertNotFoundError(t, err)
+	})
+
+	t.Run("experience not found", func(t *testing.T) {
+		err := service.DeleteExperience(ctx, "non-existent-id")
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
 func TestExperienceService_GetExperiencesByCompany(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	mockExperienceRepo := testutil.NewMockExperienceRepository()
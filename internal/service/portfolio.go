@@ -4,12 +4,15 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"holger-hahn-website/internal/domain"
 	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/telemetry"
 )
 
 // PortfolioService handles business logic for service offerings.
@@ -39,17 +42,24 @@ func NewPortfolioService(repo repository.ServiceRepository, techRepo repository.
 }
 
 // CreateService creates a new service offering with validation.
-func (s *PortfolioService) CreateService(ctx context.Context, req CreateServiceRequest) (*domain.Service, error) {
+func (s *PortfolioService) CreateService(ctx context.Context, req CreateServiceRequest) (_ *domain.Service, err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.CreateService")
+	defer telemetry.End(span, &err)
+
 	// Normalize input
 	req.Name = strings.TrimSpace(req.Name)
 	req.Description = strings.TrimSpace(req.Description)
 
 	if req.Name == "" {
-		return nil, domain.ErrInvalidInput("service name cannot be empty")
+		return nil, domain.ErrInvalidField("name", "service name cannot be empty")
 	}
 
 	if req.Description == "" {
-		return nil, domain.ErrInvalidInput("service description cannot be empty")
+		return nil, domain.ErrInvalidField("description", "service description cannot be empty")
+	}
+
+	if !req.Category.IsValid() {
+		return nil, domain.ErrInvalidField("category", "invalid service category")
 	}
 
 	// Check if service already exists
@@ -82,7 +92,10 @@ func (s *PortfolioService) CreateService(ctx context.Context, req CreateServiceR
 }
 
 // GetService retrieves a service by ID.
-func (s *PortfolioService) GetService(ctx context.Context, id string) (*domain.Service, error) {
+func (s *PortfolioService) GetService(ctx context.Context, id string) (_ *domain.Service, err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.GetService")
+	defer telemetry.End(span, &err)
+
 	if id == "" {
 		return nil, domain.ErrInvalidInput("service ID cannot be empty")
 	}
@@ -96,7 +109,10 @@ func (s *PortfolioService) GetService(ctx context.Context, id string) (*domain.S
 }
 
 // ListServices retrieves services with filtering and pagination.
-func (s *PortfolioService) ListServices(ctx context.Context, filter ServiceFilter) ([]*domain.Service, error) {
+func (s *PortfolioService) ListServices(ctx context.Context, filter ServiceFilter) (_ []*domain.Service, err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.ListServices")
+	defer telemetry.End(span, &err)
+
 	repoFilter := repository.ServiceFilter{
 		Category:    filter.Category,
 		IsActive:    filter.IsActive,
@@ -118,8 +134,44 @@ func (s *PortfolioService) ListServices(ctx context.Context, filter ServiceFilte
 	return services, nil
 }
 
+// QueryServices returns one ordered page of the services matching filter
+// together with the total number of matches.
+func (s *PortfolioService) QueryServices(ctx context.Context, filter ServiceFilter) (_ *Page[*domain.Service], err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.QueryServices")
+	defer telemetry.End(span, &err)
+
+	if err := s.validator.ValidateListRequest(filter.Limit, filter.Offset, filter.OrderBy, filter.OrderDir, "service"); err != nil {
+		return nil, err
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, domain.ErrInvalidField("min_price", "min_price cannot exceed max_price")
+	}
+
+	services, err := s.repo.List(ctx, repository.ServiceFilter{
+		Category:    filter.Category,
+		IsActive:    filter.IsActive,
+		Technology:  filter.Technology,
+		PricingType: filter.PricingType,
+		MinPrice:    filter.MinPrice,
+		MaxPrice:    filter.MaxPrice,
+	})
+	if err != nil {
+		return nil, domain.ErrInternal(fmt.Sprintf("failed to list services: %v", err))
+	}
+
+	services = filterItems(services, filter.matches)
+	sortItems(services, filter.OrderBy, filter.OrderDir, "name", "asc", serviceOrder,
+		func(a, b *domain.Service) int { return cmp.Compare(a.ID, b.ID) })
+
+	return paginate(services, filter.Limit, filter.Offset), nil
+}
+
 // GetActiveServices retrieves all active services.
-func (s *PortfolioService) GetActiveServices(ctx context.Context) ([]*domain.Service, error) {
+func (s *PortfolioService) GetActiveServices(ctx context.Context) (_ []*domain.Service, err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.GetActiveServices")
+	defer telemetry.End(span, &err)
+
 	services, err := s.repo.GetActive(ctx)
 	if err != nil {
 		return nil, domain.ErrInternal(fmt.Sprintf("failed to get active services: %v", err))
@@ -129,7 +181,10 @@ func (s *PortfolioService) GetActiveServices(ctx context.Context) ([]*domain.Ser
 }
 
 // GetServicesByCategory retrieves services by category.
-func (s *PortfolioService) GetServicesByCategory(ctx context.Context, category domain.ServiceType) ([]*domain.Service, error) {
+func (s *PortfolioService) GetServicesByCategory(ctx context.Context, category domain.ServiceType) (_ []*domain.Service, err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.GetServicesByCategory")
+	defer telemetry.End(span, &err)
+
 	if !category.IsValid() {
 		return nil, domain.ErrInvalidInput("invalid service category")
 	}
@@ -143,7 +198,10 @@ func (s *PortfolioService) GetServicesByCategory(ctx context.Context, category d
 }
 
 // AddTechnologyToService adds a technology to a service.
-func (s *PortfolioService) AddTechnologyToService(ctx context.Context, serviceID, technologyID string) error {
+func (s *PortfolioService) AddTechnologyToService(ctx context.Context, serviceID, technologyID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.AddTechnologyToService")
+	defer telemetry.End(span, &err)
+
 	// Get service
 	service, err := s.GetService(ctx, serviceID)
 	if err != nil {
@@ -174,13 +232,23 @@ func (s *PortfolioService) AddTechnologyToService(ctx context.Context, serviceID
 }
 
 // AddDeliverableToService adds a deliverable to a service.
-func (s *PortfolioService) AddDeliverableToService(ctx context.Context, serviceID string, deliverable DeliverableRequest) error {
+func (s *PortfolioService) AddDeliverableToService(ctx context.Context, serviceID string, deliverable DeliverableRequest) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.AddDeliverableToService")
+	defer telemetry.End(span, &err)
+
 	// Get service
 	service, err := s.GetService(ctx, serviceID)
 	if err != nil {
 		return err
 	}
 
+	name, err := NewStringField("deliverable name", deliverable.Name).Field("name").Required().MaxLength(200).Validate()
+	if err != nil {
+		return err
+	}
+
+	deliverable.Name = name
+
 	// Create deliverable
 	del := domain.NewDeliverable(deliverable.Name, deliverable.Description, deliverable.Timeline)
 	del.ID = generateID()
@@ -195,8 +263,32 @@ func (s *PortfolioService) AddDeliverableToService(ctx context.Context, serviceI
 	return nil
 }
 
+// RemoveDeliverableFromService removes a deliverable from a service.
+func (s *PortfolioService) RemoveDeliverableFromService(ctx context.Context, serviceID, deliverableID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.RemoveDeliverableFromService")
+	defer telemetry.End(span, &err)
+
+	service, err := s.GetService(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	if !service.RemoveDeliverable(deliverableID) {
+		return domain.ErrNotFound("deliverable")
+	}
+
+	if err := s.repo.Update(ctx, service); err != nil {
+		return domain.ErrInternal(fmt.Sprintf("failed to remove deliverable from service: %v", err))
+	}
+
+	return nil
+}
+
 // UpdateServicePricing updates the pricing for a service.
-func (s *PortfolioService) UpdateServicePricing(ctx context.Context, serviceID string, pricing domain.PricingInfo) error {
+func (s *PortfolioService) UpdateServicePricing(ctx context.Context, serviceID string, pricing domain.PricingInfo) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.UpdateServicePricing")
+	defer telemetry.End(span, &err)
+
 	service, err := s.GetService(ctx, serviceID)
 	if err != nil {
 		return err
@@ -214,7 +306,10 @@ func (s *PortfolioService) UpdateServicePricing(ctx context.Context, serviceID s
 }
 
 // ActivateService activates a service.
-func (s *PortfolioService) ActivateService(ctx context.Context, id string) error {
+func (s *PortfolioService) ActivateService(ctx context.Context, id string) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.ActivateService")
+	defer telemetry.End(span, &err)
+
 	service, err := s.GetService(ctx, id)
 	if err != nil {
 		return err
@@ -230,7 +325,10 @@ func (s *PortfolioService) ActivateService(ctx context.Context, id string) error
 }
 
 // DeactivateService deactivates a service.
-func (s *PortfolioService) DeactivateService(ctx context.Context, id string) error {
+func (s *PortfolioService) DeactivateService(ctx context.Context, id string) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.DeactivateService")
+	defer telemetry.End(span, &err)
+
 	service, err := s.GetService(ctx, id)
 	if err != nil {
 		return err
@@ -245,6 +343,84 @@ func (s *PortfolioService) DeactivateService(ctx context.Context, id string) err
 	return nil
 }
 
+// UpdateService updates an existing service offering.
+func (s *PortfolioService) UpdateService(ctx context.Context, id string, updates ServiceUpdate) (_ *domain.Service, err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.UpdateService")
+	defer telemetry.End(span, &err)
+
+	service, err := s.GetService(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply updates
+	if updates.Name != nil {
+		name := strings.TrimSpace(*updates.Name)
+		if name == "" {
+			return nil, domain.ErrInvalidField("name", "service name cannot be empty")
+		}
+
+		if name != service.Name {
+			existing, err := s.repo.GetByName(ctx, name)
+			if err == nil && existing != nil && existing.ID != service.ID {
+				return nil, domain.ErrConflict(fmt.Sprintf("service '%s' already exists", name))
+			}
+		}
+
+		service.Name = name
+	}
+
+	if updates.Description != nil {
+		description := strings.TrimSpace(*updates.Description)
+		if description == "" {
+			return nil, domain.ErrInvalidField("description", "service description cannot be empty")
+		}
+
+		service.Description = description
+	}
+
+	if updates.Category != nil {
+		if !updates.Category.IsValid() {
+			return nil, domain.ErrInvalidField("category", "invalid service category")
+		}
+
+		service.Category = *updates.Category
+	}
+
+	if updates.Duration != nil {
+		service.Duration = strings.TrimSpace(*updates.Duration)
+	}
+
+	service.UpdatedAt = time.Now()
+
+	if err := service.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, service); err != nil {
+		return nil, domain.ErrInternal(fmt.Sprintf("failed to update service: %v", err))
+	}
+
+	return service, nil
+}
+
+// DeleteService removes a service offering.
+func (s *PortfolioService) DeleteService(ctx context.Context, id string) (err error) {
+	ctx, span := telemetry.Start(ctx, "PortfolioService.DeleteService")
+	defer telemetry.End(span, &err)
+
+	// Check if service exists
+	if _, err := s.GetService(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return domain.ErrInternal(fmt.Sprintf("failed to delete service: %v", err))
+	}
+
+	return nil
+}
+
 // CreateServiceRequest represents a request to create a service.
 type CreateServiceRequest struct {
 	Pricing     *domain.PricingInfo `json:"pricing,omitempty"`
@@ -254,6 +430,14 @@ type CreateServiceRequest struct {
 	Duration    string              `json:"duration,omitempty"`
 }
 
+// ServiceUpdate represents fields that can be updated.
+type ServiceUpdate struct {
+	Name        *string             `json:"name,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Category    *domain.ServiceType `json:"category,omitempty"`
+	Duration    *string             `json:"duration,omitempty"`
+}
+
 // ServiceFilter represents filtering options for the service layer.
 type ServiceFilter struct {
 	Category    *domain.ServiceType
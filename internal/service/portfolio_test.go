@@ -250,6 +250,61 @@ func TestPortfolioService_ListServices(t *testing.T) {
 	})
 }
 
+func TestPortfolioService_QueryServices(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockServiceRepo := testutil.NewMockServiceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewPortfolioService(mockServiceRepo, mockTechRepo)
+
+	audit := domain.NewService("Smart Contract Audit", "Security review", domain.ServiceTypeAuditing)
+	audit.ID = "svc-audit"
+	audit.AddTechnology(domain.Technology{Name: "Solidity"})
+	testutil.AssertNoError(t, audit.SetPricing(domain.PricingInfo{Type: domain.PricingTypeDaily, Amount: 1200}))
+
+	coaching := domain.NewService("Architecture Coaching", "Team coaching", domain.ServiceTypeConsulting)
+	coaching.ID = "svc-coaching"
+	coaching.Deactivate()
+
+	for _, svc := range []*domain.Service{audit, coaching} {
+		testutil.AssertNoError(t, mockServiceRepo.Create(ctx, svc))
+	}
+
+	t.Run("orders by name", func(t *testing.T) {
+		page, err := service.QueryServices(ctx, ServiceFilter{})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 2, page.Total)
+		testutil.AssertEqual(t, coaching.ID, page.Items[0].ID)
+		testutil.AssertEqual(t, audit.ID, page.Items[1].ID)
+	})
+
+	t.Run("filters by technology and price range", func(t *testing.T) {
+		tech := "solidity"
+		minPrice, maxPrice := 1000.0, 1500.0
+		page, err := service.QueryServices(ctx, ServiceFilter{Technology: &tech, MinPrice: &minPrice, MaxPrice: &maxPrice})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 1, page.Total)
+		testutil.AssertEqual(t, audit.ID, page.Items[0].ID)
+	})
+
+	t.Run("filters by active status", func(t *testing.T) {
+		active := false
+		page, err := service.QueryServices(ctx, ServiceFilter{IsActive: &active})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 1, page.Total)
+		testutil.AssertEqual(t, coaching.ID, page.Items[0].ID)
+	})
+
+	t.Run("rejects inverted price range", func(t *testing.T) {
+		minPrice, maxPrice := 2000.0, 1000.0
+		_, err := service.QueryServices(ctx, ServiceFilter{MinPrice: &minPrice, MaxPrice: &maxPrice})
+
+		testutil.AssertValidationError(t, err)
+	})
+}
+
 func TestPortfolioService_GetActiveServices(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	mockServiceRepo := testutil.NewMockServiceRepository()
@@ -407,6 +462,102 @@ func TestPortfolioService_DeactivateService(t *testing.T) {
 	})
 }
 
+func TestPortfolioService_UpdateService(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockServiceRepo := testutil.NewMockServiceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewPortfolioService(mockServiceRepo, mockTechRepo)
+
+	created, err := service.CreateService(ctx, CreateServiceRequest{
+		Name:        "Architecture Review",
+		Description: "Review of distributed system architecture",
+		Category:    domain.ServiceTypeArchitecture,
+	})
+	testutil.AssertNoError(t, err)
+
+	t.Run("successful update", func(t *testing.T) {
+		duration := "1 week"
+		category := domain.ServiceTypeAuditing
+		updated, err := service.UpdateService(ctx, created.ID, ServiceUpdate{
+			Duration: &duration,
+			Category: &category,
+		})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, "1 week", updated.Duration)
+		testutil.AssertEqual(t, domain.ServiceTypeAuditing, updated.Category)
+	})
+
+	t.Run("invalid category reports field", func(t *testing.T) {
+		category := domain.ServiceType("invalid")
+		_, err := service.UpdateService(ctx, created.ID, ServiceUpdate{Category: &category})
+
+		testutil.AssertValidationError(t, err)
+
+		domErr, ok := domain.AsDomainError(err)
+		testutil.AssertTrue(t, ok, "Expected domain error")
+		testutil.AssertEqual(t, "category", domErr.Field)
+	})
+
+	t.Run("service not found", func(t *testing.T) {
+		_, err := service.UpdateService(ctx, "non-existent-service", ServiceUpdate{})
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
+func TestPortfolioService_RemoveDeliverableFromService(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockServiceRepo := testutil.NewMockServiceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewPortfolioService(mockServiceRepo, mockTechRepo)
+
+	created, err := service.CreateService(ctx, CreateServiceRequest{
+		Name:        "Smart Contract Audit",
+		Description: "Security review of smart contracts",
+		Category:    domain.ServiceTypeAuditing,
+	})
+	testutil.AssertNoError(t, err)
+
+	err = service.AddDeliverableToService(ctx, created.ID, DeliverableRequest{Name: "Audit Report"})
+	testutil.AssertNoError(t, err)
+
+	stored, err := service.GetService(ctx, created.ID)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, len(stored.Deliverables))
+
+	deliverableID := stored.Deliverables[0].ID
+
+	t.Run("successful removal", func(t *testing.T) {
+		err := service.RemoveDeliverableFromService(ctx, created.ID, deliverableID)
+
+		testutil.AssertNoError(t, err)
+
+		updated, err := service.GetService(ctx, created.ID)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 0, len(updated.Deliverables))
+	})
+
+	t.Run("deliverable not found", func(t *testing.T) {
+		err := service.RemoveDeliverableFromService(ctx, created.ID, deliverableID)
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
+func TestPortfolioService_DeleteService(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockServiceRepo := testutil.NewMockServiceRepository()
+	mockTechRepo := testutil.NewMockTechnologyRepository()
+	service := NewPortfolioService(mockServiceRepo, mockTechRepo)
+
+	t.Run("service not found", func(t *testing.T) {
+		err := service.DeleteService(ctx, "non-existent-service")
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
 // Test service filter validation.
 func TestServiceFilter_Validation(t *testing.T) {
 	t.Run("valid filters", func(t *testing.T) {
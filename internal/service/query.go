@@ -0,0 +1,208 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"holger-hahn-website/internal/domain"
+)
+
+// Page is one window of a filtered and ordered result set.
+type Page[T any] struct {
+	Items []T
+	Pagination
+}
+
+// HasMore reports whether further items follow this page.
+func (p *Page[T]) HasMore() bool {
+	return p.Offset+len(p.Items) < p.Total
+}
+
+// paginate cuts the requested window out of items and records the total.
+func paginate[T any](items []T, limit, offset *int) *Page[T] {
+	var l, o int
+	if limit != nil {
+		l = *limit
+	}
+
+	if offset != nil {
+		o = *offset
+	}
+
+	pagination := NewPagination(l, o)
+	pagination.Total = len(items)
+
+	start := min(pagination.Offset, len(items))
+	end := min(start+pagination.Limit, len(items))
+
+	return &Page[T]{
+		Items:      items[start:end],
+		Pagination: *pagination,
+	}
+}
+
+// filterItems returns the items for which keep reports true.
+func filterItems[T any](items []T, keep func(T) bool) []T {
+	result := make([]T, 0, len(items))
+
+	for _, item := range items {
+		if keep(item) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// comparator orders two items by a single field.
+type comparator[T any] func(a, b T) int
+
+// sortItems orders items by the requested field, falling back to defaultField.
+// Ties are broken by tieBreak so that consecutive pages never overlap.
+func sortItems[T any](items []T, orderBy, orderDir *string, defaultField, defaultDir string, fields map[string]comparator[T], tieBreak comparator[T]) {
+	field, dir := defaultField, defaultDir
+	if orderBy != nil {
+		field = *orderBy
+		dir = "asc"
+	}
+
+	if orderDir != nil {
+		dir = *orderDir
+	}
+
+	ordering := NewOrdering(field, dir)
+	compare := fields[ordering.OrderBy]
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		result := compare(a, b)
+		if ordering.OrderDir == "desc" {
+			result = -result
+		}
+
+		if result == 0 {
+			return tieBreak(a, b)
+		}
+
+		return result
+	})
+}
+
+// compareTimes orders two optional timestamps, treating nil as the latest.
+func compareTimes(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return a.Compare(*b)
+	}
+}
+
+// compareFold orders two strings case-insensitively.
+func compareFold(a, b string) int {
+	return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// usesTechnology reports whether name matches one of the given technologies.
+func usesTechnology(technologies []domain.Technology, name string) bool {
+	for _, tech := range technologies {
+		if strings.EqualFold(tech.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// levelRank orders proficiency levels from beginner to expert.
+func levelRank(level domain.Level) int {
+	return slices.Index(domain.AllLevels(), level)
+}
+
+// matches applies the technology filter criteria.
+func (f TechnologyFilter) matches(tech *domain.Technology) bool {
+	if f.Category != nil && tech.Category != *f.Category {
+		return false
+	}
+
+	if f.Level != nil && tech.Level != *f.Level {
+		return false
+	}
+
+	return true
+}
+
+// matches applies the experience filter criteria.
+func (f ExperienceFilter) matches(exp *domain.Experience) bool {
+	switch {
+	case f.CompanyName != nil && !strings.EqualFold(exp.CompanyName, *f.CompanyName):
+		return false
+	case f.Position != nil && !strings.EqualFold(exp.Position, *f.Position):
+		return false
+	case f.Location != nil && !strings.EqualFold(exp.Location, *f.Location):
+		return false
+	case f.IsRemote != nil && exp.IsRemote != *f.IsRemote:
+		return false
+	case f.IsCurrent != nil && exp.IsCurrent() != *f.IsCurrent:
+		return false
+	case f.StartAfter != nil && exp.StartDate.Before(*f.StartAfter):
+		return false
+	case f.EndBefore != nil && (exp.EndDate == nil || exp.EndDate.After(*f.EndBefore)):
+		return false
+	case f.Technology != nil && !usesTechnology(exp.Technologies, *f.Technology):
+		return false
+	}
+
+	return true
+}
+
+// matches applies the service filter criteria.
+func (f ServiceFilter) matches(svc *domain.Service) bool {
+	switch {
+	case f.Category != nil && svc.Category != *f.Category:
+		return false
+	case f.IsActive != nil && svc.IsActive != *f.IsActive:
+		return false
+	case f.Technology != nil && !usesTechnology(svc.Technologies, *f.Technology):
+		return false
+	case f.PricingType != nil && (svc.Pricing == nil || svc.Pricing.Type != *f.PricingType):
+		return false
+	case f.MinPrice != nil && (svc.Pricing == nil || svc.Pricing.Amount < *f.MinPrice):
+		return false
+	case f.MaxPrice != nil && (svc.Pricing == nil || svc.Pricing.Amount > *f.MaxPrice):
+		return false
+	}
+
+	return true
+}
+
+var technologyOrder = map[string]comparator[*domain.Technology]{
+	"name":     func(a, b *domain.Technology) int { return compareFold(a.Name, b.Name) },
+	"category": func(a, b *domain.Technology) int { return compareFold(a.Category, b.Category) },
+	"level": func(a, b *domain.Technology) int {
+		return cmp.Compare(levelRank(a.Level), levelRank(b.Level))
+	},
+	"created_at": func(a, b *domain.Technology) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"updated_at": func(a, b *domain.Technology) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+}
+
+var experienceOrder = map[string]comparator[*domain.Experience]{
+	"start_date":   func(a, b *domain.Experience) int { return a.StartDate.Compare(b.StartDate) },
+	"end_date":     func(a, b *domain.Experience) int { return compareTimes(a.EndDate, b.EndDate) },
+	"company_name": func(a, b *domain.Experience) int { return compareFold(a.CompanyName, b.CompanyName) },
+	"position":     func(a, b *domain.Experience) int { return compareFold(a.Position, b.Position) },
+	"created_at":   func(a, b *domain.Experience) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"updated_at":   func(a, b *domain.Experience) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+}
+
+var serviceOrder = map[string]comparator[*domain.Service]{
+	"name":       func(a, b *domain.Service) int { return compareFold(a.Name, b.Name) },
+	"category":   func(a, b *domain.Service) int { return cmp.Compare(a.Category, b.Category) },
+	"created_at": func(a, b *domain.Service) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"updated_at": func(a, b *domain.Service) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+}
@@ -4,11 +4,13 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"time"
 
 	"holger-hahn-website/internal/domain"
 	"holger-hahn-website/internal/repository"
+	"holger-hahn-website/internal/telemetry"
 )
 
 // TechnologyService handles business logic for technologies.
@@ -33,13 +35,32 @@ func NewTechnologyService(repo repository.TechnologyRepository) *TechnologyServi
 
 // CreateTechnology creates a new technology with validation.
 func (s *TechnologyService) CreateTechnology(ctx context.Context, name, category string, level domain.Level) (*domain.Technology, error) {
+	return s.CreateTechnologyFromRequest(ctx, CreateTechnologyRequest{Name: name, Category: category, Level: level})
+}
+
+// CreateTechnologyFromRequest creates a new technology with its optional
+// fields. Everything is validated before the technology is stored, so a
+// rejected request leaves nothing behind.
+func (s *TechnologyService) CreateTechnologyFromRequest(ctx context.Context, req CreateTechnologyRequest) (_ *domain.Technology, err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.CreateTechnology")
+	defer telemetry.End(span, &err)
+
 	// Validate and normalize input
-	normalizedName, err := NewStringField("technology name", name).Required().MinLength(2).MaxLength(100).Validate()
+	normalizedName, err := NewStringField("technology name", req.Name).Field("name").Required().MinLength(2).MaxLength(100).Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	normalizedCategory, err := NewStringField("category", category).Required().MaxLength(50).Validate()
+	normalizedCategory, err := NewStringField("category", req.Category).Field("category").Required().MaxLength(50).Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Level.IsValid() {
+		return nil, domain.ErrInvalidField("level", "invalid technology level")
+	}
+
+	description, err := NewStringField("description", req.Description).Field("description").MaxLength(2000).Validate()
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +72,10 @@ func (s *TechnologyService) CreateTechnology(ctx context.Context, name, category
 	}
 
 	// Create new technology
-	tech := domain.NewTechnology(normalizedName, normalizedCategory, level)
+	tech := domain.NewTechnology(normalizedName, normalizedCategory, req.Level)
 	tech.ID = generateID()
+	tech.Description = description
+	tech.IconURL = req.IconURL
 
 	if err := tech.Validate(); err != nil {
 		return nil, err
@@ -66,7 +89,10 @@ func (s *TechnologyService) CreateTechnology(ctx context.Context, name, category
 }
 
 // GetTechnology retrieves a technology by ID.
-func (s *TechnologyService) GetTechnology(ctx context.Context, id string) (*domain.Technology, error) {
+func (s *TechnologyService) GetTechnology(ctx context.Context, id string) (_ *domain.Technology, err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.GetTechnology")
+	defer telemetry.End(span, &err)
+
 	if err := s.validator.CommonRequestValidator.ValidateID(id, "technology"); err != nil {
 		return nil, err
 	}
@@ -80,7 +106,10 @@ func (s *TechnologyService) GetTechnology(ctx context.Context, id string) (*doma
 }
 
 // ListTechnologies retrieves technologies with filtering and pagination.
-func (s *TechnologyService) ListTechnologies(ctx context.Context, filter TechnologyFilter) ([]*domain.Technology, error) {
+func (s *TechnologyService) ListTechnologies(ctx context.Context, filter TechnologyFilter) (_ []*domain.Technology, err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.ListTechnologies")
+	defer telemetry.End(span, &err)
+
 	// Validate list request parameters
 	if err := s.validator.ValidateListRequest(filter.Limit, filter.Offset, filter.OrderBy, filter.OrderDir, "technology"); err != nil {
 		return nil, err
@@ -103,8 +132,36 @@ func (s *TechnologyService) ListTechnologies(ctx context.Context, filter Technol
 	return technologies, nil
 }
 
+// QueryTechnologies returns one ordered page of the technologies matching filter
+// together with the total number of matches.
+func (s *TechnologyService) QueryTechnologies(ctx context.Context, filter TechnologyFilter) (_ *Page[*domain.Technology], err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.QueryTechnologies")
+	defer telemetry.End(span, &err)
+
+	if err := s.validator.ValidateListRequest(filter.Limit, filter.Offset, filter.OrderBy, filter.OrderDir, "technology"); err != nil {
+		return nil, err
+	}
+
+	technologies, err := s.repo.List(ctx, repository.TechnologyFilter{
+		Category: filter.Category,
+		Level:    filter.Level,
+	})
+	if err != nil {
+		return nil, s.errorHandler.Basic.HandleRepositoryListError("technologies", err)
+	}
+
+	technologies = filterItems(technologies, filter.matches)
+	sortItems(technologies, filter.OrderBy, filter.OrderDir, "name", "asc", technologyOrder,
+		func(a, b *domain.Technology) int { return cmp.Compare(a.ID, b.ID) })
+
+	return paginate(technologies, filter.Limit, filter.Offset), nil
+}
+
 // UpdateTechnology updates an existing technology.
-func (s *TechnologyService) UpdateTechnology(ctx context.Context, id string, updates TechnologyUpdate) (*domain.Technology, error) {
+func (s *TechnologyService) UpdateTechnology(ctx context.Context, id string, updates TechnologyUpdate) (_ *domain.Technology, err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.UpdateTechnology")
+	defer telemetry.End(span, &err)
+
 	tech, err := s.GetTechnology(ctx, id)
 	if err != nil {
 		return nil, err
@@ -112,6 +169,10 @@ func (s *TechnologyService) UpdateTechnology(ctx context.Context, id string, upd
 
 	// Apply updates
 	if updates.Level != nil {
+		if !updates.Level.IsValid() {
+			return nil, domain.ErrInvalidField("level", "invalid technology level")
+		}
+
 		if err := tech.UpdateLevel(*updates.Level); err != nil {
 			return nil, err
 		}
@@ -139,14 +200,16 @@ func (s *TechnologyService) UpdateTechnology(ctx context.Context, id string, upd
 }
 
 // DeleteTechnology removes a technology.
-func (s *TechnologyService) DeleteTechnology(ctx context.Context, id string) error {
+func (s *TechnologyService) DeleteTechnology(ctx context.Context, id string) (err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.DeleteTechnology")
+	defer telemetry.End(span, &err)
+
 	if err := s.validator.CommonRequestValidator.ValidateID(id, "technology"); err != nil {
 		return err
 	}
 
 	// Check if technology exists
-	_, err := s.GetTechnology(ctx, id)
-	if err != nil {
+	if _, err := s.GetTechnology(ctx, id); err != nil {
 		return err
 	}
 
@@ -158,7 +221,10 @@ func (s *TechnologyService) DeleteTechnology(ctx context.Context, id string) err
 }
 
 // GetTechnologiesByCategory retrieves technologies filtered by category.
-func (s *TechnologyService) GetTechnologiesByCategory(ctx context.Context, category string) ([]*domain.Technology, error) {
+func (s *TechnologyService) GetTechnologiesByCategory(ctx context.Context, category string) (_ []*domain.Technology, err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.GetTechnologiesByCategory")
+	defer telemetry.End(span, &err)
+
 	if err := s.validator.CommonRequestValidator.ValidateNonEmpty(category, "category"); err != nil {
 		return nil, err
 	}
@@ -172,7 +238,10 @@ func (s *TechnologyService) GetTechnologiesByCategory(ctx context.Context, categ
 }
 
 // GetTechnologiesByLevel retrieves technologies filtered by proficiency level.
-func (s *TechnologyService) GetTechnologiesByLevel(ctx context.Context, level domain.Level) ([]*domain.Technology, error) {
+func (s *TechnologyService) GetTechnologiesByLevel(ctx context.Context, level domain.Level) (_ []*domain.Technology, err error) {
+	ctx, span := telemetry.Start(ctx, "TechnologyService.GetTechnologiesByLevel")
+	defer telemetry.End(span, &err)
+
 	if !level.IsValid() {
 		return nil, domain.ErrInvalidInput("invalid technology level")
 	}
@@ -195,9 +264,18 @@ type TechnologyFilter struct {
 	OrderDir *string
 }
 
+// CreateTechnologyRequest represents a request to create a technology.
+type CreateTechnologyRequest struct {
+	Name        string       `json:"name"`
+	Category    string       `json:"category"`
+	Level       domain.Level `json:"level"`
+	Description string       `json:"description,omitempty"`
+	IconURL     string       `json:"icon_url,omitempty"`
+}
+
 // TechnologyUpdate represents fields that can be updated.
 type TechnologyUpdate struct {
-	Level       *domain.Level
-	Description *string
-	IconURL     *string
+	Level       *domain.Level `json:"level,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	IconURL     *string       `json:"icon_url,omitempty"`
 }
@@ -200,6 +200,53 @@ func TestTechnologyService_ListTechnologies(t *testing.T) {
 	})
 }
 
+func TestTechnologyService_QueryTechnologies(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	mockRepo := testutil.NewMockTechnologyRepository()
+	service := NewTechnologyService(mockRepo)
+	fixtures := testutil.NewTechnologyFixtures()
+	mockRepo.PreloadTechnologies(fixtures.TechnologiesList())
+
+	t.Run("orders by name and reports the total", func(t *testing.T) {
+		limit := 2
+		page, err := service.QueryTechnologies(ctx, TechnologyFilter{Limit: &limit})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 5, page.Total)
+		testutil.AssertLen(t, page.Items, 2)
+		testutil.AssertEqual(t, "Docker", page.Items[0].Name)
+		testutil.AssertEqual(t, "Go", page.Items[1].Name)
+		testutil.AssertTrue(t, page.HasMore(), "Should have more pages")
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		limit, offset := 2, 4
+		page, err := service.QueryTechnologies(ctx, TechnologyFilter{Limit: &limit, Offset: &offset})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertLen(t, page.Items, 1)
+		testutil.AssertEqual(t, "TypeScript", page.Items[0].Name)
+		testutil.AssertFalse(t, page.HasMore(), "Should be the last page")
+	})
+
+	t.Run("orders by level descending within a category", func(t *testing.T) {
+		category, orderBy, orderDir := "DevOps", "level", "desc"
+		page, err := service.QueryTechnologies(ctx, TechnologyFilter{Category: &category, OrderBy: &orderBy, OrderDir: &orderDir})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 2, page.Total)
+		testutil.AssertEqual(t, "Docker", page.Items[0].Name)
+		testutil.AssertEqual(t, "Kubernetes", page.Items[1].Name)
+	})
+
+	t.Run("rejects unknown order field", func(t *testing.T) {
+		orderBy := "popularity"
+		_, err := service.QueryTechnologies(ctx, TechnologyFilter{OrderBy: &orderBy})
+
+		testutil.AssertValidationError(t, err)
+	})
+}
+
 func TestTechnologyService_UpdateTechnology(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	mockRepo := testutil.NewMockTechnologyRepository()
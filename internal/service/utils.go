@@ -6,6 +6,7 @@ package service
 import (
 	"crypto/rand"
 	"fmt"
+	"slices"
 	"time"
 )
 
@@ -94,24 +95,19 @@ func NewOrdering(orderBy, orderDir string) *Ordering {
 	}
 }
 
-// ValidateOrderBy checks if the order by field is valid for a given entity type.
-func ValidateOrderBy(entityType, orderBy string) bool {
-	validFields := map[string][]string{
-		"technology": {"name", "category", "level", "created_at", "updated_at"},
-		"experience": {"start_date", "end_date", "company_name", "position", "created_at", "updated_at"},
-		"service":    {"name", "category", "created_at", "updated_at"},
-	}
-
-	fields, exists := validFields[entityType]
-	if !exists {
-		return false
-	}
+// orderFields lists the fields each entity type can be ordered by.
+var orderFields = map[string][]string{
+	"technology": {"name", "category", "level", "created_at", "updated_at"},
+	"experience": {"start_date", "end_date", "company_name", "position", "created_at", "updated_at"},
+	"service":    {"name", "category", "created_at", "updated_at"},
+}
 
-	for _, field := range fields {
-		if field == orderBy {
-			return true
-		}
-	}
+// OrderFields returns the fields a given entity type can be ordered by.
+func OrderFields(entityType string) []string {
+	return slices.Clone(orderFields[entityType])
+}
 
-	return false
+// ValidateOrderBy checks if the order by field is valid for a given entity type.
+func ValidateOrderBy(entityType, orderBy string) bool {
+	return slices.Contains(orderFields[entityType], orderBy)
 }
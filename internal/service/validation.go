@@ -23,6 +23,7 @@ func NewInputValidator() *InputValidator {
 // ValidateStringField validates and normalizes a string field.
 type StringFieldValidator struct {
 	fieldName string
+	field     string
 	value     string
 	required  bool
 	minLength int
@@ -37,6 +38,12 @@ func NewStringField(fieldName, value string) *StringFieldValidator {
 	}
 }
 
+// Field sets the request field name reported with validation errors.
+func (s *StringFieldValidator) Field(field string) *StringFieldValidator {
+	s.field = field
+	return s
+}
+
 // Required marks the field as required.
 func (s *StringFieldValidator) Required() *StringFieldValidator {
 	s.required = true
@@ -62,22 +69,31 @@ func (s *StringFieldValidator) Validate() (string, error) {
 
 	// Check if required
 	if s.required && normalized == "" {
-		return "", domain.ErrInvalidInput(s.fieldName + " cannot be empty")
+		return "", s.invalid(s.fieldName + " cannot be empty")
 	}
 
 	// Check minimum length
 	if s.minLength > 0 && len(normalized) > 0 && len(normalized) < s.minLength {
-		return "", domain.ErrInvalidInput(fmt.Sprintf("%s must be at least %d characters long", s.fieldName, s.minLength))
+		return "", s.invalid(fmt.Sprintf("%s must be at least %d characters long", s.fieldName, s.minLength))
 	}
 
 	// Check maximum length
 	if s.maxLength > 0 && len(normalized) > s.maxLength {
-		return "", domain.ErrInvalidInput(fmt.Sprintf("%s must be less than %d characters", s.fieldName, s.maxLength))
+		return "", s.invalid(fmt.Sprintf("%s must be less than %d characters", s.fieldName, s.maxLength))
 	}
 
 	return normalized, nil
 }
 
+// invalid builds a validation error, tagged with the request field when one is set.
+func (s *StringFieldValidator) invalid(message string) error {
+	if s.field != "" {
+		return domain.ErrInvalidField(s.field, message)
+	}
+
+	return domain.ErrInvalidInput(message)
+}
+
 // CommonRequestValidator validates common request patterns across services.
 type CommonRequestValidator struct {
 	*InputValidator
@@ -0,0 +1,71 @@
+package sitebuild
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"holger-hahn-website/internal/assets"
+	"holger-hahn-website/internal/security"
+)
+
+// staticDir holds the assets served under /static/.
+const staticDir = "static"
+
+// publishAssets publishes every file below src under /static/, both under its
+// own name and under its fingerprinted name, and writes the manifest mapping
+// one to the other. The unhashed copies keep URLs working that templates do
+// not resolve, such as those inside stylesheets.
+func publishAssets(out *publisher, src string) (assets.Manifest, error) {
+	manifest := assets.Manifest{}
+
+	err := filepath.WalkDir(src, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+
+		validated, err := security.ValidateFilePath(file, src)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(validated) // #nosec G304 - Path validated above
+		if err != nil {
+			return err
+		}
+
+		urlPath := path.Join("/", staticDir, filepath.ToSlash(rel))
+		hashed := assets.Fingerprint(urlPath, data)
+
+		err = out.write(urlPath, data)
+		if err != nil {
+			return err
+		}
+
+		err = out.write(hashed, data)
+		if err != nil {
+			return err
+		}
+
+		manifest[urlPath] = hashed
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, out.write(assets.ManifestFile, append(raw, '\n'))
+}
@@ -0,0 +1,46 @@
+package sitebuild
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/sitecheck"
+)
+
+// errCheckFailed is returned when the site check finds issues.
+var errCheckFailed = errors.New("site check failed")
+
+// checkSite checks the site in publicDir, writes the report to reportFile and
+// fails when there are issues.
+func checkSite(publicDir, baseURL, reportFile string) error {
+	report, err := sitecheck.Check(publicDir, sitecheck.Options{BaseURL: baseURL})
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(reportFile, append(raw, '\n'), constants.PublicFilePerms)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range report.Issues {
+		log.Printf("%s: %s: %s", issue.Page, issue.Rule, issue.Message)
+	}
+
+	log.Printf("Checked %d pages, %d issues, report in %s", report.Pages, len(report.Issues), reportFile)
+
+	if !report.OK() {
+		return fmt.Errorf("%w: %d issues", errCheckFailed, len(report.Issues))
+	}
+
+	return nil
+}
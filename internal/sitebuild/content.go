@@ -0,0 +1,77 @@
+package sitebuild
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/seo"
+	"holger-hahn-website/internal/service"
+)
+
+// siteContent is everything the static pages are rendered from.
+type siteContent struct {
+	seo.Content
+
+	// Current holds the experiences the home page shows, as on the live site.
+	Current      []*domain.Experience
+	Technologies []*domain.Technology
+}
+
+// loadContent reads the content from the repositories and validates it.
+func loadContent(ctx context.Context, di *container.Container) (*siteContent, error) {
+	content, err := container.MustGet[*seo.Site](di).Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := container.MustGet[*service.ExperienceService](di).GetCurrentExperiences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	technologies, err := container.MustGet[*service.TechnologyService](di).ListTechnologies(ctx, service.TechnologyFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	site := &siteContent{Content: content, Current: current, Technologies: technologies}
+
+	if err := site.validate(); err != nil {
+		return nil, err
+	}
+
+	return site, nil
+}
+
+// validate checks every piece of content with its domain rules and reports
+// all failures at once.
+func (c *siteContent) validate() error {
+	var errs []error
+
+	for _, exp := range c.Experiences {
+		if err := exp.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("experience %s: %w", exp.ID, err))
+		}
+	}
+
+	for _, svc := range c.Services {
+		if err := svc.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("service %s: %w", svc.ID, err))
+		}
+	}
+
+	for _, tech := range c.Technologies {
+		if err := tech.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("technology %s: %w", tech.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid content:\n%w", errors.Join(errs...))
+	}
+
+	return nil
+}
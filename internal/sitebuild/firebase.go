@@ -0,0 +1,89 @@
+package sitebuild
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"holger-hahn-website/internal/assets"
+	"holger-hahn-website/internal/constants"
+)
+
+// firebaseConfigFile is the Firebase Hosting configuration in the project root.
+const firebaseConfigFile = "firebase.json"
+
+// Cache policies for the deployed files. Fingerprinted assets never change
+// under their name; documents are revalidated on every request so that a
+// deploy is visible at once.
+const (
+	revalidateCache  = "public, max-age=0, must-revalidate"
+	fingerprintRegex = `^/static/.+\.[0-9a-f]{8}\.[0-9a-z]+$`
+	documentGlob     = "**/*.@(html|xml|txt|json)"
+)
+
+// firebaseHeader is one header Firebase Hosting sets on matching responses.
+type firebaseHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// firebaseHeaderRule matches files by glob or by regular expression.
+type firebaseHeaderRule struct {
+	Source  string           `json:"source,omitempty"`
+	Regex   string           `json:"regex,omitempty"`
+	Headers []firebaseHeader `json:"headers"`
+}
+
+// cacheHeaders are the generated hosting.headers rules.
+func cacheHeaders() []firebaseHeaderRule {
+	return []firebaseHeaderRule{
+		{Regex: fingerprintRegex, Headers: []firebaseHeader{{Key: "Cache-Control", Value: assets.ImmutableCache}}},
+		{Source: documentGlob, Headers: []firebaseHeader{{Key: "Cache-Control", Value: revalidateCache}}},
+	}
+}
+
+// writeFirebaseHeaders replaces the hosting.headers section of the Firebase
+// configuration at file with the generated cache rules and leaves every other
+// setting alone. The file is only rewritten when the rules changed.
+func writeFirebaseHeaders(file string) error {
+	raw, err := os.ReadFile(file) // #nosec G304 - Fixed configuration file in the project root
+	if err != nil {
+		return err
+	}
+
+	var config map[string]json.RawMessage
+
+	err = json.Unmarshal(raw, &config)
+	if err != nil {
+		return err
+	}
+
+	var hosting map[string]json.RawMessage
+
+	err = json.Unmarshal(config["hosting"], &hosting)
+	if err != nil {
+		return err
+	}
+
+	hosting["headers"], err = json.Marshal(cacheHeaders())
+	if err != nil {
+		return err
+	}
+
+	config["hosting"], err = json.Marshal(hosting)
+	if err != nil {
+		return err
+	}
+
+	updated, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	updated = append(updated, '\n')
+	if bytes.Equal(raw, updated) {
+		return nil
+	}
+
+	return os.WriteFile(file, updated, constants.PublicFilePerms)
+}
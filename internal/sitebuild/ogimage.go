@@ -0,0 +1,29 @@
+package sitebuild
+
+import (
+	"context"
+
+	"holger-hahn-website/internal/ogimage"
+)
+
+// buildOGImages pre-renders every preview card under the /og/ route.
+func buildOGImages(ctx context.Context, generator *ogimage.Generator, out *publisher) error {
+	names, err := generator.Names(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		img, err := generator.Image(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		err = out.write(ogimage.Path(name), img.Body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
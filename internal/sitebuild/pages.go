@@ -0,0 +1,62 @@
+package sitebuild
+
+import (
+	"bytes"
+	"context"
+	"path"
+
+	"github.com/a-h/templ"
+	"holger-hahn-website/internal/seo"
+	"holger-hahn-website/templates"
+)
+
+// page is one HTML page of the static site.
+type page struct {
+	component templ.Component
+	path      string
+}
+
+// pages lists every page of the site with the data-driven templates the
+// live server renders: the home page and a detail page per experience and
+// per active service.
+func pages(site *seo.Site, content *siteContent) []page {
+	all := []page{{
+		path:      "/",
+		component: templates.IndexWithData(content.Current, site.HomeHead(content.Content)),
+	}}
+
+	for _, exp := range content.Experiences {
+		all = append(all, page{
+			path:      seo.ExperiencePath(exp.ID),
+			component: templates.ExperiencePage(exp, site.ExperienceHead(exp)),
+		})
+	}
+
+	for _, svc := range content.Services {
+		all = append(all, page{
+			path:      seo.ServicePath(svc.ID),
+			component: templates.ServicePage(svc, site.ServiceHead(svc)),
+		})
+	}
+
+	return all
+}
+
+// renderPages publishes every page as <path>/index.html.
+func renderPages(ctx context.Context, site *seo.Site, content *siteContent, out *publisher) error {
+	for _, p := range pages(site, content) {
+		var html bytes.Buffer
+
+		err := p.component.Render(ctx, &html)
+		if err != nil {
+			return err
+		}
+
+		err = out.write(path.Join(p.path, "index.html"), html.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package sitebuild
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/seo"
+	"holger-hahn-website/internal/testutil"
+)
+
+func newSiteContent() *siteContent {
+	experiences := testutil.NewExperienceFixtures().ExperiencesList()
+
+	svc := domain.NewService("Custody Architecture Review", "Independent review of custody setups", domain.ServiceTypeAuditing)
+	svc.ID = "svc-001"
+
+	return &siteContent{
+		Content:      seo.Content{Experiences: experiences, Services: []*domain.Service{svc}},
+		Current:      experiences[:1],
+		Technologies: testutil.NewTechnologyFixtures().TechnologiesList(),
+	}
+}
+
+func TestRenderPages(t *testing.T) {
+	publicDir := t.TempDir()
+	site := seo.NewSite("https://example.com", nil, nil)
+
+	testutil.AssertNoError(t, renderPages(context.Background(), site, newSiteContent(), newPublisher(publicDir)))
+
+	for _, path := range []string{"index.html", "experiences/exp-003/index.html", "services/svc-001/index.html"} {
+		_, err := os.Stat(filepath.Join(publicDir, path))
+		testutil.AssertNoError(t, err)
+	}
+
+	html, err := os.ReadFile(filepath.Join(publicDir, "services", "svc-001", "index.html"))
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, strings.Contains(string(html), `<link rel="canonical" href="https://example.com/services/svc-001/">`), "Detail page should carry its canonical URL")
+	testutil.AssertTrue(t, strings.Contains(string(html), "Independent review of custody setups"), "Detail page should show the service")
+}
+
+func TestSiteContentValidate(t *testing.T) {
+	content := newSiteContent()
+	testutil.AssertNoError(t, content.validate())
+
+	content.Experiences[1].Position = ""
+	content.Services[0].Description = ""
+
+	err := content.validate()
+	testutil.AssertError(t, err)
+	testutil.AssertTrue(t, strings.Contains(err.Error(), "experience exp-001: "), "Error should name the invalid experience")
+	testutil.AssertTrue(t, strings.Contains(err.Error(), "service svc-001: "), "Error should name the invalid service")
+}
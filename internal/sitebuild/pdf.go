@@ -0,0 +1,40 @@
+package sitebuild
+
+import (
+	"context"
+
+	"holger-hahn-website/internal/pdf"
+	"holger-hahn-website/internal/seo"
+)
+
+// cvPDFFile matches the /cv.pdf route.
+const cvPDFFile = "cv.pdf"
+
+// buildPDFs renders the CV and the one-pager of every active service under
+// the paths the live server serves them from, so the static pages can link
+// to them.
+func buildPDFs(ctx context.Context, generator *pdf.Generator, content seo.Content, out *publisher) error {
+	doc, err := generator.CV(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = out.write(cvPDFFile, doc.Body)
+	if err != nil {
+		return err
+	}
+
+	for _, svc := range content.Services {
+		doc, err := generator.Service(ctx, svc.ID)
+		if err != nil {
+			return err
+		}
+
+		err = out.write("services/"+svc.ID+".pdf", doc.Body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package sitebuild
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"holger-hahn-website/internal/assets"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/security"
+)
+
+// buildCacheFile records the content hash of every output of the previous
+// build. Firebase ignores dot files, so it is never deployed.
+const buildCacheFile = ".build-cache.json"
+
+// Precompressed siblings written next to compressible outputs.
+const (
+	brotliSuffix = ".br"
+	gzipSuffix   = ".gz"
+)
+
+// publisher writes build outputs into the public directory. Outputs whose
+// content is unchanged since the previous build are skipped, and text formats
+// get precompressed .br and .gz siblings.
+type publisher struct {
+	cache     map[string]string
+	dir       string
+	written   int
+	unchanged int
+}
+
+// newPublisher creates a publisher for dir, reading the previous build's
+// cache. A missing or unreadable cache makes every output count as changed.
+func newPublisher(dir string) *publisher {
+	p := &publisher{cache: map[string]string{}, dir: dir}
+
+	raw, err := os.ReadFile(filepath.Join(dir, buildCacheFile)) // #nosec G304 - Fixed name inside the output directory
+	if err == nil {
+		_ = json.Unmarshal(raw, &p.cache)
+	}
+
+	return p
+}
+
+// write stores data at the slash-separated path rel inside the public
+// directory. A leading slash is ignored, so URL paths can be passed as is.
+func (p *publisher) write(rel string, data []byte) error {
+	rel = strings.TrimLeft(rel, "/")
+
+	path, err := security.ValidateDestinationPath(filepath.Join(p.dir, filepath.FromSlash(rel)), p.dir)
+	if err != nil {
+		return err
+	}
+
+	hash := assets.Hash(data)
+	compress := assets.Compressible(rel)
+
+	if p.cache[rel] == hash && p.exists(path, compress) {
+		p.unchanged++
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), constants.DefaultDirectoryPerms)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(path, data, constants.PublicFilePerms)
+	if err != nil {
+		return err
+	}
+
+	if compress {
+		err = writeCompressed(path, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.cache[rel] = hash
+	p.written++
+
+	return nil
+}
+
+// exists reports whether the output at path and its siblings are on disk.
+func (p *publisher) exists(path string, compressed bool) bool {
+	paths := []string{path}
+	if compressed {
+		paths = append(paths, path+brotliSuffix, path+gzipSuffix)
+	}
+
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// close saves the cache for the next build.
+func (p *publisher) close() error {
+	raw, err := json.MarshalIndent(p.cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(p.dir, buildCacheFile), raw, constants.PublicFilePerms)
+}
+
+func writeCompressed(path string, data []byte) error {
+	br, brErr := assets.Brotli(data)
+	gz, gzErr := assets.Gzip(data)
+
+	if err := errors.Join(brErr, gzErr); err != nil {
+		return err
+	}
+
+	err := os.WriteFile(path+brotliSuffix, br, constants.PublicFilePerms)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path+gzipSuffix, gz, constants.PublicFilePerms)
+}
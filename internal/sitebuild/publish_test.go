@@ -0,0 +1,107 @@
+package sitebuild
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/assets"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestPublisherWrite(t *testing.T) {
+	publicDir := t.TempDir()
+	out := newPublisher(publicDir)
+
+	testutil.AssertNoError(t, out.write("/robots.txt", []byte("User-agent: *\n")))
+	testutil.AssertNoError(t, out.write("og/home.png", []byte("png")))
+	testutil.AssertEqual(t, 2, out.written)
+
+	for _, name := range []string{"robots.txt", "robots.txt.br", "robots.txt.gz", "og/home.png"} {
+		_, err := os.Stat(filepath.Join(publicDir, filepath.FromSlash(name)))
+		testutil.AssertNoError(t, err)
+	}
+
+	_, err := os.Stat(filepath.Join(publicDir, "og", "home.png.gz"))
+	testutil.AssertTrue(t, os.IsNotExist(err), "images should not get compressed siblings")
+
+	testutil.AssertNoError(t, out.close())
+
+	// A second build with the same content leaves everything alone.
+	next := newPublisher(publicDir)
+	testutil.AssertNoError(t, next.write("robots.txt", []byte("User-agent: *\n")))
+	testutil.AssertNoError(t, next.write("og/home.png", []byte("png2")))
+	testutil.AssertEqual(t, 1, next.unchanged)
+	testutil.AssertEqual(t, 1, next.written)
+
+	// Missing siblings are written again even when the content is unchanged.
+	testutil.AssertNoError(t, os.Remove(filepath.Join(publicDir, "robots.txt.br")))
+	testutil.AssertNoError(t, next.write("robots.txt", []byte("User-agent: *\n")))
+	testutil.AssertEqual(t, 2, next.written)
+
+	_, err = os.Stat(filepath.Join(publicDir, "robots.txt.br"))
+	testutil.AssertNoError(t, err)
+
+	testutil.AssertError(t, next.write("../escape.txt", []byte("x")))
+}
+
+func TestPublishAssets(t *testing.T) {
+	src := t.TempDir()
+	publicDir := t.TempDir()
+
+	testutil.AssertNoError(t, os.MkdirAll(filepath.Join(src, "css"), 0o755))
+	testutil.AssertNoError(t, os.WriteFile(filepath.Join(src, "css", "styles.css"), []byte("body{}"), 0o644))
+
+	manifest, err := publishAssets(newPublisher(publicDir), src)
+	testutil.AssertNoError(t, err)
+
+	hashed := manifest["/static/css/styles.css"]
+	testutil.AssertEqual(t, assets.Fingerprint("/static/css/styles.css", []byte("body{}")), hashed)
+	testutil.AssertTrue(t, regexp.MustCompile(fingerprintRegex).MatchString(hashed), "firebase.json rule should match "+hashed)
+
+	for _, name := range []string{"static/css/styles.css", strings.TrimPrefix(hashed, "/"), assets.ManifestFile} {
+		_, err := os.Stat(filepath.Join(publicDir, filepath.FromSlash(name)))
+		testutil.AssertNoError(t, err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(publicDir, assets.ManifestFile))
+	testutil.AssertNoError(t, err)
+
+	var written assets.Manifest
+
+	testutil.AssertNoError(t, json.Unmarshal(raw, &written))
+	testutil.AssertEqual(t, hashed, written["/static/css/styles.css"])
+}
+
+func TestWriteFirebaseHeaders(t *testing.T) {
+	file := filepath.Join(t.TempDir(), firebaseConfigFile)
+	testutil.AssertNoError(t, os.WriteFile(file, []byte(`{"hosting": {"public": "public", "rewrites": []}}`), 0o644))
+
+	testutil.AssertNoError(t, writeFirebaseHeaders(file))
+
+	raw, err := os.ReadFile(file)
+	testutil.AssertNoError(t, err)
+
+	var config struct {
+		Hosting struct {
+			Public  string               `json:"public"`
+			Headers []firebaseHeaderRule `json:"headers"`
+		} `json:"hosting"`
+	}
+
+	testutil.AssertNoError(t, json.Unmarshal(raw, &config))
+	testutil.AssertEqual(t, "public", config.Hosting.Public)
+	testutil.AssertLen(t, config.Hosting.Headers, 2)
+	testutil.AssertEqual(t, fingerprintRegex, config.Hosting.Headers[0].Regex)
+	testutil.AssertEqual(t, assets.ImmutableCache, config.Hosting.Headers[0].Headers[0].Value)
+
+	// Rewriting is idempotent.
+	testutil.AssertNoError(t, writeFirebaseHeaders(file))
+
+	again, err := os.ReadFile(file)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, string(raw), string(again))
+}
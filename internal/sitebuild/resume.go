@@ -0,0 +1,53 @@
+package sitebuild
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/resume"
+)
+
+// Exported CV files, named after the API routes that serve the same documents.
+const (
+	jsonResumeFile = "resume.json"
+	europassFile   = "resume.xml"
+)
+
+// buildResume loads the CV from the repositories behind the live site and
+// publishes the JSON Resume and Europass documents.
+func buildResume(ctx context.Context, di *container.Container, out *publisher) error {
+	cv, err := container.MustGet[*resume.Generator](di).Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	return writeResume(cv, out)
+}
+
+// writeResume publishes both CV formats for cv.
+func writeResume(cv *resume.CV, out *publisher) error {
+	var jsonResume bytes.Buffer
+
+	encoder := json.NewEncoder(&jsonResume)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(resume.NewJSONResume(cv))
+	if err != nil {
+		return err
+	}
+
+	europass, err := resume.MarshalEuropass(resume.NewEuropass(cv))
+	if err != nil {
+		return err
+	}
+
+	err = out.write(jsonResumeFile, jsonResume.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return out.write(europassFile, europass)
+}
@@ -0,0 +1,35 @@
+package sitebuild
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/testutil"
+)
+
+func TestWriteResume(t *testing.T) {
+	publicDir := t.TempDir()
+
+	cv := resume.NewCV(
+		resume.DefaultProfile(),
+		testutil.NewExperienceFixtures().ExperiencesList(),
+		testutil.NewTechnologyFixtures().TechnologiesList(),
+	)
+
+	testutil.AssertNoError(t, writeResume(cv, newPublisher(publicDir)))
+
+	raw, err := os.ReadFile(filepath.Join(publicDir, jsonResumeFile))
+	testutil.AssertNoError(t, err)
+
+	var doc resume.JSONResume
+	testutil.AssertNoError(t, json.Unmarshal(raw, &doc))
+	testutil.AssertLen(t, doc.Work, 3)
+
+	europass, err := os.ReadFile(filepath.Join(publicDir, europassFile))
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, strings.Contains(string(europass), "<SkillsPassport"), "Europass CV should be written")
+}
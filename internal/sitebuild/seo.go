@@ -0,0 +1,24 @@
+package sitebuild
+
+import "holger-hahn-website/internal/seo"
+
+// Search engine files, served by the same routes on the live site.
+const (
+	sitemapFile = "sitemap.xml"
+	robotsFile  = "robots.txt"
+)
+
+// writeSEO publishes the sitemap and robots.txt for content.
+func writeSEO(site *seo.Site, content seo.Content, out *publisher) error {
+	sitemap, err := seo.MarshalSitemap(site.Sitemap(content))
+	if err != nil {
+		return err
+	}
+
+	err = out.write(sitemapFile, sitemap)
+	if err != nil {
+		return err
+	}
+
+	return out.write(robotsFile, []byte(site.RobotsTXT()))
+}
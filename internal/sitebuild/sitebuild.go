@@ -0,0 +1,162 @@
+// Package sitebuild generates the static website. It renders every page
+// from the same repositories the live site reads, fingerprints and
+// precompresses static assets, exports the CV and search engine metadata, and
+// skips outputs that are unchanged since the last build. Finally it checks the
+// generated pages for broken links and markup and accessibility problems.
+package sitebuild
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"holger-hahn-website/internal/assets"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/ogimage"
+	"holger-hahn-website/internal/pdf"
+	"holger-hahn-website/internal/seo"
+)
+
+// Build targets.
+const (
+	TargetSite   = "site"
+	TargetResume = "resume"
+	TargetCheck  = "check"
+)
+
+// DefaultPublicDir is where the site is generated, the Firebase Hosting root.
+const DefaultPublicDir = "public"
+
+// DefaultReportFile is where the site check writes its report, outside the
+// deployed directory.
+const DefaultReportFile = "check-report.json"
+
+// ErrUnknownTarget is returned for a target other than the ones above.
+var ErrUnknownTarget = errors.New("unknown build target")
+
+// Options select what Run builds and where.
+type Options struct {
+	// Target is TargetSite, TargetResume or TargetCheck
+	Target string
+
+	// PublicDir receives the generated files
+	PublicDir string
+
+	// ReportFile receives the JSON report of the site check
+	ReportFile string
+
+	// BaseURL is the public URL that absolute links in the pages point at
+	BaseURL string
+}
+
+// Run builds opts.Target with the repositories and generators in di, then
+// checks the output unless only the CV was built.
+func Run(ctx context.Context, di *container.Container, opts Options) error {
+	if opts.Target != TargetSite && opts.Target != TargetResume && opts.Target != TargetCheck {
+		return fmt.Errorf("%w %q, expected %s, %s or %s", ErrUnknownTarget, opts.Target, TargetSite, TargetResume, TargetCheck)
+	}
+
+	err := run(ctx, di, opts.Target, opts.PublicDir)
+	if err != nil {
+		return err
+	}
+
+	if opts.Target == TargetResume {
+		return nil
+	}
+
+	log.Printf("Checking links, HTML and accessibility...")
+
+	return checkSite(opts.PublicDir, opts.BaseURL, opts.ReportFile)
+}
+
+// run builds target into publicDir from the repositories behind the live site.
+func run(ctx context.Context, di *container.Container, target, publicDir string) error {
+	if target == TargetCheck {
+		return nil
+	}
+
+	err := os.MkdirAll(publicDir, constants.DefaultDirectoryPerms)
+	if err != nil {
+		return err
+	}
+
+	out := newPublisher(publicDir)
+
+	if target == TargetSite {
+		err = buildSite(ctx, di, out)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Export the CV from the same data the live site serves.
+	log.Printf("Exporting CV (JSON Resume and Europass)...")
+
+	err = buildResume(ctx, di, out)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%d files written, %d unchanged", out.written, out.unchanged)
+
+	return out.close()
+}
+
+// buildSite renders every page from validated content, publishes the static
+// assets under fingerprinted names and updates the hosting configuration.
+func buildSite(ctx context.Context, di *container.Container, out *publisher) error {
+	log.Printf("Loading and validating content...")
+
+	content, err := loadContent(ctx, di)
+	if err != nil {
+		return err
+	}
+
+	// Fingerprint static assets first so that pages link to the hashed names.
+	log.Printf("Fingerprinting static assets...")
+
+	manifest, err := publishAssets(out, staticDir)
+	if err != nil {
+		return err
+	}
+
+	site := container.MustGet[*seo.Site](di)
+
+	// Generate search engine metadata from the same data the live site serves.
+	log.Printf("Generating sitemap.xml and robots.txt...")
+
+	err = writeSEO(site, content.Content, out)
+	if err != nil {
+		return err
+	}
+
+	// Pre-render the link preview cards the metadata points at.
+	log.Printf("Rendering Open Graph images...")
+
+	err = buildOGImages(ctx, container.MustGet[*ogimage.Generator](di), out)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Rendering PDFs...")
+
+	err = buildPDFs(ctx, container.MustGet[*pdf.Generator](di), content.Content, out)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Rendering pages...")
+
+	err = renderPages(assets.WithManifest(ctx, manifest), site, content, out)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Updating %s cache headers...", firebaseConfigFile)
+
+	return writeFirebaseHeaders(firebaseConfigFile)
+}
@@ -0,0 +1,132 @@
+// Package sitebuild provides unit tests for the static site build.
+// It contains tests for path validation and security checks to ensure the
+// build process is secure and functions correctly.
+package sitebuild
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"holger-hahn-website/internal/security"
+)
+
+func TestValidatePath(t *testing.T) {
+	// Create a temporary directory for testing
+	tmpDir := t.TempDir()
+
+	// Create a subdirectory
+	subDir := filepath.Join(tmpDir, "subdir")
+
+	err := os.MkdirAll(subDir, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a test file
+	testFile := filepath.Join(subDir, "test.txt")
+
+	err = os.WriteFile(testFile, []byte("test content"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expectedError error
+		name          string
+		path          string
+		basePath      string
+		isDestination bool
+		expectError   bool
+	}{
+		{
+			name:        "valid file path within base",
+			path:        testFile,
+			basePath:    tmpDir,
+			expectError: false,
+		},
+		{
+			name:        "valid directory path within base",
+			path:        subDir,
+			basePath:    tmpDir,
+			expectError: false,
+		},
+		{
+			name:          "path traversal attempt source",
+			path:          filepath.Join(tmpDir, "..", "outside"),
+			basePath:      tmpDir,
+			isDestination: false,
+			expectError:   true,
+			expectedError: security.ErrPathTraversal,
+		},
+		{
+			name:          "path traversal attempt destination",
+			path:          filepath.Join(tmpDir, "..", "outside"),
+			basePath:      tmpDir,
+			isDestination: true,
+			expectError:   true,
+			expectedError: security.ErrDestinationPathTraversal,
+		},
+		{
+			name:        "base path equals path",
+			path:        tmpDir,
+			basePath:    tmpDir,
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cleanPath string
+
+			var err error
+			if tt.isDestination {
+				cleanPath, err = security.ValidateDestinationPath(tt.path, tt.basePath)
+			} else {
+				cleanPath, err = security.ValidateFilePath(tt.path, tt.basePath)
+			}
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+					return
+				}
+
+				if tt.expectedError != nil && !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !filepath.IsAbs(cleanPath) {
+					t.Errorf("expected absolute path, got %s", cleanPath)
+				}
+			}
+		})
+	}
+}
+
+func TestStaticErrors(t *testing.T) {
+	// Test that our static errors are properly defined
+	if security.ErrPathTraversal == nil {
+		t.Error("ErrPathTraversal should not be nil")
+	}
+
+	if security.ErrDestinationPathTraversal == nil {
+		t.Error("ErrDestinationPathTraversal should not be nil")
+	}
+
+	// Test error messages
+	if !strings.Contains(security.ErrPathTraversal.Error(), "path traversal") {
+		t.Errorf("ErrPathTraversal should contain 'path traversal', got: %s", security.ErrPathTraversal.Error())
+	}
+
+	if !strings.Contains(security.ErrDestinationPathTraversal.Error(), "destination path traversal") {
+		t.Errorf("ErrDestinationPathTraversal should contain 'destination path traversal', got: %s", security.ErrDestinationPathTraversal.Error())
+	}
+}
@@ -0,0 +1,123 @@
+package sitecheck
+
+import (
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// page is what the checks need to know about one parsed HTML file.
+type page struct {
+	ids        map[string]int
+	path       string
+	links      []string
+	images     []string
+	headings   []int
+	jsonLD     []string
+	missingAlt []string
+}
+
+// parsePage collects the links, IDs, headings, images and JSON-LD blocks of
+// the HTML document read from r, served under the URL path urlPath.
+func parsePage(urlPath string, r io.Reader) (*page, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &page{path: urlPath, ids: map[string]int{}}
+	p.visit(doc)
+
+	return p, nil
+}
+
+func (p *page) visit(n *html.Node) {
+	if n.Type == html.ElementNode {
+		p.element(n)
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		p.visit(child)
+	}
+}
+
+func (p *page) element(n *html.Node) {
+	if id, ok := attr(n, "id"); ok {
+		p.ids[id]++
+	}
+
+	switch n.DataAtom {
+	case atom.A, atom.Link:
+		if href, ok := attr(n, "href"); ok {
+			p.links = append(p.links, href)
+		}
+	case atom.Script:
+		if typ, _ := attr(n, "type"); typ == "application/ld+json" {
+			p.jsonLD = append(p.jsonLD, text(n))
+		} else if src, ok := attr(n, "src"); ok {
+			p.links = append(p.links, src)
+		}
+	case atom.Img:
+		src, _ := attr(n, "src")
+		if _, ok := attr(n, "alt"); !ok {
+			p.missingAlt = append(p.missingAlt, src)
+		}
+
+		p.image(src)
+		p.srcset(n)
+	case atom.Source:
+		p.srcset(n)
+	case atom.Meta:
+		if property, _ := attr(n, "property"); property == "og:image" {
+			content, _ := attr(n, "content")
+			p.image(content)
+		}
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		p.headings = append(p.headings, int(n.Data[1]-'0'))
+	}
+}
+
+// image records src as both a link and an image whose size is checked.
+func (p *page) image(src string) {
+	if src == "" {
+		return
+	}
+
+	p.links = append(p.links, src)
+	p.images = append(p.images, src)
+}
+
+// srcset records every candidate of the srcset attribute of n.
+func (p *page) srcset(n *html.Node) {
+	srcset, _ := attr(n, "srcset")
+
+	for candidate := range strings.SplitSeq(srcset, ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			p.image(fields[0])
+		}
+	}
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+func text(n *html.Node) string {
+	var b strings.Builder
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.TextNode {
+			b.WriteString(child.Data)
+		}
+	}
+
+	return b.String()
+}
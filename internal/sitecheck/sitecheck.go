@@ -0,0 +1,320 @@
+// Package sitecheck inspects the generated static site before it is deployed.
+// It parses every HTML page and reports broken internal links and anchors,
+// images without alt text, duplicate IDs, skipped heading levels, invalid
+// JSON-LD and oversized images.
+package sitecheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Rules an issue can violate.
+const (
+	RuleBrokenLink     = "broken-link"
+	RuleBrokenAnchor   = "broken-anchor"
+	RuleMissingAlt     = "missing-alt"
+	RuleDuplicateID    = "duplicate-id"
+	RuleHeadingOrder   = "heading-order"
+	RuleInvalidJSONLD  = "invalid-json-ld"
+	RuleOversizedImage = "oversized-image"
+)
+
+// DefaultMaxImageSize is the largest image, in bytes, a page may reference.
+const DefaultMaxImageSize = 300 << 10
+
+// Options configure a check.
+type Options struct {
+	// BaseURL is the public address of the site. Absolute links below it are
+	// checked like internal ones.
+	BaseURL string
+	// MaxImageSize is the largest image in bytes; zero means DefaultMaxImageSize.
+	MaxImageSize int64
+}
+
+// Issue is one problem found on a page.
+type Issue struct {
+	Page    string `json:"page"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Report is the machine-readable result of a check.
+type Report struct {
+	Issues []Issue `json:"issues"`
+	Pages  int     `json:"pages"`
+}
+
+// OK reports whether the check found no issues.
+func (r *Report) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// checker holds the parsed pages of the site in dir.
+type checker struct {
+	pages   map[string]*page
+	checked map[string]bool
+	report  *Report
+	dir     string
+	opts    Options
+}
+
+// Check parses every HTML file below dir, the root of the site, and reports
+// the issues found. Only failures to read the site are returned as errors.
+func Check(dir string, opts Options) (*Report, error) {
+	if opts.MaxImageSize == 0 {
+		opts.MaxImageSize = DefaultMaxImageSize
+	}
+
+	opts.BaseURL = strings.TrimRight(opts.BaseURL, "/")
+
+	c := &checker{
+		pages:   map[string]*page{},
+		checked: map[string]bool{},
+		report:  &Report{Issues: []Issue{}},
+		dir:     dir,
+		opts:    opts,
+	}
+
+	err := c.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(c.pages))
+	for urlPath := range c.pages {
+		paths = append(paths, urlPath)
+	}
+
+	slices.Sort(paths)
+
+	for _, urlPath := range paths {
+		c.check(c.pages[urlPath])
+	}
+
+	c.report.Pages = len(paths)
+
+	return c.report, nil
+}
+
+// parse reads every HTML file below the site root, skipping dot files.
+func (c *checker) parse() error {
+	return filepath.WalkDir(c.dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(entry.Name(), ".") && file != c.dir {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() || filepath.Ext(file) != ".html" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(c.dir, file)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(file) // #nosec G304 - Walking the site root
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		urlPath := pagePath(filepath.ToSlash(rel))
+
+		p, err := parsePage(urlPath, f)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", rel, err)
+		}
+
+		c.pages[urlPath] = p
+
+		return nil
+	})
+}
+
+// pagePath returns the URL path a file is served under, e.g. "/services/x/"
+// for "services/x/index.html".
+func pagePath(rel string) string {
+	if rel == "index.html" {
+		return "/"
+	}
+
+	if dir, ok := strings.CutSuffix(rel, "/index.html"); ok {
+		return "/" + dir + "/"
+	}
+
+	return "/" + rel
+}
+
+func (c *checker) check(p *page) {
+	for _, link := range p.links {
+		c.checkLink(p, link)
+	}
+
+	for _, src := range p.missingAlt {
+		c.add(p, RuleMissingAlt, "image %q has no alt attribute", src)
+	}
+
+	ids := make([]string, 0, len(p.ids))
+	for id, count := range p.ids {
+		if count > 1 {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		c.add(p, RuleDuplicateID, "id %q is used %d times", id, p.ids[id])
+	}
+
+	c.checkHeadings(p)
+
+	for _, block := range p.jsonLD {
+		c.checkJSONLD(p, block)
+	}
+
+	for _, src := range p.images {
+		c.checkImage(p, src)
+	}
+}
+
+// checkLink reports internal links to files that do not exist and fragments
+// that match no ID on the target page.
+func (c *checker) checkLink(p *page, link string) {
+	target, ok := c.resolve(p, link)
+	if !ok {
+		return
+	}
+
+	file, ok := c.file(target.Path)
+	if !ok {
+		c.add(p, RuleBrokenLink, "link %q points to a missing file", link)
+		return
+	}
+
+	if target.Fragment == "" {
+		return
+	}
+
+	linked, ok := c.pages[pagePath(file)]
+	if ok && linked.ids[target.Fragment] == 0 {
+		c.add(p, RuleBrokenAnchor, "link %q points to a missing anchor", link)
+	}
+}
+
+// checkHeadings reports a page without exactly one h1 and headings that skip
+// a level on the way down.
+func (c *checker) checkHeadings(p *page) {
+	h1 := 0
+	previous := 0
+
+	for _, level := range p.headings {
+		if level == 1 {
+			h1++
+		}
+
+		if previous > 0 && level > previous+1 {
+			c.add(p, RuleHeadingOrder, "h%d follows h%d", level, previous)
+		}
+
+		previous = level
+	}
+
+	if h1 != 1 {
+		c.add(p, RuleHeadingOrder, "page has %d h1 headings, expected 1", h1)
+	}
+}
+
+// checkJSONLD reports blocks that are not JSON objects with a @context.
+func (c *checker) checkJSONLD(p *page, block string) {
+	var doc map[string]any
+
+	err := json.Unmarshal([]byte(block), &doc)
+	if err != nil {
+		c.add(p, RuleInvalidJSONLD, "structured data is not a JSON object: %v", err)
+		return
+	}
+
+	if _, ok := doc["@context"]; !ok {
+		c.add(p, RuleInvalidJSONLD, "structured data has no @context")
+	}
+}
+
+// checkImage reports images above the size limit, each only once.
+func (c *checker) checkImage(p *page, src string) {
+	target, ok := c.resolve(p, src)
+	if !ok {
+		return
+	}
+
+	file, ok := c.file(target.Path)
+	if !ok || c.checked[file] {
+		return
+	}
+
+	c.checked[file] = true
+
+	info, err := os.Stat(filepath.Join(c.dir, filepath.FromSlash(file)))
+	if err == nil && info.Size() > c.opts.MaxImageSize {
+		c.add(p, RuleOversizedImage, "image %q is %d KiB, limit is %d KiB", src, info.Size()>>10, c.opts.MaxImageSize>>10)
+	}
+}
+
+// resolve returns the URL link points to relative to the site root, or false
+// for links that leave the site.
+func (c *checker) resolve(p *page, link string) (*url.URL, bool) {
+	if c.opts.BaseURL != "" {
+		if rest, ok := strings.CutPrefix(link, c.opts.BaseURL); ok && (rest == "" || strings.HasPrefix(rest, "/")) {
+			link = "/" + strings.TrimPrefix(rest, "/")
+		}
+	}
+
+	ref, err := url.Parse(link)
+	if err != nil || ref.Scheme != "" || ref.Host != "" || link == "" {
+		return nil, false
+	}
+
+	base := &url.URL{Path: p.path}
+
+	return base.ResolveReference(ref), true
+}
+
+// file returns the slash-separated path of the file serving urlPath, trying
+// the index.html of a directory as the hosting does.
+func (c *checker) file(urlPath string) (string, bool) {
+	rel := strings.TrimPrefix(path.Clean("/"+urlPath), "/")
+
+	candidates := []string{path.Join(rel, "index.html")}
+	if !strings.HasSuffix(urlPath, "/") && rel != "" {
+		candidates = append([]string{rel}, candidates...)
+	}
+
+	for _, candidate := range candidates {
+		info, err := os.Stat(filepath.Join(c.dir, filepath.FromSlash(candidate)))
+		if err == nil && !info.IsDir() {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+func (c *checker) add(p *page, rule, format string, args ...any) {
+	c.report.Issues = append(c.report.Issues, Issue{Page: p.path, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
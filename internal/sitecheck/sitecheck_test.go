@@ -0,0 +1,130 @@
+package sitecheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"holger-hahn-website/internal/testutil"
+)
+
+const validHome = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<link rel="canonical" href="https://example.com/">
+	<link rel="stylesheet" href="/static/site.css">
+	<meta property="og:image" content="https://example.com/og/home.png">
+	<script type="application/ld+json">{"@context": "https://schema.org", "@graph": []}</script>
+</head>
+<body>
+	<a href="#main">Skip</a>
+	<main id="main">
+		<h1>Home</h1>
+		<h2 id="about">About</h2>
+		<h3>Details</h3>
+		<h2>Services</h2>
+		<img src="/static/photo.webp" srcset="/static/photo.webp 1x, /static/photo.webp 2x" alt="">
+		<a href="/services/one/">One</a>
+		<a href="services/one/#pricing">Pricing</a>
+		<a href="https://example.org/elsewhere">External</a>
+		<a href="mailto:hello@example.com">Mail</a>
+	</main>
+</body>
+</html>`
+
+const validService = `<!DOCTYPE html>
+<html lang="en">
+<body>
+	<h1>One</h1>
+	<h2 id="pricing">Pricing</h2>
+	<a href="/#about">About</a>
+</body>
+</html>`
+
+func writeSite(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		testutil.AssertNoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+		testutil.AssertNoError(t, os.WriteFile(file, []byte(content), 0o644))
+	}
+
+	return dir
+}
+
+func validSite() map[string]string {
+	return map[string]string{
+		"index.html":              validHome,
+		"services/one/index.html": validService,
+		"static/site.css":         "body{}",
+		"static/photo.webp":       "webp",
+		"og/home.png":             "png",
+	}
+}
+
+func TestCheckValidSite(t *testing.T) {
+	report, err := Check(writeSite(t, validSite()), Options{BaseURL: "https://example.com/"})
+	testutil.AssertNoError(t, err)
+
+	for _, issue := range report.Issues {
+		t.Errorf("unexpected issue: %+v", issue)
+	}
+
+	testutil.AssertTrue(t, report.OK(), "valid site should pass")
+	testutil.AssertEqual(t, 2, report.Pages)
+}
+
+func TestCheckReportsIssues(t *testing.T) {
+	site := validSite()
+	site["broken/index.html"] = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<script type="application/ld+json">{"@type": "Person"}</script>
+	<script type="application/ld+json">{not json</script>
+</head>
+<body>
+	<h2 id="dup">Intro</h2>
+	<h4 id="dup">Deep</h4>
+	<img src="/static/photo.webp">
+	<img src="/static/huge.png" alt="Huge">
+	<a href="/missing/">Missing</a>
+	<a href="/services/one/#nowhere">Nowhere</a>
+	<a href="https://example.com/gone.pdf">Gone</a>
+</body>
+</html>`
+	site["static/huge.png"] = string(make([]byte, 2048))
+
+	report, err := Check(writeSite(t, site), Options{BaseURL: "https://example.com", MaxImageSize: 1024})
+	testutil.AssertNoError(t, err)
+	testutil.AssertTrue(t, !report.OK(), "broken page should fail")
+
+	rules := map[string]int{}
+
+	for _, issue := range report.Issues {
+		testutil.AssertEqual(t, "/broken/", issue.Page)
+		rules[issue.Rule]++
+	}
+
+	testutil.AssertEqual(t, 2, rules[RuleBrokenLink])
+	testutil.AssertEqual(t, 1, rules[RuleBrokenAnchor])
+	testutil.AssertEqual(t, 1, rules[RuleMissingAlt])
+	testutil.AssertEqual(t, 1, rules[RuleDuplicateID])
+	testutil.AssertEqual(t, 2, rules[RuleHeadingOrder])
+	testutil.AssertEqual(t, 2, rules[RuleInvalidJSONLD])
+	testutil.AssertEqual(t, 1, rules[RuleOversizedImage])
+}
+
+func TestPagePath(t *testing.T) {
+	tests := map[string]string{
+		"index.html":              "/",
+		"services/one/index.html": "/services/one/",
+		"404.html":                "/404.html",
+	}
+
+	for rel, want := range tests {
+		testutil.AssertEqual(t, want, pagePath(rel))
+	}
+}
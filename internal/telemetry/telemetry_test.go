@@ -0,0 +1,50 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"holger-hahn-website/internal/testutil"
+)
+
+// recordSpans routes spans to a recorder for the duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+func lookup(ctx context.Context, fail bool) (err error) {
+	_, span := Start(ctx, "Service.Lookup")
+	defer End(span, &err)
+
+	if fail {
+		return errors.New("not found")
+	}
+
+	return nil
+}
+
+func TestEndRecordsErrors(t *testing.T) {
+	recorder := recordSpans(t)
+
+	testutil.AssertNoError(t, lookup(context.Background(), false))
+	testutil.AssertError(t, lookup(context.Background(), true))
+
+	spans := recorder.Ended()
+	testutil.AssertEqual(t, 2, len(spans))
+	testutil.AssertEqual(t, "Service.Lookup", spans[0].Name())
+	testutil.AssertEqual(t, codes.Unset, spans[0].Status().Code)
+	testutil.AssertEqual(t, codes.Error, spans[1].Status().Code)
+	testutil.AssertEqual(t, "not found", spans[1].Status().Description)
+}
@@ -4,14 +4,11 @@
 package utils
 
 import (
-	"context"
 	"errors"
 
 	"github.com/samber/lo"
 	"github.com/samber/mo"
 	"github.com/spf13/viper"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/trace"
 )
 
 // Example functions demonstrating library usage to keep them in go.mod
@@ -44,11 +41,5 @@ func GetConfigValue(key string, defaultValue interface{}) interface{} {
 	return viper.Get(key)
 }
 
-// TraceOperation demonstrates OpenTelemetry tracing
-func TraceOperation(ctx context.Context, operationName string) (context.Context, trace.Span) {
-	tracer := otel.Tracer("holger-hahn-website")
-	return tracer.Start(ctx, operationName)
-}
-
 // Note: These functions ensure the libraries remain in go.mod and provide
 // examples for future integration throughout the codebase.
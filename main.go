@@ -5,29 +5,50 @@ package main
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"holger-hahn-website/internal/application"
 	"holger-hahn-website/internal/config"
 	"holger-hahn-website/internal/constants"
 	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/domain"
+	"holger-hahn-website/internal/graph"
 	"holger-hahn-website/internal/handler"
+	"holger-hahn-website/internal/health"
+	"holger-hahn-website/internal/infrastructure"
+	"holger-hahn-website/internal/lifecycle"
+	"holger-hahn-website/internal/logging"
+	"holger-hahn-website/internal/metrics"
+	"holger-hahn-website/internal/ogimage"
+	"holger-hahn-website/internal/pagecache"
+	"holger-hahn-website/internal/pdf"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/seo"
 	"holger-hahn-website/internal/service"
+	"holger-hahn-website/internal/telemetry"
 )
 
 // ContactHandler handles HTTP requests for contact operations.
 type ContactHandler struct {
 	contactService *application.ContactService
+	metrics        domain.MetricsService
+	responses      *handler.ResponseHandler
 }
 
 // NewContactHandler creates a new contact handler.
-func NewContactHandler(contactService *application.ContactService) *ContactHandler {
+func NewContactHandler(contactService *application.ContactService, metrics domain.MetricsService) *ContactHandler {
 	return &ContactHandler{
 		contactService: contactService,
+		metrics:        metrics,
+		responses:      handler.NewResponseHandler(),
 	}
 }
 
@@ -37,71 +58,239 @@ func (h *ContactHandler) SubmitContactForm(c *gin.Context) {
 
 	// Bind and validate JSON/form data
 	if err := c.ShouldBind(&req); err != nil {
-		log.Printf("Validation error: %v", err)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"message": "Please check all required fields and try again.",
-			"error":   err.Error(),
-		})
+		slog.WarnContext(c.Request.Context(), "Contact form validation failed", "error", err)
+		h.metrics.RecordContactSubmission(domain.SubmissionValidationFailed)
+		h.responses.HandleBindError(c, err)
 
 		return
 	}
 
-	// Use application service to handle the request
-	ctx := context.Background()
-
-	response, err := h.contactService.SubmitContactForm(ctx, req)
+	// Use application service to handle the request within the request's trace
+	response, err := h.contactService.SubmitContactForm(c.Request.Context(), req)
 	if err != nil {
-		log.Printf("Contact service error: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"success": false,
-			"message": "Sorry, there was an error processing your request. Please try again later.",
-		})
+		if errors.Is(err, domain.ErrValidationFailed) {
+			err = domain.ErrInvalidInput(err.Error())
+		}
+
+		h.responses.HandleError(c, err)
 
 		return
 	}
 
-	log.Printf("Contact form submitted successfully - ID: %s", response.ID)
+	slog.InfoContext(c.Request.Context(), "Contact form submitted", "contact_id", response.ID)
 	c.JSON(http.StatusOK, response)
 }
 
+// ListContacts handles GET /api/v1/contacts requests.
+func (h *ContactHandler) ListContacts(c *gin.Context) {
+	page, err := handler.ParsePageRequest(c, constants.DefaultContactPageSize, constants.MaxContactPageSize)
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	status := c.Query("status")
+	if status != "" && !domain.ContactStatus(status).IsValid() {
+		h.responses.HandleError(c, domain.ErrInvalidField("status", "status must be one of new, read, replied, archived"))
+		return
+	}
+
+	contacts, err := h.contactService.ListContacts(c.Request.Context(), status, page.Limit, page.Offset)
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	total, err := h.contactService.CountContacts(c.Request.Context(), status)
+	if err != nil {
+		h.responses.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, handler.NewListResponse(c, contacts, page.Offset, total))
+}
+
 // setupRoutes configures all application routes for both portfolio and contact functionality.
-func setupRoutes(r *gin.Engine, portfolioHandlers *handler.PortfolioHandlers, contactHandler *ContactHandler) {
-	// Serve static files
-	r.Static("/static", "./static")
+func setupRoutes(
+	r *gin.Engine,
+	portfolioHandlers *handler.PortfolioHandlers,
+	contactHandler *ContactHandler,
+	adminHandlers *handler.AdminHandlers,
+	graphqlHandlers *handler.GraphQLHandlers,
+	resumeHandlers *handler.ResumeHandlers,
+	pdfHandlers *handler.PDFHandlers,
+	ogImageHandlers *handler.OGImageHandlers,
+	staticHandlers *handler.StaticHandlers,
+	healthHandlers *handler.HealthHandlers,
+	metricsHandlers *handler.MetricsHandlers,
+	tokenAuth handler.TokenAuthenticator,
+	live *config.Live,
+	pageCache *pagecache.Cache,
+) {
+	// Optional parts of the site answer 404 while their feature flag is off;
+	// the PDF handlers check theirs themselves
+	graphqlEnabled := handler.RequireFeature(func() bool { return live.Current().Features.GraphQL })
+	adminEnabled := handler.RequireFeature(func() bool { return live.Current().Features.Admin })
+
+	// Pages and portfolio reads are rendered once per content revision. Pages
+	// read no query parameters; the API lists are cached by the ones they read
+	// once any others have been rejected.
+	cached := pageCache.Middleware()
+	cachedList := func(params []string, list gin.HandlerFunc) []gin.HandlerFunc {
+		return []gin.HandlerFunc{handler.RejectUnknownParams(params...), pageCache.Middleware(params...), list}
+	}
+
+	// Pages link to static assets by their fingerprinted names
+	r.Use(staticHandlers.Manifest())
+
+	// Serve static files embedded in the binary
+	r.GET(staticPrefix+"/*filepath", staticHandlers.File)
+	r.HEAD(staticPrefix+"/*filepath", staticHandlers.File)
 
 	// Main portfolio page with dynamic data
-	r.GET("/", portfolioHandlers.HomeHandler)
+	r.GET("/", cached, portfolioHandlers.HomeHandler)
 
 	// Contact form API endpoint
 	r.POST("/contact", contactHandler.SubmitContactForm)
 
-	// Health check endpoint
-	r.GET("/health", portfolioHandlers.HealthHandler)
+	// PDF downloads for clients
+	r.GET("/cv.pdf", pdfHandlers.CV)
+	r.GET("/services/:file", pdfHandlers.Service)
 
-	// Portfolio API routes for dynamic data
-	api := r.Group("/api/v1")
+	// Detail pages
+	r.GET("/services/:file/", cached, portfolioHandlers.ServicePageHandler)
+	r.GET("/experiences/:id/", cached, portfolioHandlers.ExperiencePageHandler)
+
+	// Link preview cards
+	r.GET("/og/:file", ogImageHandlers.Image)
+
+	// Search engine metadata
+	r.GET("/sitemap.xml", cached, portfolioHandlers.SitemapHandler)
+	r.GET("/robots.txt", portfolioHandlers.RobotsHandler)
+
+	// Probes: liveness never touches dependencies, readiness checks them all
+	r.GET("/healthz/live", healthHandlers.Live)
+	r.GET("/healthz/ready", healthHandlers.Ready)
+	r.GET("/health", healthHandlers.Ready)
+
+	// Prometheus metrics, token protected when metrics.require_token is set
+	r.GET("/metrics", metricsHandlers.Guard(tokenAuth), metricsHandlers.Metrics)
+
+	// API reference rendered from the OpenAPI document
+	r.GET("/api/docs", portfolioHandlers.APIDocsHandler)
+
+	// Anything else is a 404, reported as a problem under /api/
+	r.NoRoute(handler.RouteNotFoundHandler)
+
+	// Portfolio API routes for dynamic data. Reads are public; every write
+	// route registered under /api/v1 requires a token with content:write.
+	api := r.Group("/api/v1", handler.RequireScopeForWrites(tokenAuth, domain.ScopeContentWrite))
 	{
-		api.GET("/technologies", portfolioHandlers.TechnologiesHandler)
-		api.GET("/experiences", portfolioHandlers.ExperiencesHandler)
-		api.GET("/services", portfolioHandlers.ServicesHandler)
+		api.GET("/openapi.json", portfolioHandlers.OpenAPIHandler)
+
+		// Error catalog; problem type URIs point here
+		api.GET("/problems", handler.ProblemTypesHandler)
+		api.GET("/problems/:code", handler.ProblemTypeHandler)
+
+		api.GET("/resume.json", resumeHandlers.JSONResume)
+		api.GET("/resume.xml", resumeHandlers.Europass)
+
+		api.GET("/technologies", cachedList(handler.TechnologyListParams(), portfolioHandlers.TechnologiesHandler)...)
+		api.POST("/technologies", portfolioHandlers.CreateTechnologyHandler)
+		api.GET("/technologies/:id", cached, portfolioHandlers.GetTechnologyHandler)
+		api.PATCH("/technologies/:id", portfolioHandlers.UpdateTechnologyHandler)
+		api.DELETE("/technologies/:id", portfolioHandlers.DeleteTechnologyHandler)
+
+		api.GET("/experiences", cachedList(handler.ExperienceListParams(), portfolioHandlers.ExperiencesHandler)...)
+		api.POST("/experiences", portfolioHandlers.CreateExperienceHandler)
+		api.GET("/experiences/:id", cached, portfolioHandlers.GetExperienceHandler)
+		api.PATCH("/experiences/:id", portfolioHandlers.UpdateExperienceHandler)
+		api.DELETE("/experiences/:id", portfolioHandlers.DeleteExperienceHandler)
+		api.POST("/experiences/:id/technologies", portfolioHandlers.AddExperienceTechnologyHandler)
+		api.POST("/experiences/:id/achievements", portfolioHandlers.AddExperienceAchievementHandler)
+		api.DELETE("/experiences/:id/achievements/:achievementId", portfolioHandlers.RemoveExperienceAchievementHandler)
+		api.POST("/experiences/:id/end", portfolioHandlers.EndExperienceHandler)
+
+		api.GET("/services", cachedList(handler.ServiceListParams(), portfolioHandlers.ServicesHandler)...)
+		api.POST("/services", portfolioHandlers.CreateServiceHandler)
+		api.GET("/services/:id", cached, portfolioHandlers.GetServiceHandler)
+		api.PATCH("/services/:id", portfolioHandlers.UpdateServiceHandler)
+		api.DELETE("/services/:id", portfolioHandlers.DeleteServiceHandler)
+		api.POST("/services/:id/technologies", portfolioHandlers.AddServiceTechnologyHandler)
+		api.POST("/services/:id/deliverables", portfolioHandlers.AddServiceDeliverableHandler)
+		api.DELETE("/services/:id/deliverables/:deliverableId", portfolioHandlers.RemoveServiceDeliverableHandler)
+		api.PUT("/services/:id/pricing", portfolioHandlers.UpdateServicePricingHandler)
+		api.POST("/services/:id/activate", portfolioHandlers.ActivateServiceHandler)
+		api.POST("/services/:id/deactivate", portfolioHandlers.DeactivateServiceHandler)
+
+		api.GET("/contacts", handler.RequireScope(tokenAuth, domain.ScopeContactsRead), contactHandler.ListContacts)
 	}
-}
 
-func main() {
-	// Initialize unified DI container (using portfolio app's container system)
-	di := container.New()
+	// Read-only GraphQL view of the portfolio domain
+	r.GET("/graphql", graphqlEnabled, graphqlHandlers.Query)
+	r.POST("/graphql", graphqlEnabled, graphqlHandlers.Query)
 
-	// Get configuration
-	cfg := container.MustGet[*config.Config](di)
+	// Browser-based content editor. Sign-in exchanges a content:write token
+	// for a session cookie; every other page requires that session.
+	r.GET("/admin/login", adminEnabled, adminHandlers.LoginPage)
+	r.POST("/admin/login", adminEnabled, adminHandlers.Login)
+	r.POST("/admin/logout", adminEnabled, adminHandlers.Logout)
 
-	// Set Gin mode based on environment
-	if cfg.Server.IsProduction() {
-		gin.SetMode(gin.ReleaseMode)
+	admin := r.Group("/admin", adminEnabled, adminHandlers.RequireSession())
+	{
+		admin.GET("", adminHandlers.Dashboard)
+
+		admin.GET("/technologies", adminHandlers.TechnologiesPage)
+		admin.POST("/technologies", adminHandlers.CreateTechnology)
+		admin.POST("/technologies/:id", adminHandlers.UpdateTechnology)
+		admin.DELETE("/technologies/:id", adminHandlers.DeleteTechnology)
+
+		admin.GET("/experiences", adminHandlers.ExperiencesPage)
+		admin.POST("/experiences", adminHandlers.CreateExperience)
+		admin.GET("/experiences/:id", adminHandlers.ExperienceEditor)
+		admin.POST("/experiences/:id", adminHandlers.UpdateExperience)
+		admin.DELETE("/experiences/:id", adminHandlers.DeleteExperience)
+		admin.POST("/experiences/:id/preview", adminHandlers.PreviewExperience)
+		admin.POST("/experiences/:id/achievements", adminHandlers.AddAchievement)
+		admin.DELETE("/experiences/:id/achievements/:achievementId", adminHandlers.RemoveAchievement)
+
+		admin.GET("/services", adminHandlers.ServicesPage)
+		admin.POST("/services", adminHandlers.CreateService)
+		admin.GET("/services/:id", adminHandlers.ServiceEditor)
+		admin.POST("/services/:id", adminHandlers.UpdateService)
+		admin.DELETE("/services/:id", adminHandlers.DeleteService)
+		admin.POST("/services/:id/pricing", adminHandlers.UpdatePricing)
+		admin.POST("/services/:id/deliverables", adminHandlers.AddDeliverable)
+		admin.DELETE("/services/:id/deliverables/:deliverableId", adminHandlers.RemoveDeliverable)
+		admin.POST("/services/:id/activate", adminHandlers.ActivateService)
+		admin.POST("/services/:id/deactivate", adminHandlers.DeactivateService)
+	}
+}
+
+// newRouter wires the handlers from the DI container into a Gin engine.
+func newRouter(di *container.Container, cfg *config.Config) (*gin.Engine, error) {
+	static, err := newStaticServer(cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	// Initialize Gin router
-	r := gin.Default()
+	// Every request gets an ID and one access log entry; panics are logged
+	// with it before recovery answers 500. Tracing every page and API request
+	// comes first so that both records carry the span; probes and assets
+	// would only add noise
+	logger := container.MustGet[*logging.Logger](di)
+
+	r := gin.New()
+	r.Use(
+		logging.RequestID(),
+		otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(isTracedRequest)),
+		logging.AccessLog(logger.Logger),
+		logging.Recovery(logger.Logger),
+	)
+
+	// Count and time every request by route
+	appMetrics := container.MustGet[*metrics.Metrics](di)
+	r.Use(appMetrics.Middleware())
 
 	// Get portfolio services from DI container
 	technologyService := container.MustGet[*service.TechnologyService](di)
@@ -113,14 +302,162 @@ func main() {
 		technologyService,
 		experienceService,
 		portfolioService,
+		container.MustGet[*seo.Site](di),
 	)
 
 	// Get contact service from unified DI container
 	contactService := container.MustGet[*application.ContactService](di)
-	contactHandler := NewContactHandler(contactService)
+	contactHandler := NewContactHandler(contactService, appMetrics)
+
+	// Get token service for API authentication
+	tokenService := container.MustGet[*application.TokenService](di)
+
+	// Content editor signs in with the same API tokens and keeps a server-side session
+	adminHandlers := handler.NewAdminHandlers(
+		technologyService,
+		experienceService,
+		portfolioService,
+		container.MustGet[*application.SessionService](di),
+		cfg.Auth.SessionMaxAge,
+		cfg.Server.IsProduction(),
+	)
+
+	graphqlHandlers := handler.NewGraphQLHandlers(container.MustGet[*graph.Server](di))
+
+	resumeHandlers := handler.NewResumeHandlers(container.MustGet[*resume.Generator](di))
 
-	// Setup all routes (portfolio + contact)
-	setupRoutes(r, portfolioHandlers, contactHandler)
+	ogImageHandlers := handler.NewOGImageHandlers(container.MustGet[*ogimage.Generator](di))
+
+	staticHandlers := handler.NewStaticHandlers(static)
+
+	healthHandlers := handler.NewHealthHandlers(container.MustGet[*health.Checker](di))
+
+	// Settings that may change on reload are read from the live configuration
+	live := container.MustGet[*config.Live](di)
+
+	pdfHandlers := handler.NewPDFHandlers(container.MustGet[*pdf.Generator](di), func() bool { return live.Current().Features.PDF })
+
+	metricsHandlers := handler.NewMetricsHandlers(appMetrics, func() bool { return live.Current().Metrics.RequireToken })
+
+	setupRoutes(r, portfolioHandlers, contactHandler, adminHandlers, graphqlHandlers, resumeHandlers, pdfHandlers, ogImageHandlers, staticHandlers, healthHandlers, metricsHandlers, tokenService, live, container.MustGet[*pagecache.Cache](di))
+
+	return r, nil
+}
+
+// isTracedRequest reports whether a request gets a server span.
+func isTracedRequest(r *http.Request) bool {
+	return !strings.HasPrefix(r.URL.Path, "/healthz/") &&
+		r.URL.Path != "/health" &&
+		r.URL.Path != "/metrics" &&
+		!strings.HasPrefix(r.URL.Path, staticPrefix+"/")
+}
+
+func main() {
+	args := os.Args[1:]
+
+	// Operating commands run instead of the server; without one the server
+	// runs, so that "holger-hahn-website --port 8081" keeps working
+	if len(args) > 0 {
+		if run, ok := commands[args[0]]; ok {
+			os.Exit(run(args[1:]))
+		}
+
+		if isHelp(args[0]) {
+			printUsage(os.Stdout)
+			os.Exit(constants.ExitSuccess)
+		}
+	}
+
+	os.Exit(runServeCommand(args))
+}
+
+// runServeCommand runs the web server until SIGINT or SIGTERM.
+func runServeCommand(args []string) int {
+	// Flags override the config file and the environment
+	flags := pflag.NewFlagSet("serve", pflag.ContinueOnError)
+	config.RegisterFlags(flags)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, pflag.ErrHelp) {
+			return constants.ExitSuccess
+		}
+
+		return constants.ExitUsage
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", flags.Arg(0))
+		printUsage(os.Stderr)
+
+		return constants.ExitUsage
+	}
+
+	// Initialize unified DI container (using portfolio app's container system)
+	di := container.NewWithConfig(config.Options{Flags: flags})
+
+	// Get configuration
+	cfg, err := container.Get[*config.Config](di)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		return constants.ExitFailure
+	}
+
+	// Route every log line, including Gin's and the standard library's,
+	// through the configured logger
+	logger := container.MustGet[*logging.Logger](di)
+	slog.SetDefault(logger.Logger)
+	gin.DefaultWriter = logger.Writer(slog.LevelDebug)
+	gin.DefaultErrorWriter = logger.Writer(slog.LevelError)
+
+	// Apply config file changes to the log level, metrics and feature flags,
+	// and rotated secrets, without a restart
+	live := container.MustGet[*config.Live](di)
+	smtp := container.MustGet[*infrastructure.SMTPEmailService](di)
+	live.OnReload(func(c *config.Config) {
+		if err := logger.SetLevel(c.Logging.Level); err != nil {
+			slog.Error("Failed to change log level", "error", err)
+		}
+
+		smtp.SetPassword(c.Email.SMTPPassword)
+	})
+
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+
+	go func() {
+		if err := live.Watch(watchCtx); err != nil {
+			slog.Error("Failed to watch config file", "error", err)
+		}
+	}()
+
+	// Set Gin mode based on environment
+	if cfg.Server.IsProduction() {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	// Export traces as configured; spans stay in memory until shutdown flushes them
+	shutdownTracing, err := telemetry.Setup(context.Background(), cfg.Tracing, cfg.Server.Environment)
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+
+		if shutdownErr := di.Shutdown(); shutdownErr != nil {
+			slog.Error("Failed to shut down DI container", "error", shutdownErr)
+		}
+
+		return constants.ExitFailure
+	}
+
+	// Initialize Gin router with all routes (portfolio + contact + admin)
+	r, err := newRouter(di, cfg)
+	if err != nil {
+		slog.Error("Failed to load static assets", "error", err)
+
+		if shutdownErr := di.Shutdown(); shutdownErr != nil {
+			slog.Error("Failed to shut down DI container", "error", shutdownErr)
+		}
+
+		return constants.ExitFailure
+	}
 
 	// Create HTTP server with configured timeouts
 	server := &http.Server{
@@ -130,23 +467,39 @@ func main() {
 		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
 	}
 
-	log.Printf("🚀 Unified Holger Hahn website server starting on %s in %s mode", cfg.Server.Address(), cfg.Server.Environment)
-	log.Println("📧 Contact form endpoint: POST /contact")
-	log.Println("🏥 Health check: GET /health")
-	log.Println("🔧 Portfolio API: GET /api/v1/technologies, /api/v1/experiences, /api/v1/services")
+	slog.Info("🚀 Unified Holger Hahn website server starting", "address", cfg.Server.Address(), "environment", cfg.Server.Environment)
+	slog.Info("📧 Contact form endpoint: POST /contact")
+	slog.Info("📊 Prometheus metrics: GET /metrics")
+	slog.Info("🏥 Health checks: GET /healthz/live, /healthz/ready (report also at /health)")
+	slog.Info("🔧 Portfolio API: GET /api/v1/technologies, /api/v1/experiences, /api/v1/services")
+	slog.Info("📖 API reference: GET /api/docs (spec at /api/v1/openapi.json)")
+	slog.Info("🔎 GraphQL: GET/POST /graphql")
+	slog.Info("📄 CV export: GET /api/v1/resume.json (JSON Resume), /api/v1/resume.xml (Europass)")
+	slog.Info("🖨️  PDF downloads: GET /cv.pdf, /services/:id.pdf")
+	slog.Info("🖼️  Link preview cards: GET /og/:name.png")
+	slog.Info("🔍 Search engines: GET /sitemap.xml, /robots.txt", "public_url", cfg.Server.PublicURL)
+	slog.Info("✏️  Content API: POST/PATCH/DELETE /api/v1/{technologies,experiences,services} (content:write)")
+	slog.Info("🔑 Token-protected API: GET /api/v1/contacts (contacts:read)")
+	slog.Info("📝 Content editor: GET /admin (sign in with a content:write token)")
 
-	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-		log.Printf("Failed to start server: %v", err)
-		// Cleanup DI containers before exit
-		if shutdownErr := di.Shutdown(); shutdownErr != nil {
-			log.Printf("Error shutting down DI container: %v", shutdownErr)
-		}
+	// Stop in dependency order once requests have drained: queued emails are
+	// sent before the container closes the database
+	emailQueue := container.MustGet[*infrastructure.EmailQueue](di)
+	app := lifecycle.New(server, time.Duration(cfg.Server.ShutdownTimeout)*time.Second)
+	app.OnStop("config watcher", func(context.Context) error {
+		stopWatching()
+		return nil
+	})
+	app.OnStop("email queue", emailQueue.Stop)
+	app.OnStop("container", func(context.Context) error { return di.Shutdown() })
+	app.OnStop("tracing", shutdownTracing)
 
-		os.Exit(constants.ExitFailure)
+	if err := app.Run(context.Background()); err != nil {
+		slog.Error("Server stopped with errors", "error", err)
+		return constants.ExitFailure
 	}
 
-	// Normal shutdown - cleanup DI containers
-	if err := di.Shutdown(); err != nil {
-		log.Printf("Error shutting down DI container: %v", err)
-	}
+	slog.Info("👋 Server stopped")
+
+	return constants.ExitSuccess
 }
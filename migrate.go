@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/database"
+)
+
+// runMigrateCommand applies, rolls back or lists the embedded schema
+// migrations. The container does not migrate on its own here, so that status
+// shows what is pending and down is not undone right away.
+func runMigrateCommand(args []string) int {
+	if len(args) == 0 {
+		printMigrateUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	switch args[0] {
+	case "up", "down", "status":
+	default:
+		printMigrateUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	opts := config.Options{Set: map[string]any{"database.auto_migrate": false}}
+
+	fs := commandFlags("migrate "+args[0], &opts, os.Stderr)
+	asJSON := fs.Bool("json", false, "print JSON")
+
+	var steps *int
+	if args[0] == "down" {
+		steps = fs.Int("steps", 1, "number of migrations to roll back")
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return constants.ExitUsage
+	}
+
+	if fs.NArg() > 0 {
+		printMigrateUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	di, _, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	dbManager, err := container.Get[*database.DatabaseManager](di)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open database: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		return migrateUp(ctx, dbManager, *asJSON, os.Stdout, os.Stderr)
+	case "down":
+		return migrateDown(ctx, dbManager, *steps, *asJSON, os.Stdout, os.Stderr)
+	default:
+		return migrationStatus(ctx, dbManager, *asJSON, os.Stdout, os.Stderr)
+	}
+}
+
+// migrateUp handles "migrate up".
+func migrateUp(ctx context.Context, dbManager *database.DatabaseManager, asJSON bool, stdout, stderr io.Writer) int {
+	statuses, err := dbManager.MigrationStatuses(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to read migrations: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if err := dbManager.Migrate(ctx); err != nil {
+		fmt.Fprintf(stderr, "Migration failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	applied := []string{}
+	for _, status := range statuses {
+		if !status.Applied {
+			applied = append(applied, status.Version)
+		}
+	}
+
+	if asJSON {
+		return writeJSON(stdout, map[string][]string{"applied": applied})
+	}
+
+	if len(applied) == 0 {
+		fmt.Fprintln(stdout, "Database is up to date")
+	}
+
+	for _, version := range applied {
+		fmt.Fprintf(stdout, "Applied %s\n", version)
+	}
+
+	return constants.ExitSuccess
+}
+
+// migrateDown handles "migrate down".
+func migrateDown(ctx context.Context, dbManager *database.DatabaseManager, steps int, asJSON bool, stdout, stderr io.Writer) int {
+	reverted, err := dbManager.MigrateDown(ctx, steps)
+
+	if !asJSON {
+		for _, version := range reverted {
+			fmt.Fprintf(stdout, "Rolled back %s\n", version)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Rollback failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if asJSON {
+		if reverted == nil {
+			reverted = []string{}
+		}
+
+		return writeJSON(stdout, map[string][]string{"reverted": reverted})
+	}
+
+	if len(reverted) == 0 {
+		fmt.Fprintln(stdout, "No migrations to roll back")
+	}
+
+	return constants.ExitSuccess
+}
+
+// migrationStatus handles "migrate status".
+func migrationStatus(ctx context.Context, dbManager *database.DatabaseManager, asJSON bool, stdout, stderr io.Writer) int {
+	statuses, err := dbManager.MigrationStatuses(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to read migrations: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if asJSON {
+		return writeJSON(stdout, statuses)
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATUS\tAPPLIED AT")
+
+	for _, status := range statuses {
+		state := "pending"
+		if status.Applied {
+			state = "applied"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", status.Version, state, formatOptionalTime(status.AppliedAt, "-"))
+	}
+
+	if err := w.Flush(); err != nil {
+		return constants.ExitFailure
+	}
+
+	return constants.ExitSuccess
+}
+
+func printMigrateUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website migrate <command>
+
+Commands:
+  up      [-json]               apply pending migrations
+  down    [-steps <n>] [-json]  roll back the newest applied migrations
+  status  [-json]               list migrations and when they were applied`)
+}
+
+// runSeedCommand adds the seed technologies missing from the database and
+// the sample experiences and services to empty tables.
+func runSeedCommand(args []string) int {
+	var opts config.Options
+
+	fs := commandFlags("seed", &opts, os.Stderr)
+	asJSON := fs.Bool("json", false, "print JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return constants.ExitUsage
+	}
+
+	di, _, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	result, err := di.Seed(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Seeding failed: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	if *asJSON {
+		return writeJSON(os.Stdout, result)
+	}
+
+	fmt.Fprintf(os.Stdout, "Created %d technologies, %d already existed; added %d experiences, %d services\n",
+		len(result.Created), len(result.Skipped), result.Experiences, result.Services)
+
+	return constants.ExitSuccess
+}
@@ -0,0 +1,489 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"holger-hahn-website/internal/application"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/handler"
+	"holger-hahn-website/internal/resume"
+	"holger-hahn-website/internal/testutil"
+)
+
+const specResource = "openapi.json"
+
+// ginParam matches Gin path parameters such as :id.
+var ginParam = regexp.MustCompile(`:([A-Za-z]+)`)
+
+// contract validates recorded responses against the OpenAPI document.
+type contract struct {
+	spec     map[string]any
+	compiler *jsonschema.Compiler
+	router   *gin.Engine
+	writer   string
+	reader   string
+}
+
+func newContract(t *testing.T) *contract {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	// A database of its own, so that runs neither depend on nor change the
+	// developer's content
+	di := container.NewWithConfig(config.Options{Set: map[string]any{
+		"database.connection_string": filepath.Join(t.TempDir(), "contract.db"),
+	}})
+	t.Cleanup(func() {
+		if err := di.Shutdown(); err != nil {
+			t.Errorf("Failed to shutdown DI container: %v", err)
+		}
+	})
+
+	ctx := testutil.TestContext(t)
+	tokens := container.MustGet[*application.TokenService](di)
+
+	writer, err := tokens.IssueToken(ctx, application.IssueTokenRequest{Name: "contract-writer", Scopes: []string{"content:write"}})
+	testutil.AssertNoError(t, err)
+
+	reader, err := tokens.IssueToken(ctx, application.IssueTokenRequest{Name: "contract-reader", Scopes: []string{"contacts:read"}})
+	testutil.AssertNoError(t, err)
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(handler.OpenAPISpec()))
+	testutil.AssertNoError(t, err)
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.AssertFormat()
+	testutil.AssertNoError(t, compiler.AddResource(specResource, doc))
+
+	var spec map[string]any
+	testutil.AssertNoError(t, json.Unmarshal(handler.OpenAPISpec(), &spec))
+
+	router, err := newRouter(di, container.MustGet[*config.Config](di))
+	testutil.AssertNoError(t, err)
+
+	return &contract{
+		spec:     spec,
+		compiler: compiler,
+		router:   router,
+		writer:   writer.Secret,
+		reader:   reader.Secret,
+	}
+}
+
+// call performs a request, checks the status code and validates the response
+// against the operation documented for route, which uses Gin path syntax.
+func (k *contract) call(t *testing.T, method, route, path string, body any, token string, wantStatus int) map[string]any {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		testutil.AssertNoError(t, err)
+
+		reader = bytes.NewReader(payload)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	k.router.ServeHTTP(rec, req)
+
+	if rec.Code != wantStatus {
+		t.Fatalf("%s %s: expected status %d, got %d: %s", method, path, wantStatus, rec.Code, rec.Body.String())
+	}
+
+	k.validate(t, method, route, rec)
+
+	var decoded map[string]any
+	_ = json.Unmarshal(rec.Body.Bytes(), &decoded)
+
+	return decoded
+}
+
+func (k *contract) validate(t *testing.T, method, route string, rec *httptest.ResponseRecorder) {
+	t.Helper()
+
+	specPath := ginParam.ReplaceAllString(route, "{$1}")
+	status := strconv.Itoa(rec.Code)
+
+	operation, ok := lookup(k.spec, "paths", specPath, strings.ToLower(method)).(map[string]any)
+	if !ok {
+		t.Fatalf("%s %s is not documented", method, specPath)
+	}
+
+	response, ok := lookup(operation, "responses", status).(map[string]any)
+	if !ok {
+		t.Fatalf("%s %s: status %s is not documented", method, specPath, status)
+	}
+
+	pointer := "#/paths/" + escapePointer(specPath) + "/" + strings.ToLower(method) + "/responses/" + status
+	if ref, isRef := response["$ref"].(string); isRef {
+		pointer = ref
+		response, _ = lookup(k.spec, strings.Split(strings.TrimPrefix(ref, "#/"), "/")...).(map[string]any)
+	}
+
+	if _, hasContent := response["content"]; !hasContent {
+		if rec.Body.Len() != 0 {
+			t.Fatalf("%s %s: status %s documents no body, got %q", method, specPath, status, rec.Body.String())
+		}
+
+		return
+	}
+
+	content, _ := response["content"].(map[string]any)
+	mediaType, _, _ := strings.Cut(rec.Header().Get("Content-Type"), ";")
+
+	if _, documented := content[mediaType]; !documented {
+		if _, isJSON := content["application/json"]; !isJSON {
+			t.Fatalf("%s %s: content type %q is not documented for status %s", method, specPath, mediaType, status)
+		}
+
+		mediaType = "application/json"
+	}
+
+	if mediaType != "application/json" && mediaType != handler.ProblemContentType {
+		return
+	}
+
+	schema, err := k.compiler.Compile(specResource + pointer + "/content/" + escapePointer(mediaType) + "/schema")
+	testutil.AssertNoError(t, err)
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(rec.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("%s %s: response is not JSON: %v", method, specPath, err)
+	}
+
+	if err := schema.Validate(instance); err != nil {
+		t.Fatalf("%s %s: response %s violates the spec: %v\n%s", method, specPath, status, err, rec.Body.String())
+	}
+}
+
+func lookup(value any, keys ...string) any {
+	for _, key := range keys {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = object[key]
+	}
+
+	return value
+}
+
+func escapePointer(segment string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(segment)
+}
+
+func TestOpenAPIContract_RoutesAreDocumented(t *testing.T) {
+	k := newContract(t)
+
+	registered := map[string]bool{}
+
+	for _, route := range k.router.Routes() {
+		if !strings.HasPrefix(route.Path, "/api/v1/") {
+			continue
+		}
+
+		specPath := ginParam.ReplaceAllString(route.Path, "{$1}")
+		registered[route.Method+" "+specPath] = true
+
+		if lookup(k.spec, "paths", specPath, strings.ToLower(route.Method)) == nil {
+			t.Errorf("route %s %s is missing from the OpenAPI document", route.Method, specPath)
+		}
+	}
+
+	paths, _ := k.spec["paths"].(map[string]any)
+	documented := make([]string, 0, len(paths))
+
+	for specPath, item := range paths {
+		for method := range item.(map[string]any) {
+			if method == "parameters" {
+				continue
+			}
+
+			documented = append(documented, strings.ToUpper(method)+" "+specPath)
+		}
+	}
+
+	sort.Strings(documented)
+
+	for _, operation := range documented {
+		if !registered[operation] {
+			t.Errorf("documented operation %s has no route", operation)
+		}
+	}
+}
+
+func TestOpenAPIContract_Technologies(t *testing.T) {
+	k := newContract(t)
+
+	k.call(t, http.MethodGet, "/api/v1/openapi.json", "/api/v1/openapi.json", nil, "", http.StatusOK)
+	k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies", nil, "", http.StatusOK)
+
+	create := map[string]any{"name": "Contract Testing", "category": "contract-testing", "level": "expert", "description": "Consumer-driven contracts"}
+	k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies", create, "", http.StatusUnauthorized)
+	k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies", create, k.reader, http.StatusForbidden)
+
+	created := k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies", create, k.writer, http.StatusCreated)
+	id, _ := created["id"].(string)
+	item := "/api/v1/technologies/" + id
+
+	k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies", create, k.writer, http.StatusConflict)
+
+	invalid := k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies", map[string]any{"name": "X"}, k.writer, http.StatusBadRequest)
+	testutil.AssertNotNil(t, invalid["details"])
+
+	t.Run("level filter", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		k.router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/technologies?level=expert&category=contract-testing", nil))
+		k.validate(t, http.MethodGet, "/api/v1/technologies", rec)
+
+		var technologies struct {
+			Data []map[string]any `json:"data"`
+		}
+		testutil.AssertNoError(t, json.Unmarshal(rec.Body.Bytes(), &technologies))
+		testutil.AssertTrue(t, len(technologies.Data) > 0, "Expected the created expert technology")
+
+		for _, tech := range technologies.Data {
+			testutil.AssertEqual(t, "expert", tech["level"])
+		}
+
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?level=beginner&category=contract-testing", nil, "", http.StatusOK)
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?level=guru", nil, "", http.StatusBadRequest)
+	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		category := "paging"
+		for _, name := range []string{"Alpha", "Bravo", "Charlie"} {
+			paged := k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies",
+				map[string]any{"name": name, "category": category, "level": "beginner"}, k.writer, http.StatusCreated)
+			defer k.call(t, http.MethodDelete, "/api/v1/technologies/:id", "/api/v1/technologies/"+paged["id"].(string), nil, k.writer, http.StatusNoContent)
+		}
+
+		query := "/api/v1/technologies?category=" + url.QueryEscape(category) + "&order_by=name&order_dir=desc&limit=2"
+		first := k.call(t, http.MethodGet, "/api/v1/technologies", query, nil, "", http.StatusOK)
+		testutil.AssertEqual(t, 2.0, first["count"])
+		testutil.AssertEqual(t, 3.0, first["total"])
+
+		cursor, _ := first["next_cursor"].(string)
+		testutil.AssertTrue(t, cursor != "", "Expected a cursor for the second page")
+
+		second := k.call(t, http.MethodGet, "/api/v1/technologies", query+"&cursor="+cursor, nil, "", http.StatusOK)
+		testutil.AssertEqual(t, 1.0, second["count"])
+		testutil.AssertNil(t, second["next_cursor"])
+
+		names := []string{}
+		for _, page := range []map[string]any{first, second} {
+			for _, tech := range page["data"].([]any) {
+				names = append(names, tech.(map[string]any)["name"].(string))
+			}
+		}
+
+		testutil.AssertTrue(t, strings.HasPrefix(names[0], "Charlie") && strings.HasPrefix(names[2], "Alpha"),
+			"Expected names in descending order, got "+strings.Join(names, ", "))
+
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?level=expert&cursor="+cursor, nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?cursor=not-a-cursor", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?limit=101", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?order_by=popularity", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?order_dir=sideways", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/technologies", "/api/v1/technologies?levle=expert", nil, "", http.StatusBadRequest)
+	})
+
+	k.call(t, http.MethodGet, "/api/v1/technologies/:id", item, nil, "", http.StatusOK)
+	k.call(t, http.MethodGet, "/api/v1/technologies/:id", "/api/v1/technologies/missing", nil, "", http.StatusNotFound)
+	k.call(t, http.MethodPatch, "/api/v1/technologies/:id", item, map[string]any{"level": "advanced"}, k.writer, http.StatusOK)
+	k.call(t, http.MethodPatch, "/api/v1/technologies/:id", item, map[string]any{"level": "guru"}, k.writer, http.StatusBadRequest)
+	k.call(t, http.MethodDelete, "/api/v1/technologies/:id", item, nil, k.writer, http.StatusNoContent)
+	k.call(t, http.MethodDelete, "/api/v1/technologies/:id", item, nil, k.writer, http.StatusNotFound)
+}
+
+func TestOpenAPIContract_Experiences(t *testing.T) {
+	k := newContract(t)
+
+	tech := k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies",
+		map[string]any{"name": "Go", "category": "language", "level": "expert"}, k.writer, http.StatusCreated)
+
+	k.call(t, http.MethodGet, "/api/v1/experiences", "/api/v1/experiences", nil, "", http.StatusOK)
+
+	created := k.call(t, http.MethodPost, "/api/v1/experiences", "/api/v1/experiences", map[string]any{
+		"company_name": "Contract GmbH",
+		"position":     "Staff Engineer",
+		"description":  "Kept the API honest",
+		"start_date":   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		"location":     "Berlin",
+	}, k.writer, http.StatusCreated)
+	id, _ := created["id"].(string)
+	item := "/api/v1/experiences/" + id
+
+	k.call(t, http.MethodPost, "/api/v1/experiences", "/api/v1/experiences", map[string]any{"position": "X"}, k.writer, http.StatusBadRequest)
+	k.call(t, http.MethodGet, "/api/v1/experiences/:id", item, nil, "", http.StatusOK)
+	k.call(t, http.MethodGet, "/api/v1/experiences/:id", "/api/v1/experiences/missing", nil, "", http.StatusNotFound)
+	k.call(t, http.MethodPatch, "/api/v1/experiences/:id", item, map[string]any{"is_remote": true}, k.writer, http.StatusOK)
+	k.call(t, http.MethodPost, "/api/v1/experiences/:id/technologies", item+"/technologies",
+		map[string]any{"technology_id": tech["id"]}, k.writer, http.StatusOK)
+
+	withAchievement := k.call(t, http.MethodPost, "/api/v1/experiences/:id/achievements", item+"/achievements", map[string]any{
+		"title": "Faster releases",
+		"metrics": map[string]any{
+			"deployment_time": map[string]any{"unit": "minutes", "before": 60, "after": 5, "improvement": 91.7},
+		},
+	}, k.writer, http.StatusOK)
+
+	achievements, _ := withAchievement["achievements"].([]any)
+	testutil.AssertEqual(t, 1, len(achievements))
+
+	achievementID, _ := achievements[0].(map[string]any)["id"].(string)
+	k.call(t, http.MethodDelete, "/api/v1/experiences/:id/achievements/:achievementId", item+"/achievements/"+achievementID, nil, k.writer, http.StatusOK)
+	k.call(t, http.MethodDelete, "/api/v1/experiences/:id/achievements/:achievementId", item+"/achievements/"+achievementID, nil, k.writer, http.StatusNotFound)
+
+	k.call(t, http.MethodPost, "/api/v1/experiences/:id/end", item+"/end",
+		map[string]any{"end_date": time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)}, k.writer, http.StatusOK)
+
+	t.Run("filters", func(t *testing.T) {
+		name := url.QueryEscape(tech["name"].(string))
+		found := k.call(t, http.MethodGet, "/api/v1/experiences",
+			"/api/v1/experiences?technology="+name+"&is_current=false&is_remote=true&start_after=2021-12-31&end_before=2024-12-31", nil, "", http.StatusOK)
+		testutil.AssertEqual(t, 1.0, found["total"])
+
+		none := k.call(t, http.MethodGet, "/api/v1/experiences", "/api/v1/experiences?technology="+name+"&is_current=true", nil, "", http.StatusOK)
+		testutil.AssertEqual(t, 0.0, none["total"])
+
+		k.call(t, http.MethodGet, "/api/v1/experiences", "/api/v1/experiences?is_current=maybe", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/experiences", "/api/v1/experiences?start_after=last-year", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/experiences", "/api/v1/experiences?order_by=name", nil, "", http.StatusBadRequest)
+	})
+
+	k.call(t, http.MethodDelete, "/api/v1/experiences/:id", item, nil, k.writer, http.StatusNoContent)
+	k.call(t, http.MethodDelete, "/api/v1/experiences/:id", item, nil, k.writer, http.StatusNotFound)
+	k.call(t, http.MethodDelete, "/api/v1/technologies/:id", "/api/v1/technologies/"+tech["id"].(string), nil, k.writer, http.StatusNoContent)
+}
+
+func TestOpenAPIContract_Services(t *testing.T) {
+	k := newContract(t)
+
+	tech := k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies",
+		map[string]any{"name": "Solidity", "category": "language", "level": "advanced"}, k.writer, http.StatusCreated)
+
+	k.call(t, http.MethodGet, "/api/v1/services", "/api/v1/services", nil, "", http.StatusOK)
+
+	create := map[string]any{"name": "Contract Review", "description": "Review of API contracts", "category": "auditing"}
+	created := k.call(t, http.MethodPost, "/api/v1/services", "/api/v1/services", create, k.writer, http.StatusCreated)
+	id, _ := created["id"].(string)
+	item := "/api/v1/services/" + id
+
+	k.call(t, http.MethodPost, "/api/v1/services", "/api/v1/services", create, k.writer, http.StatusConflict)
+	k.call(t, http.MethodGet, "/api/v1/services/:id", item, nil, "", http.StatusOK)
+	k.call(t, http.MethodGet, "/api/v1/services/:id", "/api/v1/services/missing", nil, "", http.StatusNotFound)
+	k.call(t, http.MethodPatch, "/api/v1/services/:id", item, map[string]any{"duration": "2 weeks"}, k.writer, http.StatusOK)
+	k.call(t, http.MethodPost, "/api/v1/services/:id/technologies", item+"/technologies",
+		map[string]any{"technology_id": tech["id"]}, k.writer, http.StatusOK)
+
+	withDeliverable := k.call(t, http.MethodPost, "/api/v1/services/:id/deliverables", item+"/deliverables",
+		map[string]any{"name": "Findings report", "timeline": "1 week"}, k.writer, http.StatusOK)
+
+	deliverables, _ := withDeliverable["deliverables"].([]any)
+	testutil.AssertEqual(t, 1, len(deliverables))
+
+	deliverableID, _ := deliverables[0].(map[string]any)["id"].(string)
+	k.call(t, http.MethodDelete, "/api/v1/services/:id/deliverables/:deliverableId", item+"/deliverables/"+deliverableID, nil, k.writer, http.StatusOK)
+
+	k.call(t, http.MethodPut, "/api/v1/services/:id/pricing", item+"/pricing",
+		map[string]any{"type": "daily", "amount": 1200, "currency": "EUR"}, k.writer, http.StatusOK)
+	k.call(t, http.MethodPut, "/api/v1/services/:id/pricing", item+"/pricing",
+		map[string]any{"type": "bogus"}, k.writer, http.StatusBadRequest)
+
+	t.Run("filters", func(t *testing.T) {
+		name := url.QueryEscape(tech["name"].(string))
+		found := k.call(t, http.MethodGet, "/api/v1/services",
+			"/api/v1/services?technology="+name+"&category=auditing&pricing_type=daily&min_price=1000&max_price=1500&is_active=true", nil, "", http.StatusOK)
+		testutil.AssertEqual(t, 1.0, found["total"])
+
+		none := k.call(t, http.MethodGet, "/api/v1/services", "/api/v1/services?technology="+name+"&max_price=500", nil, "", http.StatusOK)
+		testutil.AssertEqual(t, 0.0, none["total"])
+
+		k.call(t, http.MethodGet, "/api/v1/services", "/api/v1/services?category=plumbing", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/services", "/api/v1/services?min_price=cheap", nil, "", http.StatusBadRequest)
+		k.call(t, http.MethodGet, "/api/v1/services", "/api/v1/services?min_price=2000&max_price=1000", nil, "", http.StatusBadRequest)
+	})
+
+	k.call(t, http.MethodPost, "/api/v1/services/:id/deactivate", item+"/deactivate", nil, k.writer, http.StatusOK)
+	k.call(t, http.MethodPost, "/api/v1/services/:id/activate", item+"/activate", nil, k.writer, http.StatusOK)
+	k.call(t, http.MethodDelete, "/api/v1/services/:id", item, nil, k.writer, http.StatusNoContent)
+	k.call(t, http.MethodDelete, "/api/v1/technologies/:id", "/api/v1/technologies/"+tech["id"].(string), nil, k.writer, http.StatusNoContent)
+}
+
+func TestOpenAPIContract_Contacts(t *testing.T) {
+	k := newContract(t)
+
+	k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts", nil, "", http.StatusUnauthorized)
+	k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts", nil, k.writer, http.StatusForbidden)
+	k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts", nil, k.reader, http.StatusOK)
+	k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts?limit=0", nil, k.reader, http.StatusBadRequest)
+	k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts?status=spam", nil, k.reader, http.StatusBadRequest)
+	k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts?offset=0&cursor=abc", nil, k.reader, http.StatusBadRequest)
+}
+
+func TestOpenAPIContract_Resume(t *testing.T) {
+	k := newContract(t)
+
+	doc := k.call(t, http.MethodGet, "/api/v1/resume.json", "/api/v1/resume.json", nil, "", http.StatusOK)
+	testutil.AssertEqual[any](t, "Holger M. Hahn", lookup(doc, "basics", "name"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.xml", nil)
+	rec := httptest.NewRecorder()
+	k.router.ServeHTTP(rec, req)
+
+	testutil.AssertEqual(t, http.StatusOK, rec.Code)
+	k.validate(t, http.MethodGet, "/api/v1/resume.xml", rec)
+
+	var europass resume.Europass
+	testutil.AssertNoError(t, xml.Unmarshal(rec.Body.Bytes(), &europass))
+	testutil.AssertEqual(t, "Hahn", europass.LearnerInfo.Identification.Surname)
+}
+
+func TestOpenAPIContract_Problems(t *testing.T) {
+	k := newContract(t)
+
+	catalog := k.call(t, http.MethodGet, "/api/v1/problems", "/api/v1/problems", nil, "", http.StatusOK)
+	testutil.AssertNotNil(t, catalog["data"])
+
+	entry := k.call(t, http.MethodGet, "/api/v1/problems/:code", "/api/v1/problems/validation_failed", nil, "", http.StatusOK)
+	testutil.AssertEqual[any](t, float64(http.StatusBadRequest), entry["status"])
+
+	k.call(t, http.MethodGet, "/api/v1/problems/:code", "/api/v1/problems/bogus", nil, "", http.StatusNotFound)
+
+	problem := k.call(t, http.MethodPost, "/api/v1/technologies", "/api/v1/technologies", map[string]any{"name": "X"}, k.writer, http.StatusBadRequest)
+	testutil.AssertEqual[any](t, "validation_failed", problem["code"])
+	testutil.AssertEqual[any](t, "/api/v1/problems/validation_failed", problem["type"])
+	testutil.AssertEqual[any](t, "/api/v1/technologies", problem["instance"])
+	testutil.AssertTrue(t, problem["request_id"] != nil, "problems carry the request ID")
+
+	problem = k.call(t, http.MethodGet, "/api/v1/contacts", "/api/v1/contacts", nil, "", http.StatusUnauthorized)
+	testutil.AssertEqual[any](t, "token_missing", problem["code"])
+}
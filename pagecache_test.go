@@ -0,0 +1,86 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/container"
+)
+
+// newBenchmarkRouter builds the site's router over a fresh database, with the
+// page cache on or off.
+func newBenchmarkRouter(b *testing.B, cacheEnabled bool) *gin.Engine {
+	b.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	di := container.NewWithConfig(config.Options{Set: map[string]any{
+		"cache.enabled":              cacheEnabled,
+		"database.connection_string": filepath.Join(b.TempDir(), "bench.db"),
+		"logging.level":              "error",
+	}})
+	b.Cleanup(func() { _ = di.Shutdown() })
+
+	router, err := newRouter(di, container.MustGet[*config.Config](di))
+	if err != nil {
+		b.Fatalf("Failed to build router: %v", err)
+	}
+
+	return router
+}
+
+// BenchmarkHomePage compares rendering the home page on every request with
+// serving it from the page cache, and with revalidating it by ETag.
+func BenchmarkHomePage(b *testing.B) {
+	request := func(b *testing.B, router *gin.Engine, etag string, want int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != want {
+			b.Fatalf("GET / answered %d, want %d", w.Code, want)
+		}
+
+		return w
+	}
+
+	b.Run("uncached", func(b *testing.B) {
+		router := newBenchmarkRouter(b, false)
+
+		b.ReportAllocs()
+
+		for b.Loop() {
+			request(b, router, "", http.StatusOK)
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		router := newBenchmarkRouter(b, true)
+		request(b, router, "", http.StatusOK)
+
+		b.ReportAllocs()
+
+		for b.Loop() {
+			request(b, router, "", http.StatusOK)
+		}
+	})
+
+	b.Run("not modified", func(b *testing.B) {
+		router := newBenchmarkRouter(b, true)
+		etag := request(b, router, "", http.StatusOK).Header().Get("ETag")
+
+		b.ReportAllocs()
+
+		for b.Loop() {
+			request(b, router, etag, http.StatusNotModified)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/telemetry"
+	"holger-hahn-website/internal/testutil"
+)
+
+// TestAccessLogCarriesTraceContext checks that the access log record of a
+// traced request carries the trace and span IDs, which needs the tracing
+// middleware to run outside the access log.
+func TestAccessLogCarriesTraceContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "site.log")
+
+	di := container.NewWithConfig(config.Options{Set: map[string]any{
+		"database.connection_string": filepath.Join(dir, "site.db"),
+		"logging.output":             "file",
+		"logging.file":               logFile,
+	}})
+	t.Cleanup(func() { _ = di.Shutdown() })
+
+	cfg := container.MustGet[*config.Config](di)
+
+	// Without an exporter the incoming trace context is still propagated
+	shutdown, err := telemetry.Setup(context.Background(), cfg.Tracing, cfg.Server.Environment)
+	testutil.AssertNoError(t, err)
+	t.Cleanup(func() { _ = shutdown(context.Background()) })
+
+	router, err := newRouter(di, cfg)
+	testutil.AssertNoError(t, err)
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/technologies", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	file, err := os.Open(logFile)
+	testutil.AssertNoError(t, err)
+	defer file.Close()
+
+	var access map[string]any
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record map[string]any
+		if json.Unmarshal(scanner.Bytes(), &record) == nil && record["msg"] == "request" {
+			access = record
+		}
+	}
+
+	testutil.AssertTrue(t, access != nil, "Expected an access log record")
+	testutil.AssertEqual[any](t, traceID, access["trace_id"])
+	testutil.AssertTrue(t, access["span_id"] != nil, "Expected the span ID on the access record")
+}
@@ -5,4 +5,4 @@ echo "Building templates..."
 templ generate
 
 echo "Starting server on http://localhost:8080"
-go run main.go
\ No newline at end of file
+go run . serve
\ No newline at end of file
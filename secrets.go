@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/secrets"
+)
+
+// runSecretsCommand manages the encrypted secrets file that configuration
+// values of the form "secret:<name>" are looked up in.
+func runSecretsCommand(args []string) int {
+	if len(args) == 0 {
+		printSecretsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	if args[0] == "keygen" {
+		key, err := secrets.GenerateKey()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate key: %v\n", err)
+			return constants.ExitFailure
+		}
+
+		fmt.Fprintln(os.Stdout, key)
+
+		return constants.ExitSuccess
+	}
+
+	fs := flag.NewFlagSet("secrets "+args[0], flag.ContinueOnError)
+	file := fs.String("file", os.Getenv("SECRETS_FILE"), "encrypted secrets file (default $SECRETS_FILE)")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return constants.ExitUsage
+	}
+
+	store, err := openSecretsStore(*file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open secrets file: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	switch {
+	case args[0] == "list" && fs.NArg() == 0:
+		for _, name := range store.Names() {
+			fmt.Fprintln(os.Stdout, name)
+		}
+
+		return constants.ExitSuccess
+	case args[0] == "set" && fs.NArg() == 1:
+		return setSecret(store, fs.Arg(0), os.Stdin, os.Stdout, os.Stderr)
+	case args[0] == "delete" && fs.NArg() == 1:
+		store.Delete(fs.Arg(0))
+		return saveSecrets(store, "Deleted secret "+fs.Arg(0), os.Stdout, os.Stderr)
+	default:
+		printSecretsUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+}
+
+// openSecretsStore opens file with the key from $SECRETS_KEY or the file
+// named by $SECRETS_KEY_FILE.
+func openSecretsStore(file string) (*secrets.Store, error) {
+	if file == "" {
+		return nil, errors.New("no secrets file, set -file or $SECRETS_FILE")
+	}
+
+	encoded := os.Getenv("SECRETS_KEY")
+	if encoded == "" {
+		var err error
+		if encoded, err = (secrets.FileEnv{}).Lookup(context.Background(), "SECRETS_KEY"); err != nil {
+			return nil, fmt.Errorf("no key, set $SECRETS_KEY or $SECRETS_KEY_FILE: %w", err)
+		}
+	}
+
+	key, err := secrets.ParseKey(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets.OpenStore(file, key)
+}
+
+// setSecret handles "secrets set <name>", reading the value from the first
+// line of stdin so that it stays out of the shell history.
+func setSecret(store *secrets.Store, name string, stdin io.Reader, stdout, stderr io.Writer) int {
+	value, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		fmt.Fprintf(stderr, "Failed to read secret: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	value = strings.TrimRight(value, "\r\n")
+	if value == "" {
+		fmt.Fprintln(stderr, "Secret value is empty")
+		return constants.ExitUsage
+	}
+
+	store.Set(name, value)
+
+	return saveSecrets(store, fmt.Sprintf("Stored secret %s, reference it as %s%s", name, secrets.ReferencePrefix, name), stdout, stderr)
+}
+
+// saveSecrets writes store and reports done.
+func saveSecrets(store *secrets.Store, done string, stdout, stderr io.Writer) int {
+	if err := store.Save(); err != nil {
+		fmt.Fprintf(stderr, "Failed to save secrets file: %v\n", err)
+		return constants.ExitFailure
+	}
+
+	fmt.Fprintln(stdout, done)
+
+	return constants.ExitSuccess
+}
+
+func printSecretsUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website secrets <command>
+
+Commands:
+  keygen                          print a new key for $SECRETS_KEY
+  set     [-file <file>] <name>   store the value read from stdin
+  delete  [-file <file>] <name>
+  list    [-file <file>]`)
+}
@@ -18,9 +18,15 @@ import (
 	"holger-hahn-website/internal/application"
 	"holger-hahn-website/internal/constants"
 	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/seo"
 	"holger-hahn-website/templates"
 )
 
+// homeHead returns the home page metadata without structured data content.
+func homeHead() seo.Head {
+	return seo.NewSite(constants.DefaultPublicURL, nil, nil).HomeHead(seo.Content{})
+}
+
 // TestEndToEndContactFlow tests the complete contact form workflow.
 func TestEndToEndContactFlow(t *testing.T) {
 	// Setup unified dependency injection container
@@ -45,7 +51,7 @@ func TestEndToEndContactFlow(t *testing.T) {
 
 	// Routes
 	router.GET("/", func(c *gin.Context) {
-		component := templates.Index()
+		component := templates.Index(homeHead())
 
 		c.Header("Content-Type", "text/html")
 
@@ -266,7 +272,7 @@ func (h *ContactHandler) SubmitContactForm(c *gin.Context) {
 func TestSystemIntegration(t *testing.T) {
 	t.Run("Template Generation", func(t *testing.T) {
 		// Test that templates can be generated without errors
-		component := templates.Index()
+		component := templates.Index(homeHead())
 		if component == nil {
 			t.Error("Failed to create Index template component")
 		}
@@ -355,7 +361,7 @@ func TestPerformanceBasic(t *testing.T) {
 	router := gin.Default()
 	router.Static("/static", "../static")
 	router.GET("/", func(c *gin.Context) {
-		component := templates.Index()
+		component := templates.Index(homeHead())
 
 		c.Header("Content-Type", "text/html")
 
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"holger-hahn-website/internal/application"
+	"holger-hahn-website/internal/config"
+	"holger-hahn-website/internal/constants"
+	"holger-hahn-website/internal/container"
+	"holger-hahn-website/internal/domain"
+)
+
+// runUserCommand dispatches the user subcommands. The site has no user
+// accounts: the content editor signs in with a content:write token, so a
+// user is such a token named after the person it was issued to.
+func runUserCommand(args []string) int {
+	if len(args) == 0 || args[0] != "create" {
+		printUserUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	var opts config.Options
+
+	fs := commandFlags("user create", &opts, os.Stderr)
+	name := fs.String("name", "", "who the editor token is for, e.g. holger")
+	expires := fs.Duration("expires", 0, "token lifetime, 0 for no expiry (default auth.token_ttl)")
+	asJSON := fs.Bool("json", false, "print JSON")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return constants.ExitUsage
+	}
+
+	if *name == "" || fs.NArg() > 0 {
+		printUserUsage(os.Stderr)
+		return constants.ExitUsage
+	}
+
+	di, cfg, ok := openContainer(opts, os.Stderr)
+	if !ok {
+		return constants.ExitFailure
+	}
+	defer closeContainer(di, os.Stderr)
+
+	ttl := cfg.Auth.TokenTTL
+	if isFlagSet(fs, "expires") {
+		ttl = *expires
+	}
+
+	req := application.IssueTokenRequest{
+		Name:   "editor:" + *name,
+		Scopes: []string{string(domain.ScopeContentWrite)},
+		TTL:    ttl,
+	}
+
+	return issueToken(context.Background(), container.MustGet[*application.TokenService](di), req, *asJSON, os.Stdout, os.Stderr)
+}
+
+func printUserUsage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: holger-hahn-website user create -name <name> [-expires <duration>] [-json]
+
+Issues a content:write token named editor:<name> for signing in at /admin.`)
+}